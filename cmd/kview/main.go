@@ -10,6 +10,7 @@ import (
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	kubeactions "github.com/korex-labs/kview/v5/internal/kube/actions"
+	kubedeployments "github.com/korex-labs/kview/v5/internal/kube/resource/deployments"
 	kubehelm "github.com/korex-labs/kview/v5/internal/kube/resource/helm"
 	"github.com/korex-labs/kview/v5/internal/launcher"
 	"github.com/korex-labs/kview/v5/internal/runtime"
@@ -46,6 +47,9 @@ func main() {
 	srv.Actions().Register("scale", kubeactions.HandleDeploymentScale)
 	srv.Actions().Register("restart", kubeactions.HandleDeploymentRestart)
 	srv.Actions().Register("delete", kubeactions.HandleDeploymentDelete)
+	srv.Actions().Register("deployment.rollback", kubedeployments.HandleDeploymentRollback)
+	srv.Actions().Register("deployment.pause", kubeactions.HandleDeploymentPause)
+	srv.Actions().Register("deployment.resume", kubeactions.HandleDeploymentResume)
 
 	srv.Actions().Register("helm.uninstall", kubehelm.HandleHelmUninstall)
 	srv.Actions().Register("helm.upgrade", kubehelm.HandleHelmUpgrade)
@@ -53,6 +57,7 @@ func main() {
 	srv.Actions().Register("helm.rollback", kubehelm.HandleHelmRollback)
 
 	srv.Actions().Register("pod.delete", kubeactions.HandlePodDelete)
+	srv.Actions().Register("pod.evict", kubeactions.HandlePodEvict)
 
 	srv.Actions().Register("daemonset.restart", kubeactions.HandleDaemonSetRestart)
 	srv.Actions().Register("daemonset.delete", kubeactions.HandleDaemonSetDelete)
@@ -66,9 +71,13 @@ func main() {
 
 	srv.Actions().Register("job.delete", kubeactions.HandleJobDelete)
 	srv.Actions().Register("job.rerun", kubeactions.HandleJobRerun)
+	srv.Actions().Register("job.suspend", kubeactions.HandleJobSuspend)
+	srv.Actions().Register("job.resume", kubeactions.HandleJobResume)
 
 	srv.Actions().Register("cronjob.delete", kubeactions.HandleCronJobDelete)
 	srv.Actions().Register("cronjob.run", kubeactions.HandleCronJobRun)
+	srv.Actions().Register("cronjob.suspend", kubeactions.HandleCronJobSuspend)
+	srv.Actions().Register("cronjob.resume", kubeactions.HandleCronJobResume)
 
 	srv.Actions().Register("service.delete", kubeactions.HandleServiceDelete)
 
@@ -93,6 +102,9 @@ func main() {
 	srv.Actions().Register("persistentvolumes.delete", kubeactions.HandlePVDelete)
 
 	srv.Actions().Register("nodes.delete", kubeactions.HandleNodeDelete)
+	srv.Actions().Register("nodes.cordon", kubeactions.HandleNodeCordon)
+	srv.Actions().Register("nodes.uncordon", kubeactions.HandleNodeUncordon)
+	srv.Actions().Register("nodes.drain", kubeactions.NewNodeDrainHandler(rt.Registry()))
 
 	srv.Actions().Register("namespaces.delete", kubeactions.HandleNamespaceDelete)
 
@@ -100,6 +112,7 @@ func main() {
 	srv.Actions().Register("custom.workload", kubeactions.HandleCustomWorkloadAction)
 	srv.Actions().Register("resource.yaml.validate", kubeactions.HandleResourceYAMLValidate)
 	srv.Actions().Register("resource.yaml.apply", kubeactions.HandleResourceYAMLApply)
+	srv.Actions().Register("resource.finalizers.remove", kubeactions.HandleResourceFinalizersRemove)
 
 	url := fmt.Sprintf("http://%s/?token=%s", *addr, token)
 	log.Printf("kview listening on http://%s", *addr)
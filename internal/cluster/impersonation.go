@@ -0,0 +1,140 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/client-go/rest"
+)
+
+// impersonationSeparator joins a kubeconfig context name and an impersonated identity
+// into a scoped context name, e.g. "prod|as=serviceaccount:team-a/deployer". Every
+// cache keyed by context name (clients, dataplane planes, scheduler work) then keeps
+// the impersonated view apart from the operator's own.
+const impersonationSeparator = "|as="
+
+// GroupOnlyImpersonationUser is the user impersonated when only groups are given: the
+// API server requires a user, and this one has no bindings of its own, so the groups
+// alone decide what is allowed.
+const GroupOnlyImpersonationUser = "kview:group-viewer"
+
+// Impersonation is the identity requests are made as, applied through client-go's
+// ImpersonationConfig.
+type Impersonation struct {
+	UserName string   `json:"userName,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// IsZero reports whether no identity is impersonated.
+func (i Impersonation) IsZero() bool {
+	return i.UserName == "" && len(i.Groups) == 0
+}
+
+// ParseImpersonation parses a comma-separated identity such as "user:alice",
+// "serviceaccount:team-a/deployer", "group:devs" or "user:alice,group:devs,group:qa".
+// At most one user or ServiceAccount may be given; groups alone impersonate
+// GroupOnlyImpersonationUser with those groups.
+func ParseImpersonation(value string) (Impersonation, error) {
+	var out Impersonation
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kind, name, ok := strings.Cut(part, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return Impersonation{}, fmt.Errorf("invalid identity %q: want user:<name>, group:<name> or serviceaccount:<namespace>/<name>", part)
+		}
+		switch strings.ToLower(strings.TrimSpace(kind)) {
+		case "user":
+			if out.UserName != "" {
+				return Impersonation{}, fmt.Errorf("only one user or serviceaccount can be impersonated")
+			}
+			out.UserName = name
+		case "serviceaccount", "sa":
+			ns, sa, ok := strings.Cut(name, "/")
+			if !ok || ns == "" || sa == "" || strings.Contains(sa, "/") {
+				return Impersonation{}, fmt.Errorf("invalid serviceaccount %q: want <namespace>/<name>", name)
+			}
+			if out.UserName != "" {
+				return Impersonation{}, fmt.Errorf("only one user or serviceaccount can be impersonated")
+			}
+			out.UserName = "system:serviceaccount:" + ns + ":" + sa
+		case "group":
+			out.Groups = append(out.Groups, name)
+		default:
+			return Impersonation{}, fmt.Errorf("invalid identity kind %q: want user, group or serviceaccount", kind)
+		}
+	}
+	if out.IsZero() {
+		return Impersonation{}, fmt.Errorf("no identity given")
+	}
+	if out.UserName == "" {
+		out.UserName = GroupOnlyImpersonationUser
+	}
+	sort.Strings(out.Groups)
+	return out, nil
+}
+
+// String is the canonical form accepted by ParseImpersonation. ServiceAccount users
+// are written as serviceaccount:<namespace>/<name>.
+func (i Impersonation) String() string {
+	var parts []string
+	switch {
+	case strings.HasPrefix(i.UserName, "system:serviceaccount:"):
+		ns, sa, _ := strings.Cut(strings.TrimPrefix(i.UserName, "system:serviceaccount:"), ":")
+		parts = append(parts, "serviceaccount:"+ns+"/"+sa)
+	case i.UserName != "" && (i.UserName != GroupOnlyImpersonationUser || len(i.Groups) == 0):
+		parts = append(parts, "user:"+i.UserName)
+	}
+	for _, g := range i.Groups {
+		parts = append(parts, "group:"+g)
+	}
+	return strings.Join(parts, ",")
+}
+
+// ScopedContextName returns the context name requests as the identity are keyed by.
+// A zero identity returns contextName unchanged.
+func ScopedContextName(contextName string, imp Impersonation) string {
+	if imp.IsZero() {
+		return contextName
+	}
+	return contextName + impersonationSeparator + imp.String()
+}
+
+// SplitScopedContextName splits a scoped context name into the kubeconfig context and
+// the impersonated identity. Plain context names return a zero identity.
+func SplitScopedContextName(name string) (string, Impersonation, error) {
+	contextName, identity, ok := strings.Cut(name, impersonationSeparator)
+	if !ok {
+		return name, Impersonation{}, nil
+	}
+	imp, err := ParseImpersonation(identity)
+	if err != nil {
+		return contextName, Impersonation{}, err
+	}
+	return contextName, imp, nil
+}
+
+// BaseContextName returns the kubeconfig context of a possibly scoped context name.
+func BaseContextName(name string) string {
+	contextName, _, _ := strings.Cut(name, impersonationSeparator)
+	return contextName
+}
+
+// IsScopedContextName reports whether name carries an impersonated identity.
+func IsScopedContextName(name string) bool {
+	return strings.Contains(name, impersonationSeparator)
+}
+
+func applyImpersonation(cfg *rest.Config, imp Impersonation) {
+	if imp.IsZero() {
+		return
+	}
+	cfg.Impersonate = rest.ImpersonationConfig{
+		UserName: imp.UserName,
+		Groups:   append([]string(nil), imp.Groups...),
+	}
+}
@@ -0,0 +1,55 @@
+package cluster
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseImpersonation(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Impersonation
+		str  string
+	}{
+		{"user:alice", Impersonation{UserName: "alice"}, "user:alice"},
+		{"serviceaccount:team-a/deployer", Impersonation{UserName: "system:serviceaccount:team-a:deployer"}, "serviceaccount:team-a/deployer"},
+		{"user:alice, group:qa,group:devs", Impersonation{UserName: "alice", Groups: []string{"devs", "qa"}}, "user:alice,group:devs,group:qa"},
+		{"group:auditors", Impersonation{UserName: GroupOnlyImpersonationUser, Groups: []string{"auditors"}}, "group:auditors"},
+	}
+	for _, tc := range cases {
+		got, err := ParseImpersonation(tc.in)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tc.in, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%q: got %+v, want %+v", tc.in, got, tc.want)
+		}
+		if got.String() != tc.str {
+			t.Fatalf("%q: String() = %q, want %q", tc.in, got.String(), tc.str)
+		}
+	}
+
+	for _, in := range []string{"", "alice", "user:", "serviceaccount:deployer", "user:a,user:b", "role:admin"} {
+		if _, err := ParseImpersonation(in); err == nil {
+			t.Fatalf("%q: expected an error", in)
+		}
+	}
+}
+
+func TestScopedContextName(t *testing.T) {
+	imp := Impersonation{UserName: "system:serviceaccount:team-a:deployer"}
+	scoped := ScopedContextName("admin@prod", imp)
+	if scoped != "admin@prod|as=serviceaccount:team-a/deployer" {
+		t.Fatalf("scoped name: %q", scoped)
+	}
+	base, got, err := SplitScopedContextName(scoped)
+	if err != nil || base != "admin@prod" || !reflect.DeepEqual(got, imp) {
+		t.Fatalf("split: %q %+v %v", base, got, err)
+	}
+	if BaseContextName(scoped) != "admin@prod" || !IsScopedContextName(scoped) {
+		t.Fatal("scoped name not recognized")
+	}
+	if ScopedContextName("prod", Impersonation{}) != "prod" {
+		t.Fatal("zero identity must keep the plain context name")
+	}
+}
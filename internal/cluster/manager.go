@@ -36,6 +36,10 @@ type KubeconfigInfo struct {
 	DefaultPath   string   `json:"defaultPath"`
 }
 
+// maxScopedClients caps how many impersonated identities keep cached clients. The
+// identity of a request header is open ended, so the oldest is dropped first.
+const maxScopedClients = 16
+
 type Manager struct {
 	mu sync.RWMutex
 
@@ -44,6 +48,8 @@ type Manager struct {
 	activeContext string
 
 	clients map[string]*Clients
+	// scopedClients lists the scoped names cached in clients, oldest first.
+	scopedClients []string
 
 	kubeconfigFiles []string
 	kubeconfigSet   bool
@@ -332,11 +338,17 @@ func (m *Manager) GetClients(ctx context.Context) (*Clients, string, error) {
 
 // GetClientsForContext returns clients for a specific context name without
 // touching the active context. Returns an error if contextName is unknown.
+// A scoped context name (see ScopedContextName) returns clients that impersonate
+// its identity; they are cached apart from the context's own clients.
 func (m *Manager) GetClientsForContext(ctx context.Context, contextName string) (*Clients, string, error) {
+	baseName, imp, err := SplitScopedContextName(contextName)
+	if err != nil {
+		return nil, contextName, fmt.Errorf("invalid impersonation: %w", err)
+	}
 	m.mu.RLock()
-	if _, ok := m.rawConfig.Contexts[contextName]; !ok {
+	if _, ok := m.rawConfig.Contexts[baseName]; !ok {
 		m.mu.RUnlock()
-		return nil, contextName, fmt.Errorf("%w: %s", ErrUnknownContext, contextName)
+		return nil, contextName, fmt.Errorf("%w: %s", ErrUnknownContext, baseName)
 	}
 	if c, ok := m.clients[contextName]; ok {
 		m.mu.RUnlock()
@@ -344,7 +356,7 @@ func (m *Manager) GetClientsForContext(ctx context.Context, contextName string)
 	}
 	m.mu.RUnlock()
 
-	overrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: baseName}
 	loadingRules := buildLoadingRules(m.kubeconfigFiles, m.kubeconfigSet)
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
 
@@ -354,6 +366,7 @@ func (m *Manager) GetClientsForContext(ctx context.Context, contextName string)
 	}
 
 	ensureExecEnv(restCfg, m.kubeconfigFiles)
+	applyImpersonation(restCfg, imp)
 
 	clientset, err := kubernetes.NewForConfig(restCfg)
 	if err != nil {
@@ -372,8 +385,18 @@ func (m *Manager) GetClientsForContext(ctx context.Context, contextName string)
 	}
 
 	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.clients[contextName]; ok {
+		return c, contextName, nil
+	}
 	m.clients[contextName] = clients
-	m.mu.Unlock()
+	if !imp.IsZero() {
+		m.scopedClients = append(m.scopedClients, contextName)
+		if len(m.scopedClients) > maxScopedClients {
+			delete(m.clients, m.scopedClients[0])
+			m.scopedClients = m.scopedClients[1:]
+		}
+	}
 
 	return clients, contextName, nil
 }
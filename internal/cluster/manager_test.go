@@ -1,6 +1,8 @@
 package cluster
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -64,3 +66,51 @@ func TestExpandKubeconfigLocationsExpandsDirectoryInNameOrder(t *testing.T) {
 		t.Fatalf("files = %v, want %v", got, want)
 	}
 }
+
+func TestGetClientsForContextCapsScopedClients(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	kubeconfig := `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: dev
+users:
+- name: dev
+  user:
+    token: test
+`
+	if err := os.WriteFile(path, []byte(kubeconfig), 0o600); err != nil {
+		t.Fatalf("write kubeconfig: %v", err)
+	}
+	m, err := NewManagerWithLoggerAndConfig(testLogger{}, path)
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+
+	if _, _, err := m.GetClientsForContext(context.Background(), "dev"); err != nil {
+		t.Fatalf("plain clients: %v", err)
+	}
+	for i := 0; i <= maxScopedClients; i++ {
+		name := ScopedContextName("dev", Impersonation{UserName: fmt.Sprintf("user-%d", i)})
+		if _, _, err := m.GetClientsForContext(context.Background(), name); err != nil {
+			t.Fatalf("scoped clients %s: %v", name, err)
+		}
+	}
+
+	if len(m.clients) != maxScopedClients+1 {
+		t.Fatalf("cached clients = %d, want %d", len(m.clients), maxScopedClients+1)
+	}
+	if _, ok := m.clients["dev"]; !ok {
+		t.Fatal("plain context clients were evicted")
+	}
+	if _, ok := m.clients[ScopedContextName("dev", Impersonation{UserName: "user-0"})]; ok {
+		t.Fatal("oldest scoped clients were not evicted")
+	}
+}
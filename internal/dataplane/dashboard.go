@@ -120,6 +120,7 @@ type ClusterDashboardResourcesPanel struct {
 	HelmReleases             int    `json:"helmReleases"`
 	ResourceQuotas           int    `json:"resourceQuotas"`
 	LimitRanges              int    `json:"limitRanges"`
+	PodDisruptionBudgets     int    `json:"podDisruptionBudgets"`
 	TotalNamespaces          int    `json:"totalNamespaces"`
 	Note                     string `json:"note,omitempty"`
 	AggregateFreshness       string `json:"aggregateFreshness,omitempty"`
@@ -148,6 +149,8 @@ type ClusterDashboardSignalsPanel struct {
 	RoleWarnings          int                            `json:"roleWarnings"`
 	RoleBindingWarnings   int                            `json:"roleBindingWarnings"`
 	HPAWarnings           int                            `json:"hpaWarnings"`
+	PDBWarnings           int                            `json:"pdbWarnings"`
+	StorageWarnings       int                            `json:"storageWarnings"`
 	ContainerNearLimit    int                            `json:"containerNearLimit"`
 	NodeResourcePressure  int                            `json:"nodeResourcePressure"`
 	Filters               []ClusterDashboardSignalFilter `json:"filters,omitempty"`
@@ -154,9 +154,13 @@ func (m *manager) aggregateClusterDashboard(plane *clusterPlane, nsNamesSorted [
 			res.LimitRanges += len(s.limitRanges.Items)
 			aggregateMetas = append(aggregateMetas, s.limitRanges.Meta)
 		}
+		if s.pdbsOK {
+			res.PodDisruptionBudgets += len(s.pdbs.Items)
+			aggregateMetas = append(aggregateMetas, s.pdbs.Meta)
+		}
 		signals.Add(m.attachSignalHistory(plane.name, now, applySignalPolicy(detectDashboardSignals(now, ns, s), p, plane.name)...)...)
 	}
-	signals.Add(m.attachSignalHistory(plane.name, now, applySignalPolicy(detectNodeResourcePressureSignals(now, plane, nodesSnap, thresholds.NodeResourcePressurePct), p, plane.name)...)...)
+	signals.Add(m.attachSignalHistory(plane.name, now, applySignalPolicy(detectClusterScopedSignals(now, plane, nodesSnap, thresholds), p, plane.name)...)...)
 
 	if len(aggregateMetas) > 0 {
 		wf := string(WorstFreshnessFromSnapshots(aggregateMetas...))
@@ -199,7 +203,9 @@ func buildSnapshotSetForNamespace(plane *clusterPlane, ns string, thresholds res
 	helmReleasesSnap, helmReleasesOK := plane.helmReleasesStore.getCached(ns)
 	rqSnap, rqOK := plane.rqStore.getCached(ns)
 	lrSnap, lrOK := plane.lrStore.getCached(ns)
+	pdbSnap, pdbOK := plane.pdbStore.getCached(ns)
 	podMetricsSnap, podMetricsOK := plane.podMetricsStore.getCached(ns)
+	scSnap, scOK := peekClusterSnapshot(&plane.storageClassesStore)
 	return dashboardSnapshotSet{
 		restartThreshold:       thresholds.PodRestartCount,
 		pods:                   podsSnap,
@@ -240,6 +246,10 @@ func buildSnapshotSetForNamespace(plane *clusterPlane, ns string, thresholds res
 		quotasOK:               rqOK && rqSnap.Err == nil,
 		limitRanges:            lrSnap,
 		limitRangesOK:          lrOK && lrSnap.Err == nil,
+		pdbs:                   pdbSnap,
+		pdbsOK:                 pdbOK && pdbSnap.Err == nil,
+		storageClasses:         scSnap,
+		storageClassesOK:       scOK && scSnap.Err == nil,
 		podMetrics:             podMetricsSnap,
 		podMetricsOK:           podMetricsOK && podMetricsSnap.Err == nil,
 		containerNearLimitPct:  thresholds.ContainerNearLimitPct,
@@ -295,8 +305,13 @@ type dashboardSnapshotSet struct {
 	quotasOK       bool
 	limitRanges    LimitRangesSnapshot
 	limitRangesOK  bool
-	podMetrics     PodMetricsSnapshot
-	podMetricsOK   bool
+	pdbs           PodDisruptionBudgetsSnapshot
+	pdbsOK         bool
+	// storageClasses is the cluster-scoped StorageClass snapshot, shared by every namespace.
+	storageClasses   StorageClassesSnapshot
+	storageClassesOK bool
+	podMetrics       PodMetricsSnapshot
+	podMetricsOK     bool
 	// containerNearLimitPct is the minimum percent-of-limit required to raise
 	// a container_near_limit signal. Set from policy.Signals.Detectors.ContainerNearLimit.Percent.
 	containerNearLimitPct  int
@@ -511,6 +526,10 @@ func (p *ClusterDashboardSignalsPanel) incrementSignalCounter(counter string) {
 		p.RoleBindingWarnings++
 	case "hpa_warnings":
 		p.HPAWarnings++
+	case "pdb_warnings":
+		p.PDBWarnings++
+	case "storage_warnings":
+		p.StorageWarnings++
 	case "container_near_limit":
 		p.ContainerNearLimit++
 	case "node_resource_pressure":
@@ -777,9 +796,9 @@ func dashboardSignalKindPriority(kind string) int {
 		return 3
 	case "ResourceQuota":
 		return 4
-	case "Job", "CronJob", "HorizontalPodAutoscaler":
+	case "Job", "CronJob", "HorizontalPodAutoscaler", "PodDisruptionBudget":
 		return 5
-	case "PersistentVolumeClaim", "Service", "Ingress":
+	case "PersistentVolumeClaim", "Service", "Ingress", "StorageClass", "VolumeAttachment":
 		return 6
 	case "ServiceAccount", "Role", "RoleBinding":
 		return 7
@@ -874,6 +893,9 @@ func namespaceHasCachedDataplaneList(plane *clusterPlane, ns string) bool {
 	if _, ok := plane.lrStore.getCached(ns); ok {
 		return true
 	}
+	if _, ok := plane.pdbStore.getCached(ns); ok {
+		return true
+	}
 	return false
 }
 
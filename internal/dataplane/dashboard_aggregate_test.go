@@ -1,6 +1,7 @@
 package dataplane
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -726,3 +727,144 @@ func TestBuildDashboardCoverageCountsCachedRowProjectionNamespaces(t *testing.T)
 		t.Fatalf("list-only: got %d, want 2", cov.ListOnlyNamespaces)
 	}
 }
+
+func TestDashboardPDBSignals(t *testing.T) {
+	apiSel := &dto.LabelSelectorDTO{MatchLabels: map[string]string{"app": "api"}}
+	items := detectDashboardSignals(time.Now().UTC(), "team-a", dashboardSnapshotSet{
+		pods: PodsSnapshot{Items: []dto.PodListItemDTO{
+			{Name: "api-0", Labels: map[string]string{"app": "api"}},
+			{Name: "api-1", Labels: map[string]string{"app": "api"}},
+			{Name: "web-0", Labels: map[string]string{"app": "web"}},
+			{Name: "web-1", Labels: map[string]string{"app": "web"}},
+		}},
+		podsOK: true,
+		pdbs: PodDisruptionBudgetsSnapshot{Items: []dto.PodDisruptionBudgetDTO{
+			{Name: "api", Selector: apiSel, SelectorString: "app=api", CurrentHealthy: 2, DesiredHealthy: 2, ExpectedPods: 2},
+			{Name: "stale", Selector: &dto.LabelSelectorDTO{MatchLabels: map[string]string{"app": "gone"}}, SelectorString: "app=gone"},
+			{Name: "unset"},
+		}},
+		pdbsOK: true,
+		deps: DeploymentsSnapshot{Items: []dto.DeploymentListItemDTO{
+			{Name: "api", Replicas: 2, Selector: "app=api"},
+			{Name: "web", Replicas: 2, Selector: "app=web"},
+			{Name: "single", Replicas: 1, Selector: "app=single"},
+		}},
+		depsOK: true,
+	})
+
+	got := map[string]bool{}
+	for _, item := range items {
+		got[item.SignalType+"/"+item.Name] = true
+	}
+	for _, key := range []string{"pdb_zero_disruptions/api", "pdb_no_matching_pods/stale", "pdb_no_matching_pods/unset", "workload_without_pdb/web"} {
+		if !got[key] {
+			t.Fatalf("expected %s in %v", key, got)
+		}
+	}
+	for _, key := range []string{"pdb_no_matching_pods/api", "workload_without_pdb/api", "workload_without_pdb/single"} {
+		if got[key] {
+			t.Fatalf("unexpected %s in %v", key, got)
+		}
+	}
+}
+
+func TestDashboardStorageSignals(t *testing.T) {
+	classes := StorageClassesSnapshot{Items: []dto.StorageClassDTO{
+		{Name: "standard", Provisioner: "ebs.csi.aws.com", VolumeBindingMode: "Immediate", IsDefault: true},
+		{Name: "local", Provisioner: "kubernetes.io/no-provisioner", VolumeBindingMode: "WaitForFirstConsumer"},
+	}}
+	items := detectDashboardSignals(time.Now().UTC(), "team-a", dashboardSnapshotSet{
+		pvcs: PVCsSnapshot{Items: []dto.PersistentVolumeClaimDTO{
+			{Name: "data", Phase: "Pending", StorageClassName: "standard"},
+			{Name: "cache", Phase: "Pending", StorageClassName: "local"},
+			{Name: "legacy", Phase: "Pending", StorageClassName: "gp1"},
+			{Name: "bound", Phase: "Bound", StorageClassName: "gp1"},
+		}},
+		pvcsOK:           true,
+		storageClasses:   classes,
+		storageClassesOK: true,
+	})
+
+	got := map[string]ClusterDashboardSignal{}
+	for _, item := range items {
+		got[item.SignalType+"/"+item.Name] = item
+	}
+	if f, ok := got["pvc_needs_attention/data"]; !ok || f.Severity != "medium" || !strings.Contains(f.Reason, "ebs.csi.aws.com") {
+		t.Fatalf("expected provisioner wait for data, got %+v", f)
+	}
+	if f, ok := got["pvc_needs_attention/cache"]; !ok || f.Severity != "low" || !strings.Contains(f.Reason, "WaitForFirstConsumer") {
+		t.Fatalf("expected low WaitForFirstConsumer signal for cache, got %+v", f)
+	}
+	if f, ok := got["pvc_storage_class_missing/legacy"]; !ok || f.Severity != "high" {
+		t.Fatalf("expected high missing-class signal for legacy, got %+v", f)
+	}
+	if f, ok := got["pvc_storage_class_missing/bound"]; !ok || f.Severity != "low" {
+		t.Fatalf("expected low missing-class signal for bound, got %+v", f)
+	}
+	if _, ok := got["pvc_needs_attention/legacy"]; ok {
+		t.Fatalf("missing-class claim should not also raise pvc_needs_attention: %v", got)
+	}
+
+	if out := detectNoDefaultStorageClassSignals(classes); len(out) != 0 {
+		t.Fatalf("expected no signal with a default class, got %+v", out)
+	}
+	classes.Items[0].IsDefault = false
+	if out := detectNoDefaultStorageClassSignals(classes); len(out) != 1 || out[0].Scope != "cluster" {
+		t.Fatalf("expected cluster no_default_storage_class signal, got %+v", out)
+	}
+}
+
+func TestDashboardVolumeAttachmentStuckDetaching(t *testing.T) {
+	now := time.Now()
+	snap := VolumeAttachmentsSnapshot{Items: []dto.VolumeAttachmentDTO{
+		{Name: "stuck", NodeName: "n1", Attached: true, DeletionTimestamp: now.Add(-time.Hour).Unix()},
+		{Name: "erroring", NodeName: "n2", Attached: true, DeletionTimestamp: now.Add(-time.Minute).Unix(), DetachError: "rpc error"},
+		{Name: "recent", NodeName: "n3", Attached: true, DeletionTimestamp: now.Add(-time.Minute).Unix()},
+		{Name: "healthy", NodeName: "n4", Attached: true},
+	}}
+	got := map[string]string{}
+	for _, f := range detectVolumeAttachmentStuckDetachingSignals(now, snap) {
+		got[f.Name] = f.Severity
+	}
+	if len(got) != 2 || got["stuck"] != "medium" || got["erroring"] != "high" {
+		t.Fatalf("unexpected stuck-detaching signals: %v", got)
+	}
+}
+
+func TestDashboardServiceReadinessFailingSignals(t *testing.T) {
+	sel := map[string]string{"app": "api"}
+	items := detectDashboardSignals(time.Now().UTC(), "team-a", dashboardSnapshotSet{
+		svcs: ServicesSnapshot{Items: []dto.ServiceListItemDTO{
+			{Name: "api", Selector: sel, EndpointsNotReady: 2},
+			{Name: "api-ok", Selector: sel, EndpointsReady: 1, EndpointsNotReady: 2},
+			{Name: "orphan", Selector: map[string]string{"app": "gone"}},
+		}},
+		svcsOK: true,
+		pods: PodsSnapshot{Items: []dto.PodListItemDTO{
+			{Name: "api-1", Phase: "Running", Ready: "0/1", Labels: sel},
+			{Name: "api-0", Phase: "Running", Ready: "1/2", Labels: sel},
+			{Name: "api-2", Phase: "Pending", Ready: "0/1", Labels: sel},
+		}},
+		podsOK: true,
+	})
+
+	got := map[string]ClusterDashboardSignal{}
+	for _, item := range items {
+		got[item.SignalType+"/"+item.Name] = item
+	}
+	f, ok := got["service_readiness_failing/api"]
+	if !ok || f.Severity != "high" || !strings.Contains(f.Reason, "api-0, api-1") || strings.Contains(f.Reason, "api-2") {
+		t.Fatalf("expected readiness signal naming api-0 and api-1, got %+v", f)
+	}
+	if _, ok := got["service_no_ready_endpoints/api"]; ok {
+		t.Fatalf("readiness-failing service should not also raise service_no_ready_endpoints: %v", got)
+	}
+	for _, key := range []string{"service_no_ready_endpoints/api-ok", "service_no_ready_endpoints/orphan"} {
+		if _, ok := got[key]; !ok {
+			t.Fatalf("expected %s in %v", key, got)
+		}
+	}
+	if _, ok := got["service_readiness_failing/api-ok"]; ok {
+		t.Fatalf("service with ready endpoints should not raise service_readiness_failing")
+	}
+}
@@ -0,0 +1,196 @@
+package dataplane
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// fleetTopSignalLimit bounds the signals listed per context on the fleet dashboard.
+const fleetTopSignalLimit = 5
+
+// FleetContextSummary is one context's row on the fleet dashboard. It is built only
+// from snapshots already cached (or hydrated from persistence) for the context, so
+// rendering the fleet never triggers kube reads; background all-context enrichment is
+// what keeps the rows current.
+type FleetContextSummary struct {
+	Context string `json:"context"`
+	// Loaded is false while neither a namespace nor a node snapshot is cached yet.
+	Loaded bool `json:"loaded"`
+	// Reachability is filled by the server from the connectivity activity.
+	Reachability FleetContextReachability `json:"reachability"`
+
+	Namespaces                 int    `json:"namespaces"`
+	Nodes                      int    `json:"nodes"`
+	NodesNotReady              int    `json:"nodesNotReady"`
+	Pods                       int    `json:"pods"`
+	ResourceTotalsCompleteness string `json:"resourceTotalsCompleteness"`
+	NamespacesInResourceTotals int    `json:"namespacesInResourceTotals"`
+
+	Signals           FleetSignalCounts        `json:"signals"`
+	TopSignals        []ClusterDashboardSignal `json:"topSignals,omitempty"`
+	StaleHelmReleases []ClusterDashboardSignal `json:"staleHelmReleases,omitempty"`
+
+	Freshness FleetContextFreshness `json:"freshness"`
+}
+
+// FleetContextReachability is the last known connectivity of a context.
+type FleetContextReachability struct {
+	// State is connected, disconnected, inactive (no longer probed since another context
+	// became active), or unknown when the context was never probed.
+	State     string `json:"state"`
+	Message   string `json:"message,omitempty"`
+	CheckedAt string `json:"checkedAt,omitempty"`
+}
+
+type FleetSignalCounts struct {
+	Total  int `json:"total"`
+	High   int `json:"high"`
+	Medium int `json:"medium"`
+	Low    int `json:"low"`
+}
+
+// FleetContextFreshness reports how old the data behind a fleet row is. Worst is the
+// worst freshness across the namespace, node, and resource snapshots that fed it.
+type FleetContextFreshness struct {
+	Worst                string `json:"worst"`
+	Namespaces           string `json:"namespaces,omitempty"`
+	Nodes                string `json:"nodes,omitempty"`
+	Resources            string `json:"resources,omitempty"`
+	NamespacesObservedAt string `json:"namespacesObservedAt,omitempty"`
+	NodesObservedAt      string `json:"nodesObservedAt,omitempty"`
+	// AgeSec is the age of the newest namespace or node snapshot; -1 when none is cached.
+	AgeSec int64 `json:"ageSec"`
+}
+
+// FleetContextSummary builds the fleet dashboard row for one context from cached snapshots.
+func (m *manager) FleetContextSummary(ctx context.Context, clusterName string) FleetContextSummary {
+	ctx = ContextWithWorkSourceIfUnset(ctx, WorkSourceDashboard)
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+
+	out := FleetContextSummary{
+		Context:   clusterName,
+		Freshness: FleetContextFreshness{Worst: string(FreshnessClassUnknown), AgeSec: -1},
+	}
+	nsSnap, nsOK := peekClusterSnapshot(&plane.nsStore)
+	nodesSnap, nodesOK := peekClusterSnapshot(&plane.nodesStore)
+	out.Loaded = nsOK || nodesOK
+
+	now := time.Now()
+	var metas []SnapshotMetadata
+	var newest time.Time
+	nsNames := make([]string, 0, len(nsSnap.Items))
+	if nsOK {
+		for _, ns := range nsSnap.Items {
+			nsNames = append(nsNames, ns.Name)
+		}
+		out.Namespaces = len(nsNames)
+		out.Freshness.Namespaces = string(nsSnap.Meta.Freshness)
+		out.Freshness.NamespacesObservedAt = formatSnapshotTime(nsSnap.Meta.ObservedAt)
+		metas = append(metas, nsSnap.Meta)
+		newest = nsSnap.Meta.ObservedAt
+	}
+	if nodesOK {
+		out.Nodes = len(nodesSnap.Items)
+		for _, n := range nodesSnap.Items {
+			if n.Status != "Ready" {
+				out.NodesNotReady++
+			}
+		}
+		out.Freshness.Nodes = string(nodesSnap.Meta.Freshness)
+		out.Freshness.NodesObservedAt = formatSnapshotTime(nodesSnap.Meta.ObservedAt)
+		metas = append(metas, nodesSnap.Meta)
+		if nodesSnap.Meta.ObservedAt.After(newest) {
+			newest = nodesSnap.Meta.ObservedAt
+		}
+	}
+	if !newest.IsZero() {
+		out.Freshness.AgeSec = int64(now.Sub(newest).Seconds())
+	}
+	sort.Strings(nsNames)
+
+	nodeState := CoarseState(nodesSnap.Err, len(nodesSnap.Items))
+	res, signals, derived, cov := m.aggregateClusterDashboard(plane, nsNames, len(nsNames), nodesSnap, nodeState, ClusterDashboardListOptions{
+		SignalsFilter: "signal:stale_transitional_helm_release",
+		SignalsLimit:  100,
+	})
+	if derived.Nodes.Total > out.Nodes {
+		out.Nodes = derived.Nodes.Total
+	}
+	out.Pods = res.Pods
+	out.ResourceTotalsCompleteness = cov.ResourceTotalsCompleteness
+	out.NamespacesInResourceTotals = cov.NamespacesInResourceTotals
+	out.Signals = FleetSignalCounts{Total: signals.Total, High: signals.High, Medium: signals.Medium, Low: signals.Low}
+	out.TopSignals = signals.Top
+	if len(out.TopSignals) > fleetTopSignalLimit {
+		out.TopSignals = out.TopSignals[:fleetTopSignalLimit]
+	}
+	out.StaleHelmReleases = signals.Items
+	if res.AggregateFreshness != "" {
+		out.Freshness.Resources = res.AggregateFreshness
+		metas = append(metas, SnapshotMetadata{Freshness: FreshnessClass(res.AggregateFreshness)})
+	}
+	if len(metas) > 0 {
+		out.Freshness.Worst = string(WorstFreshnessFromSnapshots(metas...))
+	}
+	return out
+}
+
+// FleetDashboard rolls up every kubeconfig context. Contexts are ordered for triage:
+// unreachable first, then by high and medium signal counts.
+type FleetDashboard struct {
+	Totals   FleetDashboardTotals  `json:"totals"`
+	Contexts []FleetContextSummary `json:"contexts"`
+}
+
+type FleetDashboardTotals struct {
+	Contexts          int               `json:"contexts"`
+	Unreachable       int               `json:"unreachable"`
+	NotLoaded         int               `json:"notLoaded"`
+	Nodes             int               `json:"nodes"`
+	NodesNotReady     int               `json:"nodesNotReady"`
+	Pods              int               `json:"pods"`
+	Signals           FleetSignalCounts `json:"signals"`
+	StaleHelmReleases int               `json:"staleHelmReleases"`
+}
+
+// BuildFleetDashboard orders the context rows for triage and sums their totals.
+func BuildFleetDashboard(rows []FleetContextSummary) FleetDashboard {
+	out := FleetDashboard{Contexts: rows}
+	if out.Contexts == nil {
+		out.Contexts = []FleetContextSummary{}
+	}
+	sort.SliceStable(out.Contexts, func(i, j int) bool {
+		a, b := out.Contexts[i], out.Contexts[j]
+		if ua, ub := a.Reachability.State == "disconnected", b.Reachability.State == "disconnected"; ua != ub {
+			return ua
+		}
+		if a.Signals.High != b.Signals.High {
+			return a.Signals.High > b.Signals.High
+		}
+		if a.Signals.Medium != b.Signals.Medium {
+			return a.Signals.Medium > b.Signals.Medium
+		}
+		return a.Context < b.Context
+	})
+	t := &out.Totals
+	for _, row := range out.Contexts {
+		t.Contexts++
+		if row.Reachability.State == "disconnected" {
+			t.Unreachable++
+		}
+		if !row.Loaded {
+			t.NotLoaded++
+		}
+		t.Nodes += row.Nodes
+		t.NodesNotReady += row.NodesNotReady
+		t.Pods += row.Pods
+		t.Signals.Total += row.Signals.Total
+		t.Signals.High += row.Signals.High
+		t.Signals.Medium += row.Signals.Medium
+		t.Signals.Low += row.Signals.Low
+		t.StaleHelmReleases += len(row.StaleHelmReleases)
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package dataplane
+
+import (
+	"testing"
+	"time"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func TestFleetContextSummaryFromCachedSnapshots(t *testing.T) {
+	mm := NewManager(ManagerConfig{}).(*manager)
+
+	empty := mm.FleetContextSummary(t.Context(), "cold")
+	if empty.Loaded || empty.Freshness.AgeSec != -1 || empty.Freshness.Worst != string(FreshnessClassUnknown) {
+		t.Fatalf("expected an unloaded row for a cold context, got %+v", empty)
+	}
+
+	planeAny, _ := mm.PlaneForCluster(t.Context(), "prod")
+	plane := planeAny.(*clusterPlane)
+	now := time.Now().UTC()
+	meta := SnapshotMetadata{ObservedAt: now.Add(-time.Minute), Freshness: FreshnessClassHot}
+	setClusterSnapshot(&plane.nsStore, NamespaceSnapshot{Meta: meta, Items: []dto.NamespaceListItemDTO{{Name: "apps"}, {Name: "idle"}}})
+	setClusterSnapshot(&plane.nodesStore, NodesSnapshot{
+		Meta:  SnapshotMetadata{ObservedAt: now.Add(-2 * time.Minute), Freshness: FreshnessClassCold},
+		Items: []dto.NodeListItemDTO{{Name: "n1", Status: "Ready"}, {Name: "n2", Status: "NotReady"}},
+	})
+	setNamespacedSnapshot(&plane.podsStore, "apps", PodsSnapshot{Meta: meta, Items: []dto.PodListItemDTO{
+		{Name: "web-0", Namespace: "apps", Phase: "Running", Ready: "1/1", Node: "n1"},
+		{Name: "web-1", Namespace: "apps", Phase: "Running", Ready: "1/1", Node: "n1"},
+	}})
+	setNamespacedSnapshot(&plane.helmReleasesStore, "apps", HelmReleasesSnapshot{Meta: meta, Items: []dto.HelmReleaseDTO{
+		{Name: "stuck", Namespace: "apps", Status: "pending-upgrade", Updated: now.Add(-48 * time.Hour).Unix()},
+		{Name: "fine", Namespace: "apps", Status: "deployed", Updated: now.Unix()},
+	}})
+
+	row := mm.FleetContextSummary(t.Context(), "prod")
+	if !row.Loaded || row.Namespaces != 2 || row.Nodes != 2 || row.NodesNotReady != 1 || row.Pods != 2 {
+		t.Fatalf("unexpected totals: %+v", row)
+	}
+	if row.ResourceTotalsCompleteness != "partial" || row.NamespacesInResourceTotals != 1 {
+		t.Fatalf("unexpected coverage: %q %d", row.ResourceTotalsCompleteness, row.NamespacesInResourceTotals)
+	}
+	if len(row.StaleHelmReleases) != 1 || row.StaleHelmReleases[0].Name != "stuck" {
+		t.Fatalf("expected the stuck release, got %+v", row.StaleHelmReleases)
+	}
+	if row.Signals.High == 0 || len(row.TopSignals) == 0 || row.TopSignals[0].Severity != "high" {
+		t.Fatalf("expected high severity signals first, got %+v", row.Signals)
+	}
+	if row.Freshness.Worst != string(FreshnessClassCold) || row.Freshness.AgeSec < 59 {
+		t.Fatalf("unexpected freshness: %+v", row.Freshness)
+	}
+}
+
+func TestBuildFleetDashboardOrdersForTriage(t *testing.T) {
+	fleet := BuildFleetDashboard([]FleetContextSummary{
+		{Context: "a-quiet", Loaded: true, Nodes: 3, Reachability: FleetContextReachability{State: "connected"}},
+		{Context: "b-noisy", Loaded: true, Nodes: 5, NodesNotReady: 1, Signals: FleetSignalCounts{Total: 4, High: 2, Medium: 2}, Reachability: FleetContextReachability{State: "inactive"}},
+		{Context: "c-down", Reachability: FleetContextReachability{State: "disconnected"}},
+		{Context: "d-medium", Loaded: true, Signals: FleetSignalCounts{Total: 1, Medium: 1}, StaleHelmReleases: []ClusterDashboardSignal{{Name: "rel"}}, Reachability: FleetContextReachability{State: "unknown"}},
+	})
+
+	order := make([]string, 0, len(fleet.Contexts))
+	for _, row := range fleet.Contexts {
+		order = append(order, row.Context)
+	}
+	want := []string{"c-down", "b-noisy", "d-medium", "a-quiet"}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("expected order %v, got %v", want, order)
+		}
+	}
+	tot := fleet.Totals
+	if tot.Contexts != 4 || tot.Unreachable != 1 || tot.NotLoaded != 1 || tot.Nodes != 8 || tot.NodesNotReady != 1 {
+		t.Fatalf("unexpected totals: %+v", tot)
+	}
+	if tot.Signals.Total != 5 || tot.Signals.High != 2 || tot.StaleHelmReleases != 1 {
+		t.Fatalf("unexpected signal totals: %+v", tot)
+	}
+}
@@ -2,9 +2,13 @@ package dataplane
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
+
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 )
 
@@ -23,9 +27,11 @@ var dashboardSignalDetectors = []dashboardSignalDetector{
 	{Type: "hpa_needs_attention", Detect: detectHPANeedsAttentionSignals},
 	{Type: "stale_transitional_helm_release", Detect: detectStaleTransitionalHelmReleaseSignals},
 	{Type: "service_no_ready_endpoints", Detect: detectServiceNoReadyEndpointsSignals},
+	{Type: "service_readiness_failing", Detect: detectServiceReadinessFailingSignals},
 	{Type: "ingress_pending_address", Detect: detectIngressPendingAddressSignals},
 	{Type: "ingress_needs_attention", Detect: detectIngressNeedsAttentionSignals},
 	{Type: "pvc_needs_attention", Detect: detectPVCNeedsAttentionSignals},
+	{Type: "pvc_storage_class_missing", Detect: detectPVCStorageClassMissingSignals},
 	{Type: "role_permission_surface", Detect: detectRolePermissionSurfaceSignals},
 	{Type: "rolebinding_subject_surface", Detect: detectRoleBindingSubjectSurfaceSignals},
 	{Type: "resource_quota_pressure", Detect: detectResourceQuotaPressureSignals},
@@ -34,6 +40,9 @@ var dashboardSignalDetectors = []dashboardSignalDetector{
 	{Type: "potentially_unused_pvc", Detect: detectPotentiallyUnusedPVCSignals},
 	{Type: "potentially_unused_serviceaccount", Detect: detectPotentiallyUnusedServiceAccountSignals},
 	{Type: "container_near_limit", Detect: detectContainerNearLimitSignals},
+	{Type: "pdb_zero_disruptions", Detect: detectPDBZeroDisruptionsSignals},
+	{Type: "pdb_no_matching_pods", Detect: detectPDBNoMatchingPodsSignals},
+	{Type: "workload_without_pdb", Detect: detectWorkloadWithoutPDBSignals},
 }
 
 func detectEmptyNamespaceSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
@@ -165,6 +174,130 @@ func hpaSignalSeverityAndScore(hpa dto.HorizontalPodAutoscalerDTO) (string, int)
 	return "medium", 65
 }
 
+func detectPDBZeroDisruptionsSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
+	if !s.pdbsOK {
+		return nil
+	}
+	var out []ClusterDashboardSignal
+	for _, pdb := range s.pdbs.Items {
+		if pdb.DisruptionsAllowed > 0 || pdb.ExpectedPods == 0 {
+			continue
+		}
+		f := dashboardSignalItem("pdb_zero_disruptions", "PodDisruptionBudget", ns, pdb.Name, "medium", 68, "PodDisruptionBudget currently allows no voluntary disruptions.", "high", "poddisruptionbudgets")
+		f.ActualData = fmt.Sprintf("disruptions allowed 0, healthy %d of %d desired, %d expected pods", pdb.CurrentHealthy, pdb.DesiredHealthy, pdb.ExpectedPods)
+		out = append(out, f)
+	}
+	return out
+}
+
+func detectPDBNoMatchingPodsSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
+	if !s.pdbsOK || !s.podsOK {
+		return nil
+	}
+	var out []ClusterDashboardSignal
+	for _, pdb := range s.pdbs.Items {
+		sel, ok := pdbSelector(pdb)
+		if ok && podsMatchSelector(s.pods.Items, sel) {
+			continue
+		}
+		f := dashboardSignalItem("pdb_no_matching_pods", "PodDisruptionBudget", ns, pdb.Name, "low", 36, "PodDisruptionBudget selector matches no pods.", "medium", "poddisruptionbudgets")
+		f.ActualData = "selector " + pdbSelectorLabel(pdb)
+		out = append(out, f)
+	}
+	return out
+}
+
+// detectWorkloadWithoutPDBSignals reports multi-replica deployments and statefulsets whose
+// pods are not covered by any PodDisruptionBudget in the namespace.
+func detectWorkloadWithoutPDBSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
+	if !s.pdbsOK || !s.podsOK {
+		return nil
+	}
+	var pdbSelectors []labels.Selector
+	for _, pdb := range s.pdbs.Items {
+		if sel, ok := pdbSelector(pdb); ok {
+			pdbSelectors = append(pdbSelectors, sel)
+		}
+	}
+	check := func(kind, name, section, selector string, replicas int32) *ClusterDashboardSignal {
+		if replicas < 2 || selector == "" {
+			return nil
+		}
+		sel, err := labels.Parse(selector)
+		if err != nil {
+			return nil
+		}
+		covered, podCount := false, 0
+		for _, p := range s.pods.Items {
+			if !sel.Matches(labels.Set(p.Labels)) {
+				continue
+			}
+			podCount++
+			for _, pdbSel := range pdbSelectors {
+				if pdbSel.Matches(labels.Set(p.Labels)) {
+					covered = true
+					break
+				}
+			}
+		}
+		if podCount == 0 || covered {
+			return nil
+		}
+		f := dashboardSignalItem("workload_without_pdb", kind, ns, name, "low", 32, kind+" runs multiple replicas without a PodDisruptionBudget.", "medium", section)
+		f.ActualData = fmt.Sprintf("%d replicas, %d pod%s, 0 matching PDBs", replicas, podCount, pluralSuffix(podCount))
+		return &f
+	}
+	var out []ClusterDashboardSignal
+	if s.depsOK {
+		for _, d := range s.deps.Items {
+			if f := check("Deployment", d.Name, "deployments", d.Selector, d.Replicas); f != nil {
+				out = append(out, *f)
+			}
+		}
+	}
+	if s.stsOK {
+		for _, st := range s.sts.Items {
+			if f := check("StatefulSet", st.Name, "statefulsets", st.Selector, st.Desired); f != nil {
+				out = append(out, *f)
+			}
+		}
+	}
+	return out
+}
+
+// pdbSelector returns false for a PDB without a selector (it selects no pods) or with an
+// invalid one.
+func pdbSelector(pdb dto.PodDisruptionBudgetDTO) (labels.Selector, bool) {
+	if pdb.Selector == nil {
+		return nil, false
+	}
+	sel, err := labelSelectorFromDTO(*pdb.Selector)
+	if err != nil {
+		return nil, false
+	}
+	return sel, true
+}
+
+func pdbSelectorLabel(pdb dto.PodDisruptionBudgetDTO) string {
+	switch {
+	case pdb.Selector == nil:
+		return "unset"
+	case pdb.SelectorString == "":
+		return "empty (all pods)"
+	default:
+		return pdb.SelectorString
+	}
+}
+
+func podsMatchSelector(items []dto.PodListItemDTO, sel labels.Selector) bool {
+	for _, p := range items {
+		if sel.Matches(labels.Set(p.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
 func detectStaleTransitionalHelmReleaseSignals(now time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
 	if !s.helmOK {
 		return nil
@@ -201,6 +334,10 @@ func detectServiceNoReadyEndpointsSignals(_ time.Time, ns string, s dashboardSna
 	}
 	var out []ClusterDashboardSignal
 	for _, svc := range EnrichServiceListItemsForAPI(s.svcs.Items) {
+		if s.podsOK && len(serviceUnreadyPods(svc, s.pods.Items)) > 0 {
+			// Reported by service_readiness_failing.
+			continue
+		}
 		if svc.NeedsAttention {
 			f := dashboardSignalItem("service_no_ready_endpoints", "Service", ns, svc.Name, "medium", 66, "Service has no ready endpoints.", "medium", "services")
 			f.ActualData = fmt.Sprintf("ready %d, not ready %d endpoints", svc.EndpointsReady, svc.EndpointsNotReady)
@@ -211,6 +348,69 @@ func detectServiceNoReadyEndpointsSignals(_ time.Time, ns string, s dashboardSna
 	return out
 }
 
+// detectServiceReadinessFailingSignals reports services with no ready endpoints whose
+// selector matches running pods that are failing readiness, naming those pods.
+func detectServiceReadinessFailingSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
+	if !s.svcsOK || !s.podsOK {
+		return nil
+	}
+	var out []ClusterDashboardSignal
+	for _, svc := range s.svcs.Items {
+		unready := serviceUnreadyPods(svc, s.pods.Items)
+		if len(unready) == 0 {
+			continue
+		}
+		reason := fmt.Sprintf("Service has no ready endpoints; selected pods are failing readiness: %s.", signalNameList(unready, 5))
+		f := dashboardSignalItem("service_readiness_failing", "Service", ns, svc.Name, "high", 81, reason, "high", "services")
+		f.ActualData = fmt.Sprintf("ready 0, not ready %d endpoints, %d running pod%s not ready", svc.EndpointsNotReady, len(unready), pluralSuffix(len(unready)))
+		f.CalculatedData = "selector " + labels.SelectorFromSet(svc.Selector).String()
+		out = append(out, f)
+	}
+	return out
+}
+
+// serviceUnreadyPods returns the running pods selected by a service without ready
+// endpoints whose containers are not all ready.
+func serviceUnreadyPods(svc dto.ServiceListItemDTO, pods []dto.PodListItemDTO) []string {
+	if svc.Type == "ExternalName" || len(svc.Selector) == 0 || svc.EndpointsReady > 0 {
+		return nil
+	}
+	sel := labels.SelectorFromSet(svc.Selector)
+	var out []string
+	for _, p := range pods {
+		if p.Phase != "Running" || !sel.Matches(labels.Set(p.Labels)) {
+			continue
+		}
+		if ready, total, ok := parsePodReadyCount(p.Ready); ok && total > 0 && ready < total {
+			out = append(out, p.Name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// parsePodReadyCount parses the "ready/total" container count of a pod list row.
+func parsePodReadyCount(v string) (ready, total int, ok bool) {
+	parts := strings.Split(v, "/")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	ready, err1 := strconv.Atoi(parts[0])
+	total, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return 0, 0, false
+	}
+	return ready, total, true
+}
+
+// signalNameList joins names for signal text, keeping at most limit of them.
+func signalNameList(names []string, limit int) string {
+	if len(names) <= limit {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:limit], ", "), len(names)-limit)
+}
+
 func detectIngressPendingAddressSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
 	if !s.ingsOK {
 		return nil
@@ -252,6 +452,10 @@ func detectPVCNeedsAttentionSignals(_ time.Time, ns string, s dashboardSnapshotS
 		if !pvc.NeedsAttention {
 			continue
 		}
+		if _, missing := pvcMissingStorageClass(pvc, s); missing {
+			// Reported by pvc_storage_class_missing.
+			continue
+		}
 		severity := "medium"
 		score := 63
 		reason := "PersistentVolumeClaim is not bound or has a pending resize signal."
@@ -260,18 +464,111 @@ func detectPVCNeedsAttentionSignals(_ time.Time, ns string, s dashboardSnapshotS
 			score = 84
 			reason = "PersistentVolumeClaim is in a degraded phase."
 		}
+		calculated := fmt.Sprintf("binding health %s", pvc.HealthBucket)
+		if pvc.ResizePending {
+			calculated = "requested storage differs from current capacity"
+		}
+		if explained, ok := explainPendingPVC(pvc, s); ok {
+			reason, calculated = explained.reason, explained.calculated
+			if explained.expected {
+				severity, score = "low", 30
+			}
+		}
 		f := dashboardSignalItem("pvc_needs_attention", "PersistentVolumeClaim", ns, pvc.Name, severity, score, reason, "medium", "persistentvolumeclaims")
 		f.ActualData = fmt.Sprintf("phase %s, requested %s, capacity %s", pvc.Phase, pvc.RequestedStorage, pvc.Capacity)
-		if pvc.ResizePending {
-			f.CalculatedData = "requested storage differs from current capacity"
-		} else {
-			f.CalculatedData = fmt.Sprintf("binding health %s", pvc.HealthBucket)
+		f.CalculatedData = calculated
+		out = append(out, f)
+	}
+	return out
+}
+
+type pendingPVCExplanation struct {
+	reason     string
+	calculated string
+	// expected is true when Pending is the normal state until a consumer pod appears.
+	expected bool
+}
+
+// explainPendingPVC says why a Pending claim has not bound yet, using the cached
+// StorageClass snapshot. It returns false when the claim is not Pending or the snapshot
+// is unavailable.
+func explainPendingPVC(pvc dto.PersistentVolumeClaimDTO, s dashboardSnapshotSet) (pendingPVCExplanation, bool) {
+	if !s.storageClassesOK || !strings.EqualFold(strings.TrimSpace(pvc.Phase), "Pending") {
+		return pendingPVCExplanation{}, false
+	}
+	if pvc.StorageClassName == "" {
+		calculated := "no storageClassName; dynamic provisioning is disabled for this claim"
+		if !hasDefaultStorageClass(s.storageClasses.Items) {
+			calculated = "no storageClassName and the cluster has no default StorageClass"
+		}
+		return pendingPVCExplanation{
+			reason:     "PersistentVolumeClaim has no StorageClass and waits for a matching pre-provisioned PersistentVolume.",
+			calculated: calculated,
+		}, true
+	}
+	for _, sc := range s.storageClasses.Items {
+		if sc.Name != pvc.StorageClassName {
+			continue
+		}
+		if sc.VolumeBindingMode == "WaitForFirstConsumer" {
+			return pendingPVCExplanation{
+				reason:     fmt.Sprintf("StorageClass %s uses WaitForFirstConsumer; the claim binds once a pod using it is scheduled.", sc.Name),
+				calculated: "volume binding is deferred until a consumer pod exists",
+				expected:   true,
+			}, true
 		}
+		return pendingPVCExplanation{
+			reason:     fmt.Sprintf("PersistentVolumeClaim is waiting for provisioner %s to create a volume.", sc.Provisioner),
+			calculated: fmt.Sprintf("storage class %s, binding mode %s", sc.Name, sc.VolumeBindingMode),
+		}, true
+	}
+	return pendingPVCExplanation{}, false
+}
+
+func detectPVCStorageClassMissingSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
+	if !s.pvcsOK || !s.storageClassesOK {
+		return nil
+	}
+	var out []ClusterDashboardSignal
+	for _, pvc := range s.pvcs.Items {
+		className, missing := pvcMissingStorageClass(pvc, s)
+		if !missing {
+			continue
+		}
+		severity, score := "high", 82
+		reason := fmt.Sprintf("PersistentVolumeClaim references StorageClass %s, which does not exist; it cannot be provisioned.", className)
+		if !strings.EqualFold(strings.TrimSpace(pvc.Phase), "Pending") {
+			severity, score = "low", 34
+			reason = fmt.Sprintf("PersistentVolumeClaim references StorageClass %s, which no longer exists.", className)
+		}
+		f := dashboardSignalItem("pvc_storage_class_missing", "PersistentVolumeClaim", ns, pvc.Name, severity, score, reason, "high", "persistentvolumeclaims")
+		f.ActualData = fmt.Sprintf("phase %s, storage class %s", pvc.Phase, className)
 		out = append(out, f)
 	}
 	return out
 }
 
+func pvcMissingStorageClass(pvc dto.PersistentVolumeClaimDTO, s dashboardSnapshotSet) (string, bool) {
+	if !s.storageClassesOK || pvc.StorageClassName == "" {
+		return "", false
+	}
+	for _, sc := range s.storageClasses.Items {
+		if sc.Name == pvc.StorageClassName {
+			return "", false
+		}
+	}
+	return pvc.StorageClassName, true
+}
+
+func hasDefaultStorageClass(items []dto.StorageClassDTO) bool {
+	for _, sc := range items {
+		if sc.IsDefault {
+			return true
+		}
+	}
+	return false
+}
+
 func detectRolePermissionSurfaceSignals(_ time.Time, ns string, s dashboardSnapshotSet) []ClusterDashboardSignal {
 	if !s.rolesOK {
 		return nil
@@ -487,6 +784,61 @@ func detectContainerNearLimitSignals(_ time.Time, ns string, s dashboardSnapshot
 	return out
 }
 
+// detectClusterScopedSignals runs the detectors that read cluster-scoped snapshots. Like
+// the namespace detectors, it only uses cached snapshots.
+func detectClusterScopedSignals(now time.Time, plane *clusterPlane, nodesSnap NodesSnapshot, thresholds resolvedSignalThresholds) []ClusterDashboardSignal {
+	out := detectNodeResourcePressureSignals(now, plane, nodesSnap, thresholds.NodeResourcePressurePct)
+	if plane == nil {
+		return out
+	}
+	if scSnap, ok := peekClusterSnapshot(&plane.storageClassesStore); ok && scSnap.Err == nil {
+		out = append(out, detectNoDefaultStorageClassSignals(scSnap)...)
+	}
+	if vaSnap, ok := peekClusterSnapshot(&plane.volumeAttachmentsStore); ok && vaSnap.Err == nil {
+		out = append(out, detectVolumeAttachmentStuckDetachingSignals(now, vaSnap)...)
+	}
+	return out
+}
+
+func detectNoDefaultStorageClassSignals(scSnap StorageClassesSnapshot) []ClusterDashboardSignal {
+	if hasDefaultStorageClass(scSnap.Items) {
+		return nil
+	}
+	reason := "No StorageClass is marked as the cluster default."
+	if len(scSnap.Items) == 0 {
+		reason = "The cluster has no StorageClasses."
+	}
+	f := dashboardSignalItem("no_default_storage_class", "StorageClass", "", "", "medium", 58, reason, "high", "storageclasses")
+	f.Scope = "cluster"
+	f.ScopeLocation = ""
+	f.ActualData = fmt.Sprintf("storage classes %d, default none", len(scSnap.Items))
+	return []ClusterDashboardSignal{f}
+}
+
+// detectVolumeAttachmentStuckDetachingSignals flags attachments that report a detach
+// error, or are still attached long after being marked for deletion.
+func detectVolumeAttachmentStuckDetachingSignals(now time.Time, vaSnap VolumeAttachmentsSnapshot) []ClusterDashboardSignal {
+	var out []ClusterDashboardSignal
+	for _, va := range vaSnap.Items {
+		if status, _, stuck := volumeAttachmentListSignals(va, now); status != "detaching" || !stuck {
+			continue
+		}
+		severity, score := "medium", 66
+		reason := fmt.Sprintf("VolumeAttachment has been detaching from node %s for more than %s.", va.NodeName, humanizeSignalDuration(signalVolumeDetachStuckDuration))
+		if va.DetachError != "" {
+			severity, score = "high", 83
+			reason = fmt.Sprintf("VolumeAttachment cannot detach from node %s: %s", va.NodeName, va.DetachError)
+		}
+		f := dashboardSignalItem("volume_attachment_stuck_detaching", "VolumeAttachment", "", va.Name, severity, score, reason, "high", "volumeattachments")
+		f.Scope = "cluster"
+		f.ScopeLocation = ""
+		f.ActualData = fmt.Sprintf("volume %s, node %s, attacher %s, deleting for %s", va.PersistentVolumeName, va.NodeName, va.Attacher, humanizeSignalDuration(now.Sub(time.Unix(va.DeletionTimestamp, 0))))
+		f.CalculatedData = fmt.Sprintf("still attached after deletion; threshold %s", humanizeSignalDuration(signalVolumeDetachStuckDuration))
+		out = append(out, f)
+	}
+	return out
+}
+
 // detectNodeResourcePressureSignals flags nodes whose CPU or memory usage is
 // at or above the configured percentage of allocatable capacity. Runs once at
 // cluster scope (not per namespace). Uses the cached cluster-scope node
@@ -293,9 +293,9 @@ func DashboardSignalCatalog(policy DataplanePolicy, contextName string) []Signal
 
 func defaultDashboardSignalSeverity(signalType string) string {
 	switch signalType {
-	case "abnormal_job", "abnormal_cronjob", "stale_transitional_helm_release", "pod_missing_secret_reference":
+	case "abnormal_job", "abnormal_cronjob", "stale_transitional_helm_release", "pod_missing_secret_reference", "pvc_storage_class_missing", "service_readiness_failing":
 		return "high"
-	case "empty_namespace", "long_running_job", "cronjob_no_recent_success", "hpa_needs_attention", "resource_quota_pressure", "pvc_needs_attention", "service_no_ready_endpoints", "ingress_pending_address", "ingress_needs_attention", "container_near_limit", "node_resource_pressure", "pod_young_frequent_restarts", "deployment_unavailable", "deployment_missing_template_reference":
+	case "empty_namespace", "long_running_job", "cronjob_no_recent_success", "hpa_needs_attention", "resource_quota_pressure", "pvc_needs_attention", "service_no_ready_endpoints", "ingress_pending_address", "ingress_needs_attention", "container_near_limit", "node_resource_pressure", "pod_young_frequent_restarts", "deployment_unavailable", "deployment_missing_template_reference", "pdb_zero_disruptions", "no_default_storage_class", "volume_attachment_stuck_detaching":
 		return "medium"
 	default:
 		return "low"
@@ -366,6 +366,33 @@ var dashboardSignalDefinitions = map[string]dashboardSignalDefinition{
 		SuggestedAction: "Inspect HPA conditions, metric targets, and the referenced workload. Check metrics-server/custom metrics health before changing replica bounds.",
 		Priority:        4,
 	},
+	"pdb_zero_disruptions": {
+		Type:            "pdb_zero_disruptions",
+		Label:           "PDBs blocking disruptions",
+		SummaryCounter:  "pdb_warnings",
+		CalculatedData:  "disruptionsAllowed is 0 while the budget covers pods",
+		LikelyCause:     "Covered pods are unhealthy, or minAvailable/maxUnavailable leaves no headroom for the current replica count, so node drains and evictions will block.",
+		SuggestedAction: "Restore the unhealthy pods or scale the workload up, or relax minAvailable/maxUnavailable before draining nodes or starting a cluster upgrade.",
+		Priority:        4,
+	},
+	"pdb_no_matching_pods": {
+		Type:            "pdb_no_matching_pods",
+		Label:           "PDBs without pods",
+		SummaryCounter:  "pdb_warnings",
+		CalculatedData:  "selector matches no pods in cached namespace snapshot",
+		LikelyCause:     "The selector may be missing, misspelled, or left behind after the protected workload was renamed or removed.",
+		SuggestedAction: "Compare the PDB selector with the workload pod labels. Fix the selector or delete the budget if it no longer protects anything.",
+		Priority:        7,
+	},
+	"workload_without_pdb": {
+		Type:            "workload_without_pdb",
+		Label:           "Workloads without PDB",
+		SummaryCounter:  "pdb_warnings",
+		CalculatedData:  "multiple replicas and no PodDisruptionBudget selecting its pods",
+		LikelyCause:     "The workload was deployed without a disruption budget, so a node drain may evict all replicas at once.",
+		SuggestedAction: "Add a PodDisruptionBudget that selects the workload pods with a minAvailable or maxUnavailable matching its availability needs.",
+		Priority:        7,
+	},
 	"resource_quota_pressure": {
 		Type:            "resource_quota_pressure",
 		Label:           "Quota pressure",
@@ -384,6 +411,33 @@ var dashboardSignalDefinitions = map[string]dashboardSignalDefinition{
 		SuggestedAction: "Inspect PVC events, storage class, requested capacity, bound volume, and provisioner health. Resolve binding or resize failures before changing workloads that depend on the claim.",
 		Priority:        5,
 	},
+	"pvc_storage_class_missing": {
+		Type:            "pvc_storage_class_missing",
+		Label:           "PVCs with missing StorageClass",
+		SummaryCounter:  "pvc_warnings",
+		CalculatedData:  "storageClassName not present in the cluster StorageClass snapshot",
+		LikelyCause:     "The claim names a StorageClass that was never created, was renamed, or was deleted, so no provisioner will act on it.",
+		SuggestedAction: "Create the StorageClass or recreate the claim with an existing class. Bound claims keep working, but new claims from the same manifest will stay Pending.",
+		Priority:        5,
+	},
+	"no_default_storage_class": {
+		Type:            "no_default_storage_class",
+		Label:           "No default StorageClass",
+		SummaryCounter:  "storage_warnings",
+		CalculatedData:  "no StorageClass carries the is-default-class annotation",
+		LikelyCause:     "The default annotation was removed or never set, so claims without storageClassName are not dynamically provisioned.",
+		SuggestedAction: "Mark one StorageClass with storageclass.kubernetes.io/is-default-class=true, or set storageClassName explicitly on every claim.",
+		Priority:        5,
+	},
+	"volume_attachment_stuck_detaching": {
+		Type:            "volume_attachment_stuck_detaching",
+		Label:           "Volumes stuck detaching",
+		SummaryCounter:  "storage_warnings",
+		CalculatedData:  "attachment still attached after deletion, or reporting a detach error",
+		LikelyCause:     "The CSI driver or cloud API cannot detach the volume, often because the node is unreachable or the volume is still mounted, which blocks pods that need it elsewhere.",
+		SuggestedAction: "Inspect the attacher's controller logs and the node. Resolve the detach error or recover the node; remove the attachment finalizer only after confirming the volume is detached at the storage backend.",
+		Priority:        3,
+	},
 	"potentially_unused_pvc": {
 		Type:            "potentially_unused_pvc",
 		Label:           "Potentially unused PVCs",
@@ -402,6 +456,15 @@ var dashboardSignalDefinitions = map[string]dashboardSignalDefinition{
 		SuggestedAction: "Inspect the service endpoints and selector labels, then open the selected workloads or pods to restore ready backends.",
 		Priority:        6,
 	},
+	"service_readiness_failing": {
+		Type:            "service_readiness_failing",
+		Label:           "Service readiness failing",
+		SummaryCounter:  "service_warnings",
+		CalculatedData:  "selected pods are running but not ready",
+		LikelyCause:     "The pods behind the service are running, but their readiness probes fail, so the EndpointSlices list them as not ready and traffic has no backend.",
+		SuggestedAction: "Open the named pods and check readiness probe events, container logs, and the dependencies the probe checks.",
+		Priority:        6,
+	},
 	"ingress_pending_address": {
 		Type:            "ingress_pending_address",
 		Label:           "Ingress pending address",
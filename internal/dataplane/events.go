@@ -0,0 +1,310 @@
+package dataplane
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Dataplane event types delivered to SubscribeEvents consumers.
+const (
+	DataplaneEventRevision = "revision"
+	DataplaneEventSignal   = "signal"
+)
+
+// Signal change values carried by DataplaneEventSignal events.
+const (
+	DataplaneSignalAppeared = "appeared"
+	DataplaneSignalCleared  = "cleared"
+)
+
+// dataplaneSignalDebounce coalesces bursts of revision bumps (watch batches, warm-up
+// fan-out) into a single signal recomputation per cluster/namespace scope.
+const dataplaneSignalDebounce = 500 * time.Millisecond
+
+// DataplaneEvent is one push notification about cached dataplane state. Revision events
+// mirror ListSnapshotRevision bumps; signal events report dashboard signals that appeared
+// or cleared since the previous detection pass for the same scope.
+type DataplaneEvent struct {
+	ID        uint64                `json:"id"`
+	Type      string                `json:"type"`
+	Context   string                `json:"context"`
+	Kind      ResourceKind          `json:"kind,omitempty"`
+	Namespace string                `json:"namespace,omitempty"`
+	Revision  uint64                `json:"revision,omitempty"`
+	Signal    *DataplaneSignalEvent `json:"signal,omitempty"`
+	At        int64                 `json:"at"`
+}
+
+// DataplaneSignalEvent identifies a signal that appeared or cleared.
+type DataplaneSignalEvent struct {
+	Change       string `json:"change"`
+	SignalType   string `json:"signalType,omitempty"`
+	Severity     string `json:"severity"`
+	Kind         string `json:"kind"`
+	Name         string `json:"name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	ResourceKind string `json:"resourceKind,omitempty"`
+}
+
+type dataplaneEventSubscriber struct {
+	ch     chan DataplaneEvent
+	closed bool
+}
+
+// dataplaneEventHub fans dataplane events out to subscribers. Publishing never blocks:
+// a subscriber whose buffer is full is dropped and its channel closed, so the consumer
+// knows it lagged and must resync from the list endpoints.
+type dataplaneEventHub struct {
+	mu     sync.Mutex
+	subs   map[*dataplaneEventSubscriber]struct{}
+	nextID uint64
+	active atomic.Int32
+
+	// signals recomputes the policy-filtered signals for one scope from cached
+	// snapshots; namespace "" means cluster-scoped (node) signals.
+	signals func(cluster, namespace string) []ClusterDashboardSignal
+
+	signalMu     sync.Mutex
+	lastSignals  map[string]map[string]ClusterDashboardSignal
+	pendingScans map[string]bool
+}
+
+func newDataplaneEventHub(signals func(cluster, namespace string) []ClusterDashboardSignal) *dataplaneEventHub {
+	return &dataplaneEventHub{
+		subs:         map[*dataplaneEventSubscriber]struct{}{},
+		signals:      signals,
+		lastSignals:  map[string]map[string]ClusterDashboardSignal{},
+		pendingScans: map[string]bool{},
+	}
+}
+
+// Subscribe registers a consumer. The returned cancel func is idempotent and closes the
+// channel; the channel is also closed early when the subscriber lags behind.
+func (h *dataplaneEventHub) Subscribe(buffer int) (<-chan DataplaneEvent, func()) {
+	if buffer <= 0 {
+		buffer = 64
+	}
+	sub := &dataplaneEventSubscriber{ch: make(chan DataplaneEvent, buffer)}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.active.Store(int32(len(h.subs)))
+	h.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			h.dropLocked(sub)
+			h.mu.Unlock()
+		})
+	}
+}
+
+func (h *dataplaneEventHub) dropLocked(sub *dataplaneEventSubscriber) {
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		h.active.Store(int32(len(h.subs)))
+	}
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+	if len(h.subs) == 0 {
+		// Baselines are only meaningful while someone is listening; a later subscriber
+		// should not receive a burst of diffs against state it never saw.
+		h.signalMu.Lock()
+		h.lastSignals = map[string]map[string]ClusterDashboardSignal{}
+		h.signalMu.Unlock()
+	}
+}
+
+func (h *dataplaneEventHub) hasSubscribers() bool {
+	return h != nil && h.active.Load() > 0
+}
+
+func (h *dataplaneEventHub) publish(ev DataplaneEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) == 0 {
+		return
+	}
+	h.nextID++
+	ev.ID = h.nextID
+	if ev.At == 0 {
+		ev.At = time.Now().UTC().Unix()
+	}
+	for sub := range h.subs {
+		select {
+		case sub.ch <- ev:
+		default:
+			h.dropLocked(sub)
+		}
+	}
+}
+
+// publishRevision reports a revision bump for one list cell and schedules a debounced
+// signal diff for the scope the kind feeds.
+func (h *dataplaneEventHub) publishRevision(cluster string, kind ResourceKind, namespace string, revision uint64) {
+	if !h.hasSubscribers() {
+		return
+	}
+	h.publish(DataplaneEvent{
+		Type:      DataplaneEventRevision,
+		Context:   cluster,
+		Kind:      kind,
+		Namespace: namespace,
+		Revision:  revision,
+	})
+	if scope, ok := signalScopeForKind(kind, namespace); ok {
+		h.scheduleSignalScan(cluster, scope)
+	}
+}
+
+// signalScopeForKind maps a list cell to the signal detection scope it contributes to.
+// Node, node metrics, StorageClass and VolumeAttachment lists feed cluster-scoped
+// signals; other cluster-wide lists and generic resource lists do not feed any detector.
+func signalScopeForKind(kind ResourceKind, namespace string) (string, bool) {
+	if isGenericResourceKind(kind) {
+		return "", false
+	}
+	switch kind {
+	case ResourceKindNodes, ResourceKindNodeMetrics, ResourceKindStorageClasses, ResourceKindVolumeAttachments:
+		return "", true
+	case ResourceKindNamespaces, ResourceKindPersistentVolumes, ResourceKindClusterRoles,
+		ResourceKindClusterRoleBindings, ResourceKindCRDs, ResourceKindCSIDrivers:
+		return "", false
+	}
+	if namespace == "" {
+		return "", false
+	}
+	return namespace, true
+}
+
+func (h *dataplaneEventHub) scheduleSignalScan(cluster, scope string) {
+	if h.signals == nil {
+		return
+	}
+	key := cluster + "\x00" + scope
+	h.signalMu.Lock()
+	if h.pendingScans[key] {
+		h.signalMu.Unlock()
+		return
+	}
+	h.pendingScans[key] = true
+	h.signalMu.Unlock()
+
+	time.AfterFunc(dataplaneSignalDebounce, func() {
+		h.signalMu.Lock()
+		delete(h.pendingScans, key)
+		h.signalMu.Unlock()
+		h.scanSignals(cluster, scope)
+	})
+}
+
+// scanSignals recomputes signals for a scope and publishes appeared/cleared diffs. The
+// first pass for a scope only records a baseline.
+func (h *dataplaneEventHub) scanSignals(cluster, scope string) {
+	if !h.hasSubscribers() {
+		return
+	}
+	current := map[string]ClusterDashboardSignal{}
+	for _, item := range h.signals(cluster, scope) {
+		current[signalHistoryIdentity(item)] = item
+	}
+
+	key := cluster + "\x00" + scope
+	h.signalMu.Lock()
+	prev, hadBaseline := h.lastSignals[key]
+	h.lastSignals[key] = current
+	h.signalMu.Unlock()
+	if !hadBaseline {
+		return
+	}
+
+	for _, ev := range diffDataplaneSignals(prev, current) {
+		ev.Context = cluster
+		ev.Namespace = scope
+		h.publish(ev)
+	}
+}
+
+// diffDataplaneSignals returns signal events for identities added to or removed from
+// the set, in stable identity order.
+func diffDataplaneSignals(prev, current map[string]ClusterDashboardSignal) []DataplaneEvent {
+	var out []DataplaneEvent
+	appendChange := func(keys []string, set map[string]ClusterDashboardSignal, change string) {
+		sort.Strings(keys)
+		for _, k := range keys {
+			item := set[k]
+			out = append(out, DataplaneEvent{
+				Type: DataplaneEventSignal,
+				Signal: &DataplaneSignalEvent{
+					Change:       change,
+					SignalType:   item.SignalType,
+					Severity:     item.Severity,
+					Kind:         item.Kind,
+					Name:         item.Name,
+					Namespace:    item.Namespace,
+					Reason:       item.Reason,
+					ResourceKind: item.ResourceKind,
+				},
+			})
+		}
+	}
+	var appeared, cleared []string
+	for k := range current {
+		if _, ok := prev[k]; !ok {
+			appeared = append(appeared, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := current[k]; !ok {
+			cleared = append(cleared, k)
+		}
+	}
+	appendChange(appeared, current, DataplaneSignalAppeared)
+	appendChange(cleared, prev, DataplaneSignalCleared)
+	return out
+}
+
+// planeEventSink is shared by every store of a cluster plane. The manager attaches its
+// hub after building the plane, so planes built directly (tests, tooling) publish nothing.
+type planeEventSink struct {
+	hub atomic.Pointer[dataplaneEventHub]
+}
+
+func (s *planeEventSink) revision(cluster string, kind ResourceKind, namespace string, revision uint64) {
+	if s == nil {
+		return
+	}
+	if h := s.hub.Load(); h != nil {
+		h.publishRevision(cluster, kind, namespace, revision)
+	}
+}
+
+// SubscribeEvents registers a consumer for revision and signal events across all clusters.
+func (m *manager) SubscribeEvents(buffer int) (<-chan DataplaneEvent, func()) {
+	return m.events.Subscribe(buffer)
+}
+
+// eventSignals recomputes policy-filtered signals for one scope from cached snapshots
+// only; it never schedules kube reads.
+func (m *manager) eventSignals(cluster, namespace string) []ClusterDashboardSignal {
+	m.mu.RLock()
+	plane := m.planes[cluster]
+	m.mu.RUnlock()
+	if plane == nil {
+		return nil
+	}
+	policy := m.EffectivePolicy(cluster)
+	thresholds := signalThresholdsFromPolicy(policy)
+	now := time.Now()
+	if namespace == "" {
+		nodesSnap, _ := peekClusterSnapshot(&plane.nodesStore)
+		return applySignalPolicy(detectClusterScopedSignals(now, plane, nodesSnap, thresholds), policy, cluster)
+	}
+	return applySignalPolicy(detectDashboardSignals(now, namespace, buildSnapshotSetForNamespace(plane, namespace, thresholds)), policy, cluster)
+}
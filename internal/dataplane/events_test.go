@@ -0,0 +1,102 @@
+package dataplane
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestDataplaneEventHubPublishesRevisionsFromStores(t *testing.T) {
+	hub := newDataplaneEventHub(nil)
+	p := newClusterPlane("c", ProfileFocused, DiscoveryModeTargeted, ObservationScope{}, nil, nil, nil)
+	p.events.hub.Store(hub)
+
+	// Without subscribers nothing is buffered or numbered.
+	setNamespacedSnapshot(&p.podsStore, "app", PodsSnapshot{Meta: SnapshotMetadata{ObservedAt: time.Now()}})
+
+	events, cancel := hub.Subscribe(4)
+	defer cancel()
+	setNamespacedSnapshot(&p.podsStore, "app", PodsSnapshot{Meta: SnapshotMetadata{ObservedAt: time.Now()}})
+	touchNamespacedSnapshot(&p.podsStore, "app", SnapshotMetadata{ObservedAt: time.Now()})
+	setClusterSnapshot(&p.nodesStore, NodesSnapshot{Meta: SnapshotMetadata{ObservedAt: time.Now()}})
+
+	first := <-events
+	if first.ID != 1 || first.Type != DataplaneEventRevision || first.Context != "c" || first.Kind != ResourceKindPods || first.Namespace != "app" || first.Revision != 2 {
+		t.Fatalf("unexpected pods event: %#v", first)
+	}
+	second := <-events
+	if second.Kind != ResourceKindNodes || second.Namespace != "" || second.Revision != 1 {
+		t.Fatalf("touch must not publish; unexpected event: %#v", second)
+	}
+}
+
+func TestDataplaneEventHubDropsLaggingSubscriber(t *testing.T) {
+	hub := newDataplaneEventHub(nil)
+	events, cancel := hub.Subscribe(1)
+	defer cancel()
+
+	hub.publishRevision("c", ResourceKindPods, "app", 1)
+	hub.publishRevision("c", ResourceKindPods, "app", 2)
+
+	if ev, ok := <-events; !ok || ev.Revision != 1 {
+		t.Fatalf("expected buffered event before close, got %#v ok=%v", ev, ok)
+	}
+	if _, ok := <-events; ok {
+		t.Fatalf("expected lagging subscriber channel to be closed")
+	}
+	if hub.hasSubscribers() {
+		t.Fatalf("lagging subscriber should be removed")
+	}
+}
+
+func TestDataplaneEventHubSignalDiffs(t *testing.T) {
+	current := []ClusterDashboardSignal{
+		{Kind: "Pod", Name: "a", Namespace: "app", SignalType: "pod_restarts", Severity: "medium"},
+	}
+	hub := newDataplaneEventHub(func(cluster, namespace string) []ClusterDashboardSignal {
+		return current
+	})
+	events, cancel := hub.Subscribe(8)
+	defer cancel()
+
+	hub.scanSignals("c", "app")
+	select {
+	case ev := <-events:
+		t.Fatalf("baseline scan should be silent, got %#v", ev)
+	default:
+	}
+
+	current = []ClusterDashboardSignal{
+		{Kind: "Deployment", Name: "web", Namespace: "app", SignalType: "deployment_unavailable", Severity: "high"},
+	}
+	hub.scanSignals("c", "app")
+
+	appeared := <-events
+	cleared := <-events
+	if appeared.Type != DataplaneEventSignal || appeared.Signal == nil || appeared.Signal.Change != DataplaneSignalAppeared || appeared.Signal.Name != "web" {
+		t.Fatalf("unexpected appeared event: %#v", appeared)
+	}
+	if cleared.Signal == nil || cleared.Signal.Change != DataplaneSignalCleared || cleared.Signal.Name != "a" || cleared.Context != "c" || cleared.Namespace != "app" {
+		t.Fatalf("unexpected cleared event: %#v", cleared)
+	}
+}
+
+func TestSignalScopeForKind(t *testing.T) {
+	if scope, ok := signalScopeForKind(ResourceKindNodeMetrics, ""); !ok || scope != "" {
+		t.Fatalf("node metrics should feed cluster scope")
+	}
+	if _, ok := signalScopeForKind(ResourceKindCRDs, ""); ok {
+		t.Fatalf("crds should not trigger signal scans")
+	}
+	if scope, ok := signalScopeForKind(ResourceKindVolumeAttachments, ""); !ok || scope != "" {
+		t.Fatalf("volume attachments should feed cluster scope")
+	}
+	if scope, ok := signalScopeForKind(ResourceKindPods, "app"); !ok || scope != "app" {
+		t.Fatalf("pods should feed their namespace scope")
+	}
+	leases := genericResourceKind(schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"})
+	if _, ok := signalScopeForKind(leases, "app"); ok {
+		t.Fatalf("generic resource lists should not trigger signal scans")
+	}
+}
@@ -0,0 +1,75 @@
+package dataplane
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	"github.com/korex-labs/kview/v5/internal/kube/resource/apiresources"
+)
+
+type GenericResourcesSnapshot = Snapshot[dto.GenericResourceListItemDTO]
+
+const genericResourceKindPrefix = "resources/"
+
+// genericResourceKind is the per-resource kind of a generic list, e.g.
+// "resources/coordination.k8s.io/v1/leases". The core group is spelled "core".
+func genericResourceKind(gvr schema.GroupVersionResource) ResourceKind {
+	group := gvr.Group
+	if group == "" {
+		group = apiresources.CoreGroupAlias
+	}
+	return ResourceKind(genericResourceKindPrefix + group + "/" + gvr.Version + "/" + gvr.Resource)
+}
+
+func isGenericResourceKind(kind ResourceKind) bool {
+	return strings.HasPrefix(string(kind), genericResourceKindPrefix)
+}
+
+func (p *clusterPlane) genericResourcesStore(kind ResourceKind) *namespacedSnapshotStore[GenericResourcesSnapshot] {
+	p.genericMu.Lock()
+	defer p.genericMu.Unlock()
+	if p.genericStores == nil {
+		p.genericStores = map[ResourceKind]*namespacedSnapshotStore[GenericResourcesSnapshot]{}
+	}
+	store, ok := p.genericStores[kind]
+	if !ok {
+		s := newNamespacedSnapshotStore[GenericResourcesSnapshot]()
+		s.configureTelemetry(p.stats, p.events, p.name, kind)
+		store = &s
+		p.genericStores[kind] = store
+	}
+	return store
+}
+
+// GenericResourcesSnapshot returns a raw snapshot for any API resource plus metadata and
+// any normalized error. Generic lists are not persisted: the set of resources is open
+// ended and they are only read while a user browses them.
+func (p *clusterPlane) GenericResourcesSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, gvr schema.GroupVersionResource, namespace string, prio WorkPriority) (GenericResourcesSnapshot, error) {
+	kind := genericResourceKind(gvr)
+	scope := CapabilityScopeCluster
+	if namespace != "" {
+		scope = CapabilityScopeNamespace
+	}
+	desc := namespacedSnapshotDescriptor[dto.GenericResourceListItemDTO]{
+		kind:        kind,
+		ttl:         p.currentPolicy().SnapshotTTL(ResourceKindGenericResources),
+		capGroup:    gvr.Group,
+		capResource: gvr.Resource,
+		capScope:    scope,
+		fetch: func(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.GenericResourceListItemDTO, error) {
+			return apiresources.ListResources(ctx, c, gvr, namespace)
+		},
+		skipPersistence: true,
+	}
+	return executeNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, p.genericResourcesStore(kind), desc)
+}
+
+func (m *manager) GenericResourcesSnapshot(ctx context.Context, clusterName string, gvr schema.GroupVersionResource, namespace string) (GenericResourcesSnapshot, error) {
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+	return plane.GenericResourcesSnapshot(ctx, m.scheduler, m.clients, gvr, namespace, WorkPriorityCritical)
+}
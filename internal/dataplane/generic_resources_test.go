@@ -0,0 +1,37 @@
+package dataplane
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGenericResourceKind(t *testing.T) {
+	core := genericResourceKind(schema.GroupVersionResource{Version: "v1", Resource: "endpoints"})
+	if core != "resources/core/v1/endpoints" {
+		t.Fatalf("core kind: got %q", core)
+	}
+	leases := genericResourceKind(schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"})
+	if leases != "resources/coordination.k8s.io/v1/leases" || !isGenericResourceKind(leases) {
+		t.Fatalf("leases kind: got %q", leases)
+	}
+	if isGenericResourceKind(ResourceKindPods) {
+		t.Fatal("built-in kinds are not generic")
+	}
+}
+
+func TestGenericResourcesStorePerKind(t *testing.T) {
+	p := newClusterPlane("c", ProfileFocused, DiscoveryModeTargeted, ObservationScope{}, nil, nil, nil)
+	leases := genericResourceKind(schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"})
+	pcs := genericResourceKind(schema.GroupVersionResource{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"})
+
+	if p.genericResourcesStore(leases) != p.genericResourcesStore(leases) {
+		t.Fatal("expected the same store for the same resource")
+	}
+	if p.genericResourcesStore(leases) == p.genericResourcesStore(pcs) {
+		t.Fatal("expected separate stores per resource")
+	}
+	if got := p.genericResourcesStore(pcs).telemetry.kind; got != pcs {
+		t.Fatalf("telemetry kind: got %q", got)
+	}
+}
@@ -362,6 +362,30 @@ func hpaListStatus(hpa dto.HorizontalPodAutoscalerDTO) string {
 	return hpa.HealthBucket
 }
 
+// EnrichPodDisruptionBudgetListItemsForAPI returns a shallow copy with disruption-budget health hints.
+func EnrichPodDisruptionBudgetListItemsForAPI(items []dto.PodDisruptionBudgetDTO) []dto.PodDisruptionBudgetDTO {
+	if len(items) == 0 {
+		return items
+	}
+	out := make([]dto.PodDisruptionBudgetDTO, len(items))
+	for i := range items {
+		pdb := items[i]
+		switch {
+		case pdb.Selector == nil || pdb.ExpectedPods == 0:
+			pdb.HealthBucket, pdb.NeedsAttention, pdb.ListStatus = deployBucketUnknown, true, "no pods"
+			pdb.ListSignalSeverity, pdb.ListSignalCount = "low", 1
+		case pdb.DisruptionsAllowed == 0:
+			pdb.HealthBucket, pdb.NeedsAttention, pdb.ListStatus = deployBucketDegraded, true, "blocking"
+			pdb.ListSignalSeverity, pdb.ListSignalCount = "medium", 1
+		default:
+			pdb.HealthBucket, pdb.NeedsAttention, pdb.ListStatus = deployBucketHealthy, false, deployBucketHealthy
+			pdb.ListSignalSeverity, pdb.ListSignalCount = listSignalOK, 0
+		}
+		out[i] = pdb
+	}
+	return out
+}
+
 // EnrichServiceListItemsForAPI returns a shallow copy with endpoint and exposure hints.
 func EnrichServiceListItemsForAPI(items []dto.ServiceListItemDTO) []dto.ServiceListItemDTO {
 	if len(items) == 0 {
@@ -846,6 +870,41 @@ func persistentVolumeBindingHint(pv dto.PersistentVolumeDTO) string {
 	return "unbound"
 }
 
+// EnrichVolumeAttachmentListItemsForAPI returns a shallow copy with attach/detach lifecycle hints.
+func EnrichVolumeAttachmentListItemsForAPI(items []dto.VolumeAttachmentDTO) []dto.VolumeAttachmentDTO {
+	if len(items) == 0 {
+		return items
+	}
+	now := time.Now()
+	out := make([]dto.VolumeAttachmentDTO, len(items))
+	for i := range items {
+		va := items[i]
+		va.ListStatus, va.HealthBucket, va.NeedsAttention = volumeAttachmentListSignals(va, now)
+		va.ListSignalSeverity, va.ListSignalCount = listSignalFromBucket(va.HealthBucket, va.NeedsAttention)
+		out[i] = va
+	}
+	return out
+}
+
+func volumeAttachmentListSignals(va dto.VolumeAttachmentDTO, now time.Time) (status, bucket string, needsAttention bool) {
+	switch {
+	case va.DeletionTimestamp > 0 && va.Attached:
+		stuck := va.DetachError != "" || now.Sub(time.Unix(va.DeletionTimestamp, 0)) >= signalVolumeDetachStuckDuration
+		if stuck {
+			return "detaching", deployBucketDegraded, true
+		}
+		return "detaching", deployBucketProgressing, false
+	case va.DeletionTimestamp > 0:
+		return "detached", deployBucketProgressing, false
+	case va.AttachError != "":
+		return "attach error", deployBucketDegraded, true
+	case !va.Attached:
+		return "attaching", deployBucketProgressing, false
+	default:
+		return "attached", deployBucketHealthy, false
+	}
+}
+
 // EnrichClusterRoleListItemsForAPI returns a shallow copy with coarse rules-count breadth hints.
 func EnrichClusterRoleListItemsForAPI(items []dto.ClusterRoleListItemDTO) []dto.ClusterRoleListItemDTO {
 	if len(items) == 0 {
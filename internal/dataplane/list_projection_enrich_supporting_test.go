@@ -68,3 +68,43 @@ func TestEnrichCRDListItemsForAPI(t *testing.T) {
 		t.Fatalf("unestablished crd signal unexpected: %+v", items[1])
 	}
 }
+
+func TestEnrichPodDisruptionBudgetListItemsForAPI(t *testing.T) {
+	sel := &dto.LabelSelectorDTO{MatchLabels: map[string]string{"app": "api"}}
+	got := EnrichPodDisruptionBudgetListItemsForAPI([]dto.PodDisruptionBudgetDTO{
+		{Name: "ok", Selector: sel, ExpectedPods: 3, DisruptionsAllowed: 1},
+		{Name: "blocking", Selector: sel, ExpectedPods: 2},
+		{Name: "empty"},
+	})
+	if got[0].HealthBucket != deployBucketHealthy || got[0].NeedsAttention || got[0].ListSignalSeverity != listSignalOK {
+		t.Fatalf("pdb 0 enrichment unexpected: %+v", got[0])
+	}
+	if got[1].ListStatus != "blocking" || !got[1].NeedsAttention || got[1].ListSignalSeverity != "medium" {
+		t.Fatalf("pdb 1 enrichment unexpected: %+v", got[1])
+	}
+	if got[2].ListStatus != "no pods" || !got[2].NeedsAttention || got[2].ListSignalSeverity != "low" {
+		t.Fatalf("pdb 2 enrichment unexpected: %+v", got[2])
+	}
+}
+
+func TestEnrichVolumeAttachmentListItemsForAPI(t *testing.T) {
+	now := time.Now()
+	got := EnrichVolumeAttachmentListItemsForAPI([]dto.VolumeAttachmentDTO{
+		{Name: "ok", Attached: true},
+		{Name: "pending"},
+		{Name: "failed", AttachError: "timeout"},
+		{Name: "stuck", Attached: true, DeletionTimestamp: now.Add(-time.Hour).Unix()},
+	})
+	if got[0].ListStatus != "attached" || got[0].NeedsAttention || got[0].ListSignalSeverity != listSignalOK {
+		t.Fatalf("attachment 0 enrichment unexpected: %+v", got[0])
+	}
+	if got[1].ListStatus != "attaching" || got[1].NeedsAttention {
+		t.Fatalf("attachment 1 enrichment unexpected: %+v", got[1])
+	}
+	if got[2].ListStatus != "attach error" || !got[2].NeedsAttention || got[2].ListSignalSeverity != "high" {
+		t.Fatalf("attachment 2 enrichment unexpected: %+v", got[2])
+	}
+	if got[3].ListStatus != "detaching" || got[3].HealthBucket != deployBucketDegraded || !got[3].NeedsAttention {
+		t.Fatalf("attachment 3 enrichment unexpected: %+v", got[3])
+	}
+}
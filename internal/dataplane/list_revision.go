@@ -27,7 +27,10 @@ func ListRevisionKindNeedsNamespace(k ResourceKind) bool {
 		ResourceKindPersistentVolumes,
 		ResourceKindClusterRoles,
 		ResourceKindClusterRoleBindings,
-		ResourceKindCRDs:
+		ResourceKindCRDs,
+		ResourceKindStorageClasses,
+		ResourceKindCSIDrivers,
+		ResourceKindVolumeAttachments:
 		return false
 	default:
 		return true
@@ -49,6 +52,12 @@ func ParseListRevisionResourceKind(s string) (ResourceKind, bool) {
 		return ResourceKindClusterRoleBindings, true
 	case string(ResourceKindCRDs):
 		return ResourceKindCRDs, true
+	case string(ResourceKindStorageClasses):
+		return ResourceKindStorageClasses, true
+	case string(ResourceKindCSIDrivers):
+		return ResourceKindCSIDrivers, true
+	case string(ResourceKindVolumeAttachments):
+		return ResourceKindVolumeAttachments, true
 	case string(ResourceKindPods):
 		return ResourceKindPods, true
 	case string(ResourceKindDeployments):
@@ -87,6 +96,10 @@ func ParseListRevisionResourceKind(s string) (ResourceKind, bool) {
 		return ResourceKindResourceQuotas, true
 	case string(ResourceKindLimitRanges):
 		return ResourceKindLimitRanges, true
+	case string(ResourceKindNetworkPolicies):
+		return ResourceKindNetworkPolicies, true
+	case string(ResourceKindPDBs):
+		return ResourceKindPDBs, true
 	default:
 		return "", false
 	}
@@ -141,6 +154,24 @@ func (p *clusterPlane) listSnapshotRevision(kind ResourceKind, namespace string)
 			return env
 		}
 		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
+	case ResourceKindStorageClasses:
+		snap, ok := peekClusterSnapshot(&p.storageClassesStore)
+		if !ok {
+			return env
+		}
+		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
+	case ResourceKindCSIDrivers:
+		snap, ok := peekClusterSnapshot(&p.csiDriversStore)
+		if !ok {
+			return env
+		}
+		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
+	case ResourceKindVolumeAttachments:
+		snap, ok := peekClusterSnapshot(&p.volumeAttachmentsStore)
+		if !ok {
+			return env
+		}
+		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
 	case ResourceKindPods:
 		snap, ok := peekNamespacedSnapshot(&p.podsStore, namespace)
 		if !ok {
@@ -255,6 +286,18 @@ func (p *clusterPlane) listSnapshotRevision(kind ResourceKind, namespace string)
 			return env
 		}
 		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
+	case ResourceKindNetworkPolicies:
+		snap, ok := peekNamespacedSnapshot(&p.netpolStore, namespace)
+		if !ok {
+			return env
+		}
+		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
+	case ResourceKindPDBs:
+		snap, ok := peekNamespacedSnapshot(&p.pdbStore, namespace)
+		if !ok {
+			return env
+		}
+		fillListRevisionEnvFromSnap(&env, snap, snap.Err)
 	default:
 		return env
 	}
@@ -74,6 +74,9 @@ func TestListSnapshotRevision_ClusterScopedKindsDoNotRequireNamespace(t *testing
 		ResourceKindClusterRoles,
 		ResourceKindClusterRoleBindings,
 		ResourceKindCRDs,
+		ResourceKindStorageClasses,
+		ResourceKindCSIDrivers,
+		ResourceKindVolumeAttachments,
 	}
 	for _, kind := range kinds {
 		if ListRevisionKindNeedsNamespace(kind) {
@@ -2,18 +2,20 @@ package dataplane
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 	crbindings "github.com/korex-labs/kview/v5/internal/kube/resource/clusterrolebindings"
 	clusterroles "github.com/korex-labs/kview/v5/internal/kube/resource/clusterroles"
 	configmaps "github.com/korex-labs/kview/v5/internal/kube/resource/configmaps"
 	cronjobs "github.com/korex-labs/kview/v5/internal/kube/resource/cronjobs"
+	csidrivers "github.com/korex-labs/kview/v5/internal/kube/resource/csidrivers"
 	crds "github.com/korex-labs/kview/v5/internal/kube/resource/customresourcedefinitions"
 	daemonsets "github.com/korex-labs/kview/v5/internal/kube/resource/daemonsets"
 	deployments "github.com/korex-labs/kview/v5/internal/kube/resource/deployments"
@@ -24,9 +26,11 @@ import (
 	limitranges "github.com/korex-labs/kview/v5/internal/kube/resource/limitranges"
 	kubemetrics "github.com/korex-labs/kview/v5/internal/kube/resource/metrics"
 	namespaces "github.com/korex-labs/kview/v5/internal/kube/resource/namespaces"
+	netpols "github.com/korex-labs/kview/v5/internal/kube/resource/networkpolicies"
 	nodes "github.com/korex-labs/kview/v5/internal/kube/resource/nodes"
 	pvcs "github.com/korex-labs/kview/v5/internal/kube/resource/persistentvolumeclaims"
 	pvs "github.com/korex-labs/kview/v5/internal/kube/resource/persistentvolumes"
+	pdbs "github.com/korex-labs/kview/v5/internal/kube/resource/poddisruptionbudgets"
 	pods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 	replicasets "github.com/korex-labs/kview/v5/internal/kube/resource/replicasets"
 	rquotas "github.com/korex-labs/kview/v5/internal/kube/resource/resourcequotas"
@@ -36,6 +40,8 @@ import (
 	serviceaccounts "github.com/korex-labs/kview/v5/internal/kube/resource/serviceaccounts"
 	svcs "github.com/korex-labs/kview/v5/internal/kube/resource/services"
 	statefulsets "github.com/korex-labs/kview/v5/internal/kube/resource/statefulsets"
+	storageclasses "github.com/korex-labs/kview/v5/internal/kube/resource/storageclasses"
+	volumeattachments "github.com/korex-labs/kview/v5/internal/kube/resource/volumeattachments"
 	"github.com/korex-labs/kview/v5/internal/runtime"
 )
 
@@ -114,6 +120,12 @@ type DataPlaneManager interface {
 	ClusterRoleBindingsSnapshot(ctx context.Context, clusterName string) (ClusterRoleBindingsSnapshot, error)
 	// CRDsSnapshot returns a raw snapshot for custom resource definitions in the given cluster.
 	CRDsSnapshot(ctx context.Context, clusterName string) (CRDsSnapshot, error)
+	// StorageClassesSnapshot returns a raw snapshot for storage classes in the given cluster.
+	StorageClassesSnapshot(ctx context.Context, clusterName string) (StorageClassesSnapshot, error)
+	// CSIDriversSnapshot returns a raw snapshot for CSI drivers in the given cluster.
+	CSIDriversSnapshot(ctx context.Context, clusterName string) (CSIDriversSnapshot, error)
+	// VolumeAttachmentsSnapshot returns a raw snapshot for volume attachments in the given cluster.
+	VolumeAttachmentsSnapshot(ctx context.Context, clusterName string) (VolumeAttachmentsSnapshot, error)
 	// PodsSnapshot returns a raw snapshot for pods in the given namespace.
 	PodsSnapshot(ctx context.Context, clusterName, namespace string) (PodsSnapshot, error)
 	// DeploymentsSnapshot returns a raw snapshot for deployments in the given namespace.
@@ -156,6 +168,8 @@ type DataPlaneManager interface {
 	InvalidateDaemonSetsSnapshot(ctx context.Context, clusterName, namespace string) error
 	// InvalidateJobsSnapshot drops the cached Job list for a namespace after a Job mutation.
 	InvalidateJobsSnapshot(ctx context.Context, clusterName, namespace string) error
+	// InvalidateCronJobsSnapshot drops the cached CronJob list for a namespace after a CronJob mutation.
+	InvalidateCronJobsSnapshot(ctx context.Context, clusterName, namespace string) error
 	// DaemonSetsSnapshot returns a raw snapshot for daemonsets in the given namespace.
 	DaemonSetsSnapshot(ctx context.Context, clusterName, namespace string) (DaemonSetsSnapshot, error)
 	// StatefulSetsSnapshot returns a raw snapshot for statefulsets in the given namespace.
@@ -172,6 +186,25 @@ type DataPlaneManager interface {
 	ResourceQuotasSnapshot(ctx context.Context, clusterName, namespace string) (ResourceQuotasSnapshot, error)
 	// LimitRangesSnapshot returns a raw snapshot for limit ranges in the given namespace.
 	LimitRangesSnapshot(ctx context.Context, clusterName, namespace string) (LimitRangesSnapshot, error)
+	// NetworkPoliciesSnapshot returns a raw snapshot for network policies in the given namespace.
+	NetworkPoliciesSnapshot(ctx context.Context, clusterName, namespace string) (NetworkPoliciesSnapshot, error)
+	// PodDisruptionBudgetsSnapshot returns a raw snapshot for pod disruption budgets in the given namespace.
+	PodDisruptionBudgetsSnapshot(ctx context.Context, clusterName, namespace string) (PodDisruptionBudgetsSnapshot, error)
+	// GenericResourcesSnapshot returns a raw snapshot for any API resource, listed through the
+	// dynamic client. An empty namespace lists cluster-scoped resources or all namespaces.
+	GenericResourcesSnapshot(ctx context.Context, clusterName string, gvr schema.GroupVersionResource, namespace string) (GenericResourcesSnapshot, error)
+	// NetworkReachability evaluates the cached NetworkPolicy snapshots for traffic from one pod to another.
+	NetworkReachability(ctx context.Context, clusterName string, req NetworkReachabilityRequest) (dto.NetworkReachabilityDTO, error)
+	// ResourceReferrers answers which cached pods, workloads, Ingresses and ServiceAccounts reference a ConfigMap, Secret, PVC or ServiceAccount.
+	ResourceReferrers(ctx context.Context, clusterName, namespace, kind, name string) (dto.ResourceReferrersDTO, error)
+	// NamespaceGraph returns the resource topology of a namespace, with signal severity per node.
+	NamespaceGraph(ctx context.Context, clusterName, namespace string) (dto.NamespaceGraphDTO, error)
+	// PodScheduling explains per node of the nodes snapshot why a pod does or does not fit.
+	PodScheduling(ctx context.Context, clusterName string, spec dto.PodSchedulingSpecDTO) (dto.PodSchedulingDTO, error)
+	// WhoCan answers which subjects the cached RBAC snapshots allow to perform an access, with the binding → role → rule path.
+	WhoCan(ctx context.Context, clusterName string, req WhoCanRequest) (dto.WhoCanDTO, error)
+	// ServiceAccountPermissions returns the effective permission matrix of a ServiceAccount from the cached RBAC snapshots.
+	ServiceAccountPermissions(ctx context.Context, clusterName, namespace, name string) (dto.ServiceAccountPermissionsDTO, error)
 	// NodeMetricsSnapshot returns a cluster-scoped node usage snapshot from metrics.k8s.io (not persisted).
 	// Triggers a live fetch via the scheduler when the cache is cold; intended for the
 	// background metrics warmer and for dedicated /api/nodemetrics callers, NOT for the
@@ -198,9 +231,15 @@ type DataPlaneManager interface {
 
 	// DashboardSummary returns a minimal cluster dashboard backed by dataplane snapshots.
 	DashboardSummary(ctx context.Context, clusterName string, opts ClusterDashboardListOptions) ClusterDashboardSummary
+	// FleetContextSummary returns one context's fleet dashboard row from cached snapshots only.
+	FleetContextSummary(ctx context.Context, clusterName string) FleetContextSummary
 
 	// ListSnapshotRevision returns revision metadata for a list cell without scheduling kube fetches.
 	ListSnapshotRevision(ctx context.Context, clusterName string, kind ResourceKind, namespace string) (ListSnapshotRevisionEnvelope, error)
+	// SubscribeEvents streams snapshot revision bumps and dashboard signal appear/clear
+	// changes for every cluster. Call cancel when done; the channel is closed early if the
+	// consumer falls more than buffer events behind.
+	SubscribeEvents(buffer int) (events <-chan DataplaneEvent, cancel func())
 
 	// NamespaceSummaryProjection builds namespace summary from dataplane snapshots (projection-led).
 	NamespaceSummaryProjection(ctx context.Context, clusterName, namespace string) (NamespaceSummaryProjection, error)
@@ -280,6 +319,10 @@ func (mc managerClients) GetClientsForContext(ctx context.Context, name string)
 	return mc.m.GetClientsForContext(ctx, name)
 }
 
+// maxImpersonatedPlanes caps how many "view as" identities keep a plane. Identities
+// come from a request header and are open ended, so the oldest plane is evicted first.
+const maxImpersonatedPlanes = 8
+
 // manager is the foundational implementation of DataPlaneManager: per-cluster planes,
 // scheduler-mediated snapshot reads, namespace summary projection, dashboard aggregate, and observers.
 type manager struct {
@@ -290,6 +333,8 @@ type manager struct {
 
 	mu     sync.RWMutex
 	planes map[string]*clusterPlane
+	// impersonatedPlanes lists the scoped names in planes, oldest first.
+	impersonatedPlanes []string
 
 	scheduler *workScheduler
 	clients   ClientsProvider
@@ -307,6 +352,7 @@ type manager struct {
 	signalHistory   map[string]map[string]signalHistoryRecord
 
 	nsEnrich *nsEnrichmentCoordinator
+	events   *dataplaneEventHub
 
 	nsSweepMu        sync.Mutex
 	nsSweepLast      map[string]map[string]time.Time
@@ -361,6 +407,7 @@ func NewManager(cfg ManagerConfig) DataPlaneManager {
 		nsSweepHourCount:     map[string]int{},
 		migration:            PersistenceMigrationStatus{Phase: PersistenceMigrationPhaseIdle},
 	}
+	m.events = newDataplaneEventHub(m.eventSignals)
 	if err := m.configurePersistence(policy); err != nil {
 		m.policy.Persistence.Enabled = false
 		m.bundle.Global.Persistence.Enabled = false
@@ -383,7 +430,8 @@ func (m *manager) EffectivePolicy(contextName string) DataplanePolicy {
 	m.policyMu.RLock()
 	bundle := CloneDataplanePolicyBundle(m.bundle)
 	m.policyMu.RUnlock()
-	return bundle.EffectivePolicy(contextName)
+	// Impersonated views share the policy of their kubeconfig context.
+	return bundle.EffectivePolicy(cluster.BaseContextName(contextName))
 }
 
 func (m *manager) SetPolicy(policy DataplanePolicy) DataplanePolicy {
@@ -520,12 +568,29 @@ func (m *manager) PlaneForCluster(_ context.Context, clusterName string) (Cluste
 		Namespaces:    nil,
 		ResourceKinds: nil,
 	}
+	// Impersonated planes are kept in memory only: persisted snapshots are keyed by
+	// context and must never serve another identity's view.
+	persistence := m.currentPersistence
+	impersonated := cluster.IsScopedContextName(clusterName)
+	if impersonated {
+		persistence = nil
+	}
 	p := newClusterPlane(clusterName, m.defaultProfile, m.defaultDiscoveryMode, scope, func() DataplanePolicy {
 		return m.EffectivePolicy(clusterName)
-	}, m.currentPersistence, m.stats)
+	}, persistence, m.stats)
+	p.events.hub.Store(m.events)
 	m.planes[clusterName] = p
+	if impersonated {
+		m.impersonatedPlanes = append(m.impersonatedPlanes, clusterName)
+		if len(m.impersonatedPlanes) > maxImpersonatedPlanes {
+			evicted := m.impersonatedPlanes[0]
+			m.impersonatedPlanes = m.impersonatedPlanes[1:]
+			m.planes[evicted].close()
+			delete(m.planes, evicted)
+		}
+	}
 	policy := m.EffectivePolicy(clusterName)
-	if policy.Persistence.Enabled {
+	if policy.Persistence.Enabled && !impersonated {
 		_ = p.hydratePersistedSnapshots(policy.PersistenceMaxAge())
 		m.ensureSignalHistory(clusterName)
 	}
@@ -589,6 +654,9 @@ type clusterPlane struct {
 	clusterRolesStore        snapshotStore[ClusterRolesSnapshot]
 	clusterRoleBindingsStore snapshotStore[ClusterRoleBindingsSnapshot]
 	crdsStore                snapshotStore[CRDsSnapshot]
+	storageClassesStore      snapshotStore[StorageClassesSnapshot]
+	csiDriversStore          snapshotStore[CSIDriversSnapshot]
+	volumeAttachmentsStore   snapshotStore[VolumeAttachmentsSnapshot]
 	// Metrics snapshots are cluster-scoped for nodes and namespaced for pods.
 	// These kinds are not persisted (see snapshot_exec.go skipPersistence);
 	// they are short-TTL and optional, only meaningful when metrics-server is
@@ -615,15 +683,36 @@ type clusterPlane struct {
 	hpaStore          namespacedSnapshotStore[HPAsSnapshot]
 	rqStore           namespacedSnapshotStore[ResourceQuotasSnapshot]
 	lrStore           namespacedSnapshotStore[LimitRangesSnapshot]
+	netpolStore       namespacedSnapshotStore[NetworkPoliciesSnapshot]
+	pdbStore          namespacedSnapshotStore[PodDisruptionBudgetsSnapshot]
 	podMetricsStore   namespacedSnapshotStore[PodMetricsSnapshot]
 
+	// Generic API resource lists, one store per resource; see generic_resources.go.
+	genericMu     sync.Mutex
+	genericStores map[ResourceKind]*namespacedSnapshotStore[GenericResourcesSnapshot]
+
 	// Observers state for this cluster.
 	obsMu     sync.Mutex
 	observers *clusterObservers
 
+	// Watch-backed snapshot workers (opt-in per kind via DataplanePolicy.Watch).
+	watches snapshotWatchRegistry
+
+	// lifetime is cancelled when the plane is evicted; observers run under it.
+	lifetime context.Context
+	stop     context.CancelFunc
+
 	policy      func() DataplanePolicy
 	persistence func() snapshotPersistence
 	stats       *dataplaneSessionStats
+	events      *planeEventSink
+}
+
+// close stops the observers and watchers of an evicted plane. Reads that still hold
+// the plane keep working; nothing refreshes it afterwards.
+func (p *clusterPlane) close() {
+	p.stop()
+	p.watches.stopAll()
 }
 
 func newClusterPlane(name string, profile Profile, mode DiscoveryMode, scope ObservationScope, policy func() DataplanePolicy, persistence func() snapshotPersistence, stats *dataplaneSessionStats) *clusterPlane {
@@ -659,38 +748,47 @@ func newClusterPlane(name string, profile Profile, mode DiscoveryMode, scope Obs
 		hpaStore:          newNamespacedSnapshotStore[HPAsSnapshot](),
 		rqStore:           newNamespacedSnapshotStore[ResourceQuotasSnapshot](),
 		lrStore:           newNamespacedSnapshotStore[LimitRangesSnapshot](),
+		netpolStore:       newNamespacedSnapshotStore[NetworkPoliciesSnapshot](),
+		pdbStore:          newNamespacedSnapshotStore[PodDisruptionBudgetsSnapshot](),
 		podMetricsStore:   newNamespacedSnapshotStore[PodMetricsSnapshot](),
 		policy:            policy,
 		persistence:       persistence,
 		stats:             stats,
-	}
-	p.nsStore.configureTelemetry(stats, name, ResourceKindNamespaces)
-	p.nodesStore.configureTelemetry(stats, name, ResourceKindNodes)
-	p.persistentVolumesStore.configureTelemetry(stats, name, ResourceKindPersistentVolumes)
-	p.clusterRolesStore.configureTelemetry(stats, name, ResourceKindClusterRoles)
-	p.clusterRoleBindingsStore.configureTelemetry(stats, name, ResourceKindClusterRoleBindings)
-	p.crdsStore.configureTelemetry(stats, name, ResourceKindCRDs)
-	p.podsStore.configureTelemetry(stats, name, ResourceKindPods)
-	p.depsStore.configureTelemetry(stats, name, ResourceKindDeployments)
-	p.svcsStore.configureTelemetry(stats, name, ResourceKindServices)
-	p.ingStore.configureTelemetry(stats, name, ResourceKindIngresses)
-	p.pvcsStore.configureTelemetry(stats, name, ResourceKindPVCs)
-	p.cmsStore.configureTelemetry(stats, name, ResourceKindConfigMaps)
-	p.secsStore.configureTelemetry(stats, name, ResourceKindSecrets)
-	p.saStore.configureTelemetry(stats, name, ResourceKindServiceAccounts)
-	p.rolesStore.configureTelemetry(stats, name, ResourceKindRoles)
-	p.roleBindingsStore.configureTelemetry(stats, name, ResourceKindRoleBindings)
-	p.helmReleasesStore.configureTelemetry(stats, name, ResourceKindHelmReleases)
-	p.dsStore.configureTelemetry(stats, name, ResourceKindDaemonSets)
-	p.stsStore.configureTelemetry(stats, name, ResourceKindStatefulSets)
-	p.rsStore.configureTelemetry(stats, name, ResourceKindReplicaSets)
-	p.jobsStore.configureTelemetry(stats, name, ResourceKindJobs)
-	p.cjStore.configureTelemetry(stats, name, ResourceKindCronJobs)
-	p.hpaStore.configureTelemetry(stats, name, ResourceKindHPAs)
-	p.rqStore.configureTelemetry(stats, name, ResourceKindResourceQuotas)
-	p.lrStore.configureTelemetry(stats, name, ResourceKindLimitRanges)
-	p.nodeMetricsStore.configureTelemetry(stats, name, ResourceKindNodeMetrics)
-	p.podMetricsStore.configureTelemetry(stats, name, ResourceKindPodMetrics)
+		events:            &planeEventSink{},
+	}
+	p.lifetime, p.stop = context.WithCancel(context.Background())
+	p.nsStore.configureTelemetry(stats, p.events, name, ResourceKindNamespaces)
+	p.nodesStore.configureTelemetry(stats, p.events, name, ResourceKindNodes)
+	p.persistentVolumesStore.configureTelemetry(stats, p.events, name, ResourceKindPersistentVolumes)
+	p.clusterRolesStore.configureTelemetry(stats, p.events, name, ResourceKindClusterRoles)
+	p.clusterRoleBindingsStore.configureTelemetry(stats, p.events, name, ResourceKindClusterRoleBindings)
+	p.crdsStore.configureTelemetry(stats, p.events, name, ResourceKindCRDs)
+	p.storageClassesStore.configureTelemetry(stats, p.events, name, ResourceKindStorageClasses)
+	p.csiDriversStore.configureTelemetry(stats, p.events, name, ResourceKindCSIDrivers)
+	p.volumeAttachmentsStore.configureTelemetry(stats, p.events, name, ResourceKindVolumeAttachments)
+	p.podsStore.configureTelemetry(stats, p.events, name, ResourceKindPods)
+	p.depsStore.configureTelemetry(stats, p.events, name, ResourceKindDeployments)
+	p.svcsStore.configureTelemetry(stats, p.events, name, ResourceKindServices)
+	p.ingStore.configureTelemetry(stats, p.events, name, ResourceKindIngresses)
+	p.pvcsStore.configureTelemetry(stats, p.events, name, ResourceKindPVCs)
+	p.cmsStore.configureTelemetry(stats, p.events, name, ResourceKindConfigMaps)
+	p.secsStore.configureTelemetry(stats, p.events, name, ResourceKindSecrets)
+	p.saStore.configureTelemetry(stats, p.events, name, ResourceKindServiceAccounts)
+	p.rolesStore.configureTelemetry(stats, p.events, name, ResourceKindRoles)
+	p.roleBindingsStore.configureTelemetry(stats, p.events, name, ResourceKindRoleBindings)
+	p.helmReleasesStore.configureTelemetry(stats, p.events, name, ResourceKindHelmReleases)
+	p.dsStore.configureTelemetry(stats, p.events, name, ResourceKindDaemonSets)
+	p.stsStore.configureTelemetry(stats, p.events, name, ResourceKindStatefulSets)
+	p.rsStore.configureTelemetry(stats, p.events, name, ResourceKindReplicaSets)
+	p.jobsStore.configureTelemetry(stats, p.events, name, ResourceKindJobs)
+	p.cjStore.configureTelemetry(stats, p.events, name, ResourceKindCronJobs)
+	p.hpaStore.configureTelemetry(stats, p.events, name, ResourceKindHPAs)
+	p.rqStore.configureTelemetry(stats, p.events, name, ResourceKindResourceQuotas)
+	p.lrStore.configureTelemetry(stats, p.events, name, ResourceKindLimitRanges)
+	p.netpolStore.configureTelemetry(stats, p.events, name, ResourceKindNetworkPolicies)
+	p.pdbStore.configureTelemetry(stats, p.events, name, ResourceKindPDBs)
+	p.nodeMetricsStore.configureTelemetry(stats, p.events, name, ResourceKindNodeMetrics)
+	p.podMetricsStore.configureTelemetry(stats, p.events, name, ResourceKindPodMetrics)
 	return p
 }
 
@@ -744,86 +842,96 @@ func (p *clusterPlane) hydratePersistedSnapshots(maxAge time.Duration) error {
 	}
 	for _, cell := range cells {
 		if cell.Namespace == "" {
-			if err := p.hydratePersistedClusterSnapshot(cell.Kind, cell.Payload, maxAge); err != nil {
+			if err := p.hydratePersistedClusterSnapshot(cell.Kind, cell.Payload, cell.Details, maxAge); err != nil {
 				return err
 			}
 			continue
 		}
-		if err := p.hydratePersistedNamespacedSnapshot(cell.Kind, cell.Namespace, cell.Payload, maxAge); err != nil {
+		if err := p.hydratePersistedNamespacedSnapshot(cell.Kind, cell.Namespace, cell.Payload, cell.Details, maxAge); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (p *clusterPlane) hydratePersistedClusterSnapshot(kind ResourceKind, payload []byte, maxAge time.Duration) error {
+func (p *clusterPlane) hydratePersistedClusterSnapshot(kind ResourceKind, payload, details []byte, maxAge time.Duration) error {
 	switch kind {
 	case ResourceKindNamespaces:
-		return hydratePersistedClusterSnapshotInto(&p.nsStore, payload, maxAge)
+		return hydratePersistedClusterSnapshotInto(&p.nsStore, payload, details, maxAge)
 	case ResourceKindNodes:
-		return hydratePersistedClusterSnapshotInto(&p.nodesStore, payload, maxAge)
+		return hydratePersistedClusterSnapshotInto(&p.nodesStore, payload, details, maxAge)
 	case ResourceKindPersistentVolumes:
-		return hydratePersistedClusterSnapshotInto(&p.persistentVolumesStore, payload, maxAge)
+		return hydratePersistedClusterSnapshotInto(&p.persistentVolumesStore, payload, details, maxAge)
 	case ResourceKindClusterRoles:
-		return hydratePersistedClusterSnapshotInto(&p.clusterRolesStore, payload, maxAge)
+		return hydratePersistedClusterSnapshotInto(&p.clusterRolesStore, payload, details, maxAge)
 	case ResourceKindClusterRoleBindings:
-		return hydratePersistedClusterSnapshotInto(&p.clusterRoleBindingsStore, payload, maxAge)
+		return hydratePersistedClusterSnapshotInto(&p.clusterRoleBindingsStore, payload, details, maxAge)
 	case ResourceKindCRDs:
-		return hydratePersistedClusterSnapshotInto(&p.crdsStore, payload, maxAge)
+		return hydratePersistedClusterSnapshotInto(&p.crdsStore, payload, details, maxAge)
+	case ResourceKindStorageClasses:
+		return hydratePersistedClusterSnapshotInto(&p.storageClassesStore, payload, details, maxAge)
+	case ResourceKindCSIDrivers:
+		return hydratePersistedClusterSnapshotInto(&p.csiDriversStore, payload, details, maxAge)
+	case ResourceKindVolumeAttachments:
+		return hydratePersistedClusterSnapshotInto(&p.volumeAttachmentsStore, payload, details, maxAge)
 	}
 	return nil
 }
 
-func (p *clusterPlane) hydratePersistedNamespacedSnapshot(kind ResourceKind, namespace string, payload []byte, maxAge time.Duration) error {
+func (p *clusterPlane) hydratePersistedNamespacedSnapshot(kind ResourceKind, namespace string, payload, details []byte, maxAge time.Duration) error {
 	switch kind {
 	case ResourceKindPods:
-		return hydratePersistedNamespacedSnapshotInto(&p.podsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.podsStore, namespace, payload, details, maxAge)
 	case ResourceKindDeployments:
-		return hydratePersistedNamespacedSnapshotInto(&p.depsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.depsStore, namespace, payload, details, maxAge)
 	case ResourceKindServices:
-		return hydratePersistedNamespacedSnapshotInto(&p.svcsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.svcsStore, namespace, payload, details, maxAge)
 	case ResourceKindIngresses:
-		return hydratePersistedNamespacedSnapshotInto(&p.ingStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.ingStore, namespace, payload, details, maxAge)
 	case ResourceKindPVCs:
-		return hydratePersistedNamespacedSnapshotInto(&p.pvcsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.pvcsStore, namespace, payload, details, maxAge)
 	case ResourceKindConfigMaps:
-		return hydratePersistedNamespacedSnapshotInto(&p.cmsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.cmsStore, namespace, payload, details, maxAge)
 	case ResourceKindSecrets:
-		return hydratePersistedNamespacedSnapshotInto(&p.secsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.secsStore, namespace, payload, details, maxAge)
 	case ResourceKindServiceAccounts:
-		return hydratePersistedNamespacedSnapshotInto(&p.saStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.saStore, namespace, payload, details, maxAge)
 	case ResourceKindRoles:
-		return hydratePersistedNamespacedSnapshotInto(&p.rolesStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.rolesStore, namespace, payload, details, maxAge)
 	case ResourceKindRoleBindings:
-		return hydratePersistedNamespacedSnapshotInto(&p.roleBindingsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.roleBindingsStore, namespace, payload, details, maxAge)
 	case ResourceKindHelmReleases:
-		return hydratePersistedNamespacedSnapshotInto(&p.helmReleasesStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.helmReleasesStore, namespace, payload, details, maxAge)
 	case ResourceKindDaemonSets:
-		return hydratePersistedNamespacedSnapshotInto(&p.dsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.dsStore, namespace, payload, details, maxAge)
 	case ResourceKindStatefulSets:
-		return hydratePersistedNamespacedSnapshotInto(&p.stsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.stsStore, namespace, payload, details, maxAge)
 	case ResourceKindReplicaSets:
-		return hydratePersistedNamespacedSnapshotInto(&p.rsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.rsStore, namespace, payload, details, maxAge)
 	case ResourceKindJobs:
-		return hydratePersistedNamespacedSnapshotInto(&p.jobsStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.jobsStore, namespace, payload, details, maxAge)
 	case ResourceKindCronJobs:
-		return hydratePersistedNamespacedSnapshotInto(&p.cjStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.cjStore, namespace, payload, details, maxAge)
 	case ResourceKindHPAs:
-		return hydratePersistedNamespacedSnapshotInto(&p.hpaStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.hpaStore, namespace, payload, details, maxAge)
 	case ResourceKindResourceQuotas:
-		return hydratePersistedNamespacedSnapshotInto(&p.rqStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.rqStore, namespace, payload, details, maxAge)
 	case ResourceKindLimitRanges:
-		return hydratePersistedNamespacedSnapshotInto(&p.lrStore, namespace, payload, maxAge)
+		return hydratePersistedNamespacedSnapshotInto(&p.lrStore, namespace, payload, details, maxAge)
+	case ResourceKindNetworkPolicies:
+		return hydratePersistedNamespacedSnapshotInto(&p.netpolStore, namespace, payload, details, maxAge)
+	case ResourceKindPDBs:
+		return hydratePersistedNamespacedSnapshotInto(&p.pdbStore, namespace, payload, details, maxAge)
 	}
 	return nil
 }
 
-func hydratePersistedClusterSnapshotInto[I any](store *snapshotStore[Snapshot[I]], payload []byte, maxAge time.Duration) error {
+func hydratePersistedClusterSnapshotInto[I any](store *snapshotStore[Snapshot[I]], payload, details []byte, maxAge time.Duration) error {
 	if _, ok := peekClusterSnapshot(store); ok {
 		return nil
 	}
 	var snap Snapshot[I]
-	if err := json.Unmarshal(payload, &snap); err != nil {
+	if err := decodePersistedSnapshot(payload, details, &snap); err != nil {
 		return err
 	}
 	if markPersistedSnapshot(&snap, maxAge) {
@@ -835,12 +943,12 @@ func hydratePersistedClusterSnapshotInto[I any](store *snapshotStore[Snapshot[I]
 	return nil
 }
 
-func hydratePersistedNamespacedSnapshotInto[I any](store *namespacedSnapshotStore[Snapshot[I]], namespace string, payload []byte, maxAge time.Duration) error {
+func hydratePersistedNamespacedSnapshotInto[I any](store *namespacedSnapshotStore[Snapshot[I]], namespace string, payload, details []byte, maxAge time.Duration) error {
 	if _, ok := peekNamespacedSnapshot(store, namespace); ok {
 		return nil
 	}
 	var snap Snapshot[I]
-	if err := json.Unmarshal(payload, &snap); err != nil {
+	if err := decodePersistedSnapshot(payload, details, &snap); err != nil {
 		return err
 	}
 	if markPersistedSnapshot(&snap, maxAge) {
@@ -868,6 +976,9 @@ type PersistentVolumesSnapshot = Snapshot[dto.PersistentVolumeDTO]
 type ClusterRolesSnapshot = Snapshot[dto.ClusterRoleListItemDTO]
 type ClusterRoleBindingsSnapshot = Snapshot[dto.ClusterRoleBindingListItemDTO]
 type CRDsSnapshot = Snapshot[dto.CRDListItemDTO]
+type StorageClassesSnapshot = Snapshot[dto.StorageClassDTO]
+type CSIDriversSnapshot = Snapshot[dto.CSIDriverDTO]
+type VolumeAttachmentsSnapshot = Snapshot[dto.VolumeAttachmentDTO]
 type PodsSnapshot = Snapshot[dto.PodListItemDTO]
 type DeploymentsSnapshot = Snapshot[dto.DeploymentListItemDTO]
 type ServicesSnapshot = Snapshot[dto.ServiceListItemDTO]
@@ -887,6 +998,8 @@ type CronJobsSnapshot = Snapshot[dto.CronJobDTO]
 type HPAsSnapshot = Snapshot[dto.HorizontalPodAutoscalerDTO]
 type ResourceQuotasSnapshot = Snapshot[dto.ResourceQuotaDTO]
 type LimitRangesSnapshot = Snapshot[dto.LimitRangeDTO]
+type NetworkPoliciesSnapshot = Snapshot[dto.NetworkPolicyDTO]
+type PodDisruptionBudgetsSnapshot = Snapshot[dto.PodDisruptionBudgetDTO]
 
 // Metrics snapshots hold point-in-time usage samples from metrics.k8s.io.
 // These snapshot cells are not persisted (no bbolt writes) because metric
@@ -904,6 +1017,12 @@ func (p *clusterPlane) NamespacesSnapshot(ctx context.Context, sched *workSchedu
 		capResource: "namespaces",
 		capScope:    CapabilityScopeCluster,
 		fetch:       namespaces.ListNamespaces,
+		watch: &snapshotWatchSource[dto.NamespaceListItemDTO]{
+			list:  namespaces.ListNamespacesWithResourceVersion,
+			watch: namespaces.WatchNamespaces,
+			item:  namespaces.NamespaceListItemFromObject,
+			key:   func(it dto.NamespaceListItemDTO) string { return it.Name },
+		},
 	}
 	return executeClusterSnapshot(p, ctx, sched, prio, clients, &p.nsStore, desc)
 }
@@ -973,6 +1092,45 @@ func (p *clusterPlane) CRDsSnapshot(ctx context.Context, sched *workScheduler, c
 	return executeClusterSnapshot(p, ctx, sched, prio, clients, &p.crdsStore, desc)
 }
 
+// StorageClassesSnapshot returns a raw snapshot for storage classes plus metadata and any normalized error.
+func (p *clusterPlane) StorageClassesSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, prio WorkPriority) (StorageClassesSnapshot, error) {
+	desc := clusterSnapshotDescriptor[dto.StorageClassDTO]{
+		kind:        ResourceKindStorageClasses,
+		ttl:         p.currentPolicy().SnapshotTTL(ResourceKindStorageClasses),
+		capGroup:    "storage.k8s.io",
+		capResource: "storageclasses",
+		capScope:    CapabilityScopeCluster,
+		fetch:       storageclasses.ListStorageClasses,
+	}
+	return executeClusterSnapshot(p, ctx, sched, prio, clients, &p.storageClassesStore, desc)
+}
+
+// CSIDriversSnapshot returns a raw snapshot for CSI drivers plus metadata and any normalized error.
+func (p *clusterPlane) CSIDriversSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, prio WorkPriority) (CSIDriversSnapshot, error) {
+	desc := clusterSnapshotDescriptor[dto.CSIDriverDTO]{
+		kind:        ResourceKindCSIDrivers,
+		ttl:         p.currentPolicy().SnapshotTTL(ResourceKindCSIDrivers),
+		capGroup:    "storage.k8s.io",
+		capResource: "csidrivers",
+		capScope:    CapabilityScopeCluster,
+		fetch:       csidrivers.ListCSIDrivers,
+	}
+	return executeClusterSnapshot(p, ctx, sched, prio, clients, &p.csiDriversStore, desc)
+}
+
+// VolumeAttachmentsSnapshot returns a raw snapshot for volume attachments plus metadata and any normalized error.
+func (p *clusterPlane) VolumeAttachmentsSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, prio WorkPriority) (VolumeAttachmentsSnapshot, error) {
+	desc := clusterSnapshotDescriptor[dto.VolumeAttachmentDTO]{
+		kind:        ResourceKindVolumeAttachments,
+		ttl:         p.currentPolicy().SnapshotTTL(ResourceKindVolumeAttachments),
+		capGroup:    "storage.k8s.io",
+		capResource: "volumeattachments",
+		capScope:    CapabilityScopeCluster,
+		fetch:       volumeattachments.ListVolumeAttachments,
+	}
+	return executeClusterSnapshot(p, ctx, sched, prio, clients, &p.volumeAttachmentsStore, desc)
+}
+
 // PodsSnapshot returns a raw snapshot for pods in the given namespace plus metadata and any normalized error.
 func (p *clusterPlane) PodsSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, namespace string, prio WorkPriority) (PodsSnapshot, error) {
 	desc := namespacedSnapshotDescriptor[dto.PodListItemDTO]{
@@ -982,6 +1140,17 @@ func (p *clusterPlane) PodsSnapshot(ctx context.Context, sched *workScheduler, c
 		capResource: "pods",
 		capScope:    CapabilityScopeNamespace,
 		fetch:       pods.ListPods,
+		watch: &snapshotWatchSource[dto.PodListItemDTO]{
+			list:  pods.ListPodsWithResourceVersion,
+			watch: pods.WatchPods,
+			item:  pods.PodListItemFromObject,
+			key:   func(it dto.PodListItemDTO) string { return it.Name },
+			// Watch events carry no event join; keep the last event seen by the list.
+			merge: func(prev, next dto.PodListItemDTO) dto.PodListItemDTO {
+				next.LastEvent = prev.LastEvent
+				return next
+			},
+		},
 	}
 	return executeNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, &p.podsStore, desc)
 }
@@ -995,6 +1164,12 @@ func (p *clusterPlane) DeploymentsSnapshot(ctx context.Context, sched *workSched
 		capResource: "deployments",
 		capScope:    CapabilityScopeNamespace,
 		fetch:       deployments.ListDeployments,
+		watch: &snapshotWatchSource[dto.DeploymentListItemDTO]{
+			list:  deployments.ListDeploymentsWithResourceVersion,
+			watch: deployments.WatchDeployments,
+			item:  deployments.DeploymentListItemFromObject,
+			key:   func(it dto.DeploymentListItemDTO) string { return it.Name },
+		},
 	}
 	return executeNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, &p.depsStore, desc)
 }
@@ -1220,6 +1395,32 @@ func (p *clusterPlane) LimitRangesSnapshot(ctx context.Context, sched *workSched
 	return executeNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, &p.lrStore, desc)
 }
 
+// NetworkPoliciesSnapshot returns a raw snapshot for network policies in the given namespace plus metadata and any normalized error.
+func (p *clusterPlane) NetworkPoliciesSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, namespace string, prio WorkPriority) (NetworkPoliciesSnapshot, error) {
+	desc := namespacedSnapshotDescriptor[dto.NetworkPolicyDTO]{
+		kind:        ResourceKindNetworkPolicies,
+		ttl:         p.currentPolicy().SnapshotTTL(ResourceKindNetworkPolicies),
+		capGroup:    "networking.k8s.io",
+		capResource: "networkpolicies",
+		capScope:    CapabilityScopeNamespace,
+		fetch:       netpols.ListNetworkPolicies,
+	}
+	return executeNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, &p.netpolStore, desc)
+}
+
+// PodDisruptionBudgetsSnapshot returns a raw snapshot for pod disruption budgets in the given namespace plus metadata and any normalized error.
+func (p *clusterPlane) PodDisruptionBudgetsSnapshot(ctx context.Context, sched *workScheduler, clients ClientsProvider, namespace string, prio WorkPriority) (PodDisruptionBudgetsSnapshot, error) {
+	desc := namespacedSnapshotDescriptor[dto.PodDisruptionBudgetDTO]{
+		kind:        ResourceKindPDBs,
+		ttl:         p.currentPolicy().SnapshotTTL(ResourceKindPDBs),
+		capGroup:    "policy",
+		capResource: "poddisruptionbudgets",
+		capScope:    CapabilityScopeNamespace,
+		fetch:       pdbs.ListPodDisruptionBudgets,
+	}
+	return executeNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, &p.pdbStore, desc)
+}
+
 // NodeMetricsSnapshot returns a raw cluster-scoped node usage snapshot from
 // metrics.k8s.io. The snapshot is intentionally not persisted because metric
 // samples churn every ~15s and the data is not recoverable-by-design.
@@ -1288,6 +1489,24 @@ func (m *manager) CRDsSnapshot(ctx context.Context, clusterName string) (CRDsSna
 	return plane.CRDsSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical)
 }
 
+func (m *manager) StorageClassesSnapshot(ctx context.Context, clusterName string) (StorageClassesSnapshot, error) {
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+	return plane.StorageClassesSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical)
+}
+
+func (m *manager) CSIDriversSnapshot(ctx context.Context, clusterName string) (CSIDriversSnapshot, error) {
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+	return plane.CSIDriversSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical)
+}
+
+func (m *manager) VolumeAttachmentsSnapshot(ctx context.Context, clusterName string) (VolumeAttachmentsSnapshot, error) {
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+	return plane.VolumeAttachmentsSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical)
+}
+
 func (m *manager) PodsSnapshot(ctx context.Context, clusterName, namespace string) (PodsSnapshot, error) {
 	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
 	plane := planeAny.(*clusterPlane)
@@ -1471,6 +1690,19 @@ func (m *manager) InvalidateJobsSnapshot(ctx context.Context, clusterName, names
 	return nil
 }
 
+func (m *manager) InvalidateCronJobsSnapshot(ctx context.Context, clusterName, namespace string) error {
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	plane := planeAny.(*clusterPlane)
+	clearNamespacedSnapshot(&plane.cjStore, namespace)
+	if sp := plane.currentPersistence(); sp != nil {
+		_ = sp.Delete(clusterName, ResourceKindCronJobs, namespace)
+	}
+	return nil
+}
+
 func (m *manager) DaemonSetsSnapshot(ctx context.Context, clusterName, namespace string) (DaemonSetsSnapshot, error) {
 	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
 	plane := planeAny.(*clusterPlane)
@@ -1519,6 +1751,18 @@ func (m *manager) LimitRangesSnapshot(ctx context.Context, clusterName, namespac
 	return plane.LimitRangesSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
 }
 
+func (m *manager) NetworkPoliciesSnapshot(ctx context.Context, clusterName, namespace string) (NetworkPoliciesSnapshot, error) {
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+	return plane.NetworkPoliciesSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+}
+
+func (m *manager) PodDisruptionBudgetsSnapshot(ctx context.Context, clusterName, namespace string) (PodDisruptionBudgetsSnapshot, error) {
+	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
+	plane := planeAny.(*clusterPlane)
+	return plane.PodDisruptionBudgetsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+}
+
 func (m *manager) NodeMetricsSnapshot(ctx context.Context, clusterName string) (NodeMetricsSnapshot, error) {
 	planeAny, _ := m.PlaneForCluster(ctx, clusterName)
 	plane := planeAny.(*clusterPlane)
@@ -0,0 +1,347 @@
+package dataplane
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// Edge types of the namespace graph.
+const (
+	graphEdgeOwns       = "owns"
+	graphEdgeSelects    = "selects"
+	graphEdgeScales     = "scales"
+	graphEdgeRoutes     = "routes"
+	graphEdgeReferences = "references"
+	graphEdgeBoundBy    = "boundBy"
+	graphEdgeRoleRef    = "roleRef"
+)
+
+// graphTemplateKinds carry pod template references. Pods and Jobs owned by one of them
+// do not repeat the template's reference edges.
+var graphTemplateKinds = map[string]bool{
+	"Deployment":  true,
+	"DaemonSet":   true,
+	"StatefulSet": true,
+	"Job":         true,
+	"CronJob":     true,
+}
+
+// NamespaceGraph returns the nodes and edges of a namespace from its snapshots: owner
+// references, Service/PDB selectors, HPA targets, Ingress backends, config and volume
+// references, and the ServiceAccount → RoleBinding → Role chain. Each node carries the
+// worst signal severity detected on it.
+func (m *manager) NamespaceGraph(ctx context.Context, clusterName, namespace string) (dto.NamespaceGraphDTO, error) {
+	ctx = ContextWithWorkSourceIfUnset(ctx, WorkSourceProjection)
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return dto.NamespaceGraphDTO{}, err
+	}
+	plane := planeAny.(*clusterPlane)
+	m.warmNamespaceInsightsResourceKinds(ctx, plane, namespace)
+
+	policy := m.EffectivePolicy(clusterName)
+	s := buildSnapshotSetForNamespace(plane, namespace, signalThresholdsFromPolicy(policy))
+	signals := newDashboardSignalStore()
+	signals.Add(applySignalPolicy(detectDashboardSignals(time.Now(), namespace, s), policy, clusterName)...)
+	return buildNamespaceGraph(namespace, s, signals), nil
+}
+
+type graphBuilder struct {
+	namespace string
+	nodes     map[string]*dto.GraphNodeDTO
+	present   map[string]bool
+	// known holds the kinds whose snapshot was read, keyed by Kind. An absent object of
+	// a known kind is missing; kinds not in the map are external to the namespace.
+	known    map[string]bool
+	edges    []dto.GraphEdgeDTO
+	edgeSeen map[dto.GraphEdgeDTO]bool
+	ownerOf  map[string]string
+}
+
+func graphNodeID(kind, name string) string {
+	return kind + "/" + name
+}
+
+func (b *graphBuilder) node(kind, name string) string {
+	id := graphNodeID(kind, name)
+	if _, ok := b.nodes[id]; !ok {
+		b.nodes[id] = &dto.GraphNodeDTO{ID: id, Kind: kind, Name: name, Namespace: b.namespace}
+	}
+	return id
+}
+
+func (b *graphBuilder) object(kind, name string) {
+	b.present[b.node(kind, name)] = true
+}
+
+func (b *graphBuilder) edge(fromKind, fromName, toKind, toName, typ, detail string) {
+	if fromName == "" || toName == "" {
+		return
+	}
+	e := dto.GraphEdgeDTO{From: b.node(fromKind, fromName), To: b.node(toKind, toName), Type: typ, Detail: detail}
+	if b.edgeSeen[e] {
+		return
+	}
+	b.edgeSeen[e] = true
+	b.edges = append(b.edges, e)
+	if typ == graphEdgeOwns {
+		b.ownerOf[e.To] = e.From
+	}
+}
+
+func (b *graphBuilder) owned(kind, name string, owner *dto.OwnerReferenceDTO) {
+	if owner != nil {
+		b.edge(owner.Kind, owner.Name, kind, name, graphEdgeOwns, "")
+	}
+}
+
+// templateOwned reports whether an ancestor of the node carries pod template references.
+func (b *graphBuilder) templateOwned(id string) bool {
+	for i := 0; i < 8; i++ {
+		owner, ok := b.ownerOf[id]
+		if !ok {
+			return false
+		}
+		if b.present[owner] && graphTemplateKinds[b.nodes[owner].Kind] {
+			return true
+		}
+		id = owner
+	}
+	return false
+}
+
+func (b *graphBuilder) references(kind, name string, refs []dto.ResourceReferenceDTO) {
+	if b.templateOwned(graphNodeID(kind, name)) {
+		return
+	}
+	for _, ref := range refs {
+		b.edge(kind, name, ref.Kind, ref.Name, graphEdgeReferences, ref.Via)
+	}
+}
+
+func (b *graphBuilder) selects(kind, name string, sel labels.Selector, pods []dto.PodListItemDTO) {
+	for _, p := range pods {
+		if sel.Matches(labels.Set(p.Labels)) {
+			b.edge(kind, name, "Pod", p.Name, graphEdgeSelects, "")
+		}
+	}
+}
+
+func buildNamespaceGraph(ns string, s dashboardSnapshotSet, signals dashboardSignalStore) dto.NamespaceGraphDTO {
+	b := &graphBuilder{
+		namespace: ns,
+		nodes:     map[string]*dto.GraphNodeDTO{},
+		present:   map[string]bool{},
+		edgeSeen:  map[dto.GraphEdgeDTO]bool{},
+		ownerOf:   map[string]string{},
+		known: map[string]bool{
+			"Pod": s.podsOK, "Deployment": s.depsOK, "DaemonSet": s.dsOK, "StatefulSet": s.stsOK,
+			"ReplicaSet": s.rsOK, "Job": s.jobsOK, "CronJob": s.cjsOK, "HorizontalPodAutoscaler": s.hpasOK,
+			"Service": s.svcsOK, "Ingress": s.ingsOK, "PersistentVolumeClaim": s.pvcsOK, "ConfigMap": s.cmsOK,
+			"Secret": s.secsOK, "ServiceAccount": s.sasOK, "Role": s.rolesOK, "RoleBinding": s.roleBindingsOK,
+			"PodDisruptionBudget": s.pdbsOK,
+		},
+	}
+	out := dto.NamespaceGraphDTO{Namespace: ns}
+	for _, k := range []struct {
+		kind ResourceKind
+		ok   bool
+	}{
+		{ResourceKindPods, s.podsOK}, {ResourceKindDeployments, s.depsOK}, {ResourceKindDaemonSets, s.dsOK},
+		{ResourceKindStatefulSets, s.stsOK}, {ResourceKindReplicaSets, s.rsOK}, {ResourceKindJobs, s.jobsOK},
+		{ResourceKindCronJobs, s.cjsOK}, {ResourceKindHPAs, s.hpasOK}, {ResourceKindServices, s.svcsOK},
+		{ResourceKindIngresses, s.ingsOK}, {ResourceKindPVCs, s.pvcsOK}, {ResourceKindConfigMaps, s.cmsOK},
+		{ResourceKindSecrets, s.secsOK}, {ResourceKindServiceAccounts, s.sasOK}, {ResourceKindRoles, s.rolesOK},
+		{ResourceKindRoleBindings, s.roleBindingsOK}, {ResourceKindPDBs, s.pdbsOK},
+	} {
+		if !k.ok {
+			out.Unavailable = append(out.Unavailable, string(k.kind))
+		}
+	}
+
+	var pods []dto.PodListItemDTO
+	if s.podsOK {
+		pods = s.pods.Items
+	}
+
+	// Objects and ownership first, so reference edges can skip template-owned objects.
+	for _, p := range pods {
+		b.object("Pod", p.Name)
+		b.owned("Pod", p.Name, p.Owner)
+	}
+	if s.depsOK {
+		for _, d := range s.deps.Items {
+			b.object("Deployment", d.Name)
+		}
+	}
+	if s.dsOK {
+		for _, d := range s.ds.Items {
+			b.object("DaemonSet", d.Name)
+		}
+	}
+	if s.stsOK {
+		for _, st := range s.sts.Items {
+			b.object("StatefulSet", st.Name)
+		}
+	}
+	if s.rsOK {
+		for _, rs := range s.rs.Items {
+			b.object("ReplicaSet", rs.Name)
+			b.owned("ReplicaSet", rs.Name, rs.Owner)
+		}
+	}
+	if s.jobsOK {
+		for _, j := range s.jobs.Items {
+			b.object("Job", j.Name)
+			b.owned("Job", j.Name, j.Owner)
+		}
+	}
+	if s.cjsOK {
+		for _, cj := range s.cjs.Items {
+			b.object("CronJob", cj.Name)
+		}
+	}
+	if s.pvcsOK {
+		for _, pvc := range s.pvcs.Items {
+			b.object("PersistentVolumeClaim", pvc.Name)
+		}
+	}
+	if s.cmsOK {
+		for _, cm := range s.cms.Items {
+			b.object("ConfigMap", cm.Name)
+		}
+	}
+	if s.secsOK {
+		for _, sec := range s.secs.Items {
+			b.object("Secret", sec.Name)
+		}
+	}
+	if s.rolesOK {
+		for _, r := range s.roles.Items {
+			b.object("Role", r.Name)
+		}
+	}
+
+	// Selectors, targets, and routes.
+	if s.svcsOK {
+		for _, svc := range s.svcs.Items {
+			b.object("Service", svc.Name)
+			if len(svc.Selector) > 0 {
+				b.selects("Service", svc.Name, labels.SelectorFromSet(svc.Selector), pods)
+			}
+		}
+	}
+	if s.pdbsOK {
+		for _, pdb := range s.pdbs.Items {
+			b.object("PodDisruptionBudget", pdb.Name)
+			if sel, ok := pdbSelector(pdb); ok {
+				b.selects("PodDisruptionBudget", pdb.Name, sel, pods)
+			}
+		}
+	}
+	if s.hpasOK {
+		for _, hpa := range s.hpas.Items {
+			b.object("HorizontalPodAutoscaler", hpa.Name)
+			b.edge("HorizontalPodAutoscaler", hpa.Name, hpa.ScaleTargetRef.Kind, hpa.ScaleTargetRef.Name, graphEdgeScales, "")
+		}
+	}
+	if s.ingsOK {
+		for _, ing := range s.ings.Items {
+			b.object("Ingress", ing.Name)
+			for _, svc := range ing.BackendServices {
+				b.edge("Ingress", ing.Name, "Service", svc, graphEdgeRoutes, "")
+			}
+			b.references("Ingress", ing.Name, ing.References)
+		}
+	}
+
+	// Config, volume, and identity references.
+	for _, p := range pods {
+		b.references("Pod", p.Name, p.References)
+	}
+	if s.depsOK {
+		for _, d := range s.deps.Items {
+			b.references("Deployment", d.Name, d.References)
+		}
+	}
+	if s.dsOK {
+		for _, d := range s.ds.Items {
+			b.references("DaemonSet", d.Name, d.References)
+		}
+	}
+	if s.stsOK {
+		for _, st := range s.sts.Items {
+			b.references("StatefulSet", st.Name, st.References)
+		}
+	}
+	if s.jobsOK {
+		for _, j := range s.jobs.Items {
+			b.references("Job", j.Name, j.References)
+		}
+	}
+	if s.cjsOK {
+		for _, cj := range s.cjs.Items {
+			b.references("CronJob", cj.Name, cj.References)
+		}
+	}
+	if s.sasOK {
+		for _, sa := range s.sas.Items {
+			b.object("ServiceAccount", sa.Name)
+			b.references("ServiceAccount", sa.Name, sa.References)
+		}
+	}
+	if s.roleBindingsOK {
+		for _, rb := range s.roleBindings.Items {
+			b.object("RoleBinding", rb.Name)
+			for _, subj := range rb.Subjects {
+				if subj.Kind == "ServiceAccount" && subj.Namespace == ns {
+					b.edge("ServiceAccount", subj.Name, "RoleBinding", rb.Name, graphEdgeBoundBy, "")
+				}
+			}
+			b.edge("RoleBinding", rb.Name, rb.RoleRefKind, rb.RoleRefName, graphEdgeRoleRef, "")
+		}
+	}
+
+	out.Nodes = make([]dto.GraphNodeDTO, 0, len(b.nodes))
+	for id, n := range b.nodes {
+		known, inNamespace := b.known[n.Kind]
+		switch {
+		case !inNamespace:
+			n.External = true
+			n.Namespace = ""
+		case known && !b.present[id]:
+			n.Missing = true
+		}
+		n.SignalSeverity = listSignalOK
+		if !n.External {
+			for _, sig := range signals.SignalsForResource(n.Kind, n.Name, "namespace", ns) {
+				n.SignalCount++
+				if signalSeverityRank(sig.Severity) > signalSeverityRank(n.SignalSeverity) {
+					n.SignalSeverity = sig.Severity
+				}
+			}
+		}
+		out.Nodes = append(out.Nodes, *n)
+	}
+	sort.Slice(out.Nodes, func(i, j int) bool { return out.Nodes[i].ID < out.Nodes[j].ID })
+	out.Edges = b.edges
+	if out.Edges == nil {
+		out.Edges = []dto.GraphEdgeDTO{}
+	}
+	sort.SliceStable(out.Edges, func(i, j int) bool {
+		a, c := out.Edges[i], out.Edges[j]
+		if a.From != c.From {
+			return a.From < c.From
+		}
+		if a.To != c.To {
+			return a.To < c.To
+		}
+		return a.Type < c.Type
+	})
+	return out
+}
@@ -0,0 +1,115 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func graphTestSet() dashboardSnapshotSet {
+	return dashboardSnapshotSet{
+		pods: PodsSnapshot{Items: []dto.PodListItemDTO{{
+			Name:   "web-5d8-abc",
+			Labels: map[string]string{"app": "web"},
+			Owner:  &dto.OwnerReferenceDTO{Kind: "ReplicaSet", Name: "web-5d8"},
+			References: []dto.ResourceReferenceDTO{
+				{Kind: "Secret", Name: "db", Via: "env"},
+				{Kind: "ServiceAccount", Name: "web", Via: "serviceAccount"},
+			},
+		}}},
+		podsOK: true,
+		deps: DeploymentsSnapshot{Items: []dto.DeploymentListItemDTO{{
+			Name: "web",
+			References: []dto.ResourceReferenceDTO{
+				{Kind: "Secret", Name: "db", Via: "env"},
+				{Kind: "ServiceAccount", Name: "web", Via: "serviceAccount"},
+			},
+		}}},
+		depsOK: true,
+		rs:     ReplicaSetsSnapshot{Items: []dto.ReplicaSetDTO{{Name: "web-5d8", Owner: &dto.OwnerReferenceDTO{Kind: "Deployment", Name: "web"}}}},
+		rsOK:   true,
+		svcs:   ServicesSnapshot{Items: []dto.ServiceListItemDTO{{Name: "web", Selector: map[string]string{"app": "web"}}}},
+		svcsOK: true,
+		ings:   IngressesSnapshot{Items: []dto.IngressListItemDTO{{Name: "web", BackendServices: []string{"web"}}}},
+		ingsOK: true,
+		hpas: HPAsSnapshot{Items: []dto.HorizontalPodAutoscalerDTO{{
+			Name: "web", ScaleTargetRef: dto.ScaleTargetRefDTO{Kind: "Deployment", Name: "web"},
+		}}},
+		hpasOK: true,
+		secs:   SecretsSnapshot{Items: []dto.SecretDTO{}},
+		secsOK: true,
+		sas:    ServiceAccountsSnapshot{Items: []dto.ServiceAccountListItemDTO{{Name: "web"}}},
+		sasOK:  true,
+		roleBindings: RoleBindingsSnapshot{Items: []dto.RoleBindingListItemDTO{{
+			Name: "web-read", RoleRefKind: "ClusterRole", RoleRefName: "view",
+			Subjects: []dto.SubjectDTO{{Kind: "ServiceAccount", Name: "web", Namespace: "shop"}},
+		}}},
+		roleBindingsOK: true,
+	}
+}
+
+func TestBuildNamespaceGraphEdges(t *testing.T) {
+	signals := newDashboardSignalStore()
+	signals.Add(dashboardSignalItem("pod_restarts", "Pod", "shop", "web-5d8-abc", "medium", 50, "restarts", "high", "pods"))
+	g := buildNamespaceGraph("shop", graphTestSet(), signals)
+
+	edges := map[dto.GraphEdgeDTO]bool{}
+	for _, e := range g.Edges {
+		edges[e] = true
+	}
+	for _, want := range []dto.GraphEdgeDTO{
+		{From: "Deployment/web", To: "ReplicaSet/web-5d8", Type: graphEdgeOwns},
+		{From: "ReplicaSet/web-5d8", To: "Pod/web-5d8-abc", Type: graphEdgeOwns},
+		{From: "Service/web", To: "Pod/web-5d8-abc", Type: graphEdgeSelects},
+		{From: "Ingress/web", To: "Service/web", Type: graphEdgeRoutes},
+		{From: "HorizontalPodAutoscaler/web", To: "Deployment/web", Type: graphEdgeScales},
+		{From: "Deployment/web", To: "Secret/db", Type: graphEdgeReferences, Detail: "env"},
+		{From: "ServiceAccount/web", To: "RoleBinding/web-read", Type: graphEdgeBoundBy},
+		{From: "RoleBinding/web-read", To: "ClusterRole/view", Type: graphEdgeRoleRef},
+	} {
+		if !edges[want] {
+			t.Errorf("missing edge %+v", want)
+		}
+	}
+	if edges[dto.GraphEdgeDTO{From: "Pod/web-5d8-abc", To: "Secret/db", Type: graphEdgeReferences, Detail: "env"}] {
+		t.Error("pod of a deployment must not repeat the template references")
+	}
+
+	nodes := map[string]dto.GraphNodeDTO{}
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+	if n := nodes["Pod/web-5d8-abc"]; n.SignalSeverity != "medium" || n.SignalCount != 1 {
+		t.Errorf("pod node = %+v, want medium signal", n)
+	}
+	if n := nodes["Deployment/web"]; n.SignalSeverity != "ok" {
+		t.Errorf("deployment severity = %q, want ok", n.SignalSeverity)
+	}
+	if n := nodes["Secret/db"]; !n.Missing {
+		t.Errorf("secret absent from a read snapshot must be missing: %+v", n)
+	}
+	if n := nodes["ClusterRole/view"]; !n.External || n.Namespace != "" {
+		t.Errorf("cluster role must be external: %+v", n)
+	}
+}
+
+func TestBuildNamespaceGraphUnavailableKinds(t *testing.T) {
+	s := graphTestSet()
+	s.secsOK = false
+	g := buildNamespaceGraph("shop", s, newDashboardSignalStore())
+
+	found := false
+	for _, k := range g.Unavailable {
+		if k == string(ResourceKindSecrets) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("unavailable = %v, want secrets listed", g.Unavailable)
+	}
+	for _, n := range g.Nodes {
+		if n.ID == "Secret/db" && n.Missing {
+			t.Fatal("secret must not be reported missing when the secrets snapshot is unavailable")
+		}
+	}
+}
@@ -695,6 +695,10 @@ func (m *manager) warmNamespaceEnrichmentResourceKinds(ctx context.Context, plan
 			_, _ = plane.ResourceQuotasSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityLow)
 		case ResourceKindLimitRanges:
 			_, _ = plane.LimitRangesSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityLow)
+		case ResourceKindNetworkPolicies:
+			_, _ = plane.NetworkPoliciesSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityLow)
+		case ResourceKindPDBs:
+			_, _ = plane.PodDisruptionBudgetsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityLow)
 		}
 	}
 }
@@ -720,6 +724,7 @@ func (m *manager) warmNamespaceInsightsResourceKinds(ctx context.Context, plane
 		string(ResourceKindHelmReleases),
 		string(ResourceKindResourceQuotas),
 		string(ResourceKindLimitRanges),
+		string(ResourceKindPDBs),
 	})
 }
 
@@ -0,0 +1,320 @@
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// NetworkReachabilityRequest names the pods and destination port of a reachability check.
+// Port is a number or a named container port of the destination pod; Protocol defaults
+// to TCP.
+type NetworkReachabilityRequest struct {
+	SourceNamespace      string
+	SourcePod            string
+	DestinationNamespace string
+	DestinationPod       string
+	Port                 string
+	Protocol             string
+}
+
+// networkReachabilityInput is everything the evaluation reads, taken from snapshots.
+type networkReachabilityInput struct {
+	source, destination dto.PodListItemDTO
+	port                string
+	protocol            string
+	// policies by namespace; only the source and destination namespaces are needed.
+	policies map[string][]dto.NetworkPolicyDTO
+	// namespaceLabels is nil when the namespaces snapshot is unavailable.
+	namespaceLabels map[string]map[string]string
+}
+
+// NetworkReachability evaluates the cached pod, namespace, and NetworkPolicy snapshots for
+// traffic from the source pod to the destination pod.
+func (m *manager) NetworkReachability(ctx context.Context, clusterName string, req NetworkReachabilityRequest) (dto.NetworkReachabilityDTO, error) {
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return dto.NetworkReachabilityDTO{}, err
+	}
+	plane := planeAny.(*clusterPlane)
+
+	in := networkReachabilityInput{
+		port:     strings.TrimSpace(req.Port),
+		protocol: strings.ToUpper(strings.TrimSpace(req.Protocol)),
+		policies: map[string][]dto.NetworkPolicyDTO{},
+	}
+	if in.protocol == "" {
+		in.protocol = "TCP"
+	}
+	if in.source, err = m.reachabilityPod(ctx, plane, req.SourceNamespace, req.SourcePod); err != nil {
+		return dto.NetworkReachabilityDTO{}, err
+	}
+	if in.destination, err = m.reachabilityPod(ctx, plane, req.DestinationNamespace, req.DestinationPod); err != nil {
+		return dto.NetworkReachabilityDTO{}, err
+	}
+	for _, ns := range []string{req.SourceNamespace, req.DestinationNamespace} {
+		if _, ok := in.policies[ns]; ok {
+			continue
+		}
+		snap, err := plane.NetworkPoliciesSnapshot(ctx, m.scheduler, m.clients, ns, WorkPriorityCritical)
+		if err != nil && len(snap.Items) == 0 {
+			return dto.NetworkReachabilityDTO{}, err
+		}
+		in.policies[ns] = snap.Items
+	}
+	if nsSnap, err := plane.NamespacesSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical); err == nil || len(nsSnap.Items) > 0 {
+		in.namespaceLabels = make(map[string]map[string]string, len(nsSnap.Items))
+		for _, ns := range nsSnap.Items {
+			in.namespaceLabels[ns.Name] = ns.Labels
+		}
+	}
+	return evaluateNetworkReachability(in), nil
+}
+
+func (m *manager) reachabilityPod(ctx context.Context, plane *clusterPlane, namespace, name string) (dto.PodListItemDTO, error) {
+	snap, err := plane.PodsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	if err != nil && len(snap.Items) == 0 {
+		return dto.PodListItemDTO{}, err
+	}
+	for _, p := range snap.Items {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return dto.PodListItemDTO{}, apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, namespace+"/"+name)
+}
+
+func evaluateNetworkReachability(in networkReachabilityInput) dto.NetworkReachabilityDTO {
+	out := dto.NetworkReachabilityDTO{
+		Source:      dto.NetworkEndpointDTO{Namespace: in.source.Namespace, Pod: in.source.Name, IP: in.source.PodIP},
+		Destination: dto.NetworkEndpointDTO{Namespace: in.destination.Namespace, Pod: in.destination.Name, IP: in.destination.PodIP},
+		Port:        in.port,
+		Protocol:    in.protocol,
+	}
+	port, portName := resolveReachabilityPort(in.port, in.protocol, in.destination)
+	if port == 0 {
+		out.Notes = append(out.Notes, fmt.Sprintf("port %q is not a named port of the destination pod; only rules allowing all ports can match", in.port))
+	}
+	if in.namespaceLabels == nil {
+		out.Notes = append(out.Notes, "namespace labels are unavailable; namespaceSelector peers are treated as non-matching")
+	}
+
+	ev := reachabilityEvaluator{in: in, port: port, portName: portName, notes: map[string]bool{}}
+	out.Egress = ev.direction(in.source, in.destination, "Egress")
+	out.Ingress = ev.direction(in.destination, in.source, "Ingress")
+	out.Allowed = out.Egress.Allowed && out.Ingress.Allowed
+	out.Notes = append(out.Notes, sortedNotes(ev.notes)...)
+	return out
+}
+
+type reachabilityEvaluator struct {
+	in       networkReachabilityInput
+	port     int32
+	portName string
+	notes    map[string]bool
+}
+
+// direction checks the policies selecting subject for policyType against peer. For
+// Egress the subject is the source; for Ingress it is the destination.
+func (e *reachabilityEvaluator) direction(subject, peer dto.PodListItemDTO, policyType string) dto.NetworkReachabilityDirectionDTO {
+	var res dto.NetworkReachabilityDirectionDTO
+	for _, np := range e.in.policies[subject.Namespace] {
+		if !hasPolicyType(np, policyType) || !e.selectorMatches(np.PodSelector, subject.Labels, np.Name) {
+			continue
+		}
+		res.SelectingPolicies = append(res.SelectingPolicies, np.Name)
+		rules := np.IngressRules
+		if policyType == "Egress" {
+			rules = np.EgressRules
+		}
+		for _, rule := range rules {
+			if e.portsMatch(rule.Ports) && e.peersMatch(rule.Peers, np, peer) {
+				res.AllowingPolicies = append(res.AllowingPolicies, np.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(res.SelectingPolicies)
+	sort.Strings(res.AllowingPolicies)
+
+	dir := "ingress"
+	if policyType == "Egress" {
+		dir = "egress"
+	}
+	switch {
+	case len(res.SelectingPolicies) == 0:
+		res.Allowed = true
+		res.Reason = fmt.Sprintf("no policy selects %s/%s for %s; all %s traffic is allowed", subject.Namespace, subject.Name, dir, dir)
+	case len(res.AllowingPolicies) > 0:
+		res.Allowed, res.Isolated = true, true
+		res.Reason = fmt.Sprintf("allowed by %s", strings.Join(res.AllowingPolicies, ", "))
+	default:
+		res.Isolated = true
+		res.Reason = fmt.Sprintf("denied: %s isolate %s/%s for %s and no rule matches", strings.Join(res.SelectingPolicies, ", "), subject.Namespace, subject.Name, dir)
+	}
+	return res
+}
+
+func hasPolicyType(np dto.NetworkPolicyDTO, policyType string) bool {
+	for _, t := range np.PolicyTypes {
+		if t == policyType {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *reachabilityEvaluator) portsMatch(ports []dto.NetworkPolicyPortDTO) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, p := range ports {
+		if !strings.EqualFold(p.Protocol, e.in.protocol) {
+			continue
+		}
+		if p.Port == "" {
+			return true
+		}
+		n, err := strconv.Atoi(p.Port)
+		if err != nil {
+			// Named ports in a policy resolve against the destination pod.
+			if e.portName != "" && p.Port == e.portName {
+				return true
+			}
+			continue
+		}
+		if e.port == 0 {
+			continue
+		}
+		end := int32(n)
+		if p.EndPort > end {
+			end = p.EndPort
+		}
+		if e.port >= int32(n) && e.port <= end {
+			return true
+		}
+	}
+	return false
+}
+
+// peersMatch reports whether other is one of the rule's peers. Pod selectors without a
+// namespace selector only match pods in the policy's own namespace.
+func (e *reachabilityEvaluator) peersMatch(peers []dto.NetworkPolicyPeerDTO, np dto.NetworkPolicyDTO, other dto.PodListItemDTO) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	for _, peer := range peers {
+		switch {
+		case peer.IPBlock != nil:
+			if ipBlockMatches(*peer.IPBlock, other.PodIP) {
+				return true
+			}
+			if other.PodIP == "" {
+				e.notes[fmt.Sprintf("%s: ipBlock peers cannot be evaluated without a pod IP", np.Name)] = true
+			}
+		case peer.NamespaceSelector != nil:
+			if e.in.namespaceLabels == nil {
+				continue
+			}
+			nsLabels, ok := e.in.namespaceLabels[other.Namespace]
+			if !ok {
+				continue
+			}
+			if !e.selectorMatches(*peer.NamespaceSelector, nsLabels, np.Name) {
+				continue
+			}
+			if peer.PodSelector == nil || e.selectorMatches(*peer.PodSelector, other.Labels, np.Name) {
+				return true
+			}
+		case peer.PodSelector != nil:
+			if other.Namespace == np.Namespace && e.selectorMatches(*peer.PodSelector, other.Labels, np.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *reachabilityEvaluator) selectorMatches(sel dto.LabelSelectorDTO, set map[string]string, policy string) bool {
+	s, err := labelSelectorFromDTO(sel)
+	if err != nil {
+		e.notes[fmt.Sprintf("%s: invalid selector ignored: %v", policy, err)] = true
+		return false
+	}
+	return s.Matches(labels.Set(set))
+}
+
+// labelSelectorFromDTO converts a snapshot label selector back into a labels.Selector. An
+// empty selector matches everything.
+func labelSelectorFromDTO(sel dto.LabelSelectorDTO) (labels.Selector, error) {
+	ls := &metav1.LabelSelector{MatchLabels: sel.MatchLabels}
+	for _, expr := range sel.MatchExpressions {
+		ls.MatchExpressions = append(ls.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      expr.Key,
+			Operator: metav1.LabelSelectorOperator(expr.Operator),
+			Values:   expr.Values,
+		})
+	}
+	return metav1.LabelSelectorAsSelector(ls)
+}
+
+func ipBlockMatches(block dto.NetworkPolicyIPBlockDTO, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	if _, cidr, err := net.ParseCIDR(block.CIDR); err != nil || !cidr.Contains(addr) {
+		return false
+	}
+	for _, ex := range block.Except {
+		if _, cidr, err := net.ParseCIDR(ex); err == nil && cidr.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveReachabilityPort returns the numeric port and its container port name on the
+// destination pod, if any. An unknown port name resolves to 0.
+func resolveReachabilityPort(port, protocol string, dst dto.PodListItemDTO) (int32, string) {
+	if n, err := strconv.Atoi(port); err == nil {
+		for _, p := range dst.NamedPorts {
+			if p.ContainerPort == int32(n) && strings.EqualFold(namedPortProtocol(p), protocol) {
+				return int32(n), p.Name
+			}
+		}
+		return int32(n), ""
+	}
+	for _, p := range dst.NamedPorts {
+		if p.Name == port && strings.EqualFold(namedPortProtocol(p), protocol) {
+			return p.ContainerPort, p.Name
+		}
+	}
+	return 0, ""
+}
+
+func namedPortProtocol(p dto.ContainerPortDTO) string {
+	if p.Protocol == "" {
+		return "TCP"
+	}
+	return p.Protocol
+}
+
+func sortedNotes(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,129 @@
+package dataplane
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func reachabilityTestInput(policies ...dto.NetworkPolicyDTO) networkReachabilityInput {
+	in := networkReachabilityInput{
+		source: dto.PodListItemDTO{Name: "web-1", Namespace: "front", PodIP: "10.0.1.5", Labels: map[string]string{"app": "web"}},
+		destination: dto.PodListItemDTO{Name: "api-1", Namespace: "back", PodIP: "10.0.2.7", Labels: map[string]string{"app": "api"},
+			NamedPorts: []dto.ContainerPortDTO{{Name: "http", ContainerPort: 8080}}},
+		port:     "8080",
+		protocol: "TCP",
+		policies: map[string][]dto.NetworkPolicyDTO{},
+		namespaceLabels: map[string]map[string]string{
+			"front": {"kubernetes.io/metadata.name": "front", "tier": "public"},
+			"back":  {"kubernetes.io/metadata.name": "back"},
+		},
+	}
+	for _, np := range policies {
+		in.policies[np.Namespace] = append(in.policies[np.Namespace], np)
+	}
+	return in
+}
+
+func denyAllIngress(ns string) dto.NetworkPolicyDTO {
+	return dto.NetworkPolicyDTO{Name: "default-deny", Namespace: ns, PolicyTypes: []string{"Ingress"}}
+}
+
+func TestEvaluateNetworkReachability_NoPolicies(t *testing.T) {
+	got := evaluateNetworkReachability(reachabilityTestInput())
+	if !got.Allowed || got.Egress.Isolated || got.Ingress.Isolated {
+		t.Fatalf("expected allowed without isolation, got %+v", got)
+	}
+}
+
+func TestEvaluateNetworkReachability_DefaultDenyNamesPolicy(t *testing.T) {
+	got := evaluateNetworkReachability(reachabilityTestInput(denyAllIngress("back")))
+	if got.Allowed || !got.Egress.Allowed || got.Ingress.Allowed {
+		t.Fatalf("expected ingress denial, got %+v", got)
+	}
+	if len(got.Ingress.SelectingPolicies) != 1 || got.Ingress.SelectingPolicies[0] != "default-deny" || len(got.Ingress.AllowingPolicies) != 0 {
+		t.Fatalf("ingress policies: got %+v", got.Ingress)
+	}
+	if !strings.Contains(got.Ingress.Reason, "default-deny") {
+		t.Fatalf("reason: got %q", got.Ingress.Reason)
+	}
+}
+
+func TestEvaluateNetworkReachability_NamespaceAndPodSelectorPeer(t *testing.T) {
+	allow := dto.NetworkPolicyDTO{
+		Name:        "allow-web",
+		Namespace:   "back",
+		PodSelector: dto.LabelSelectorDTO{MatchLabels: map[string]string{"app": "api"}},
+		PolicyTypes: []string{"Ingress"},
+		IngressRules: []dto.NetworkPolicyRuleDTO{{
+			Peers: []dto.NetworkPolicyPeerDTO{{
+				NamespaceSelector: &dto.LabelSelectorDTO{MatchLabels: map[string]string{"tier": "public"}},
+				PodSelector:       &dto.LabelSelectorDTO{MatchExpressions: []dto.LabelSelectorExpression{{Key: "app", Operator: "In", Values: []string{"web", "admin"}}}},
+			}},
+			Ports: []dto.NetworkPolicyPortDTO{{Protocol: "TCP", Port: "http"}},
+		}},
+	}
+	got := evaluateNetworkReachability(reachabilityTestInput(denyAllIngress("back"), allow))
+	if !got.Allowed || len(got.Ingress.AllowingPolicies) != 1 || got.Ingress.AllowingPolicies[0] != "allow-web" {
+		t.Fatalf("expected allow-web to allow, got %+v", got.Ingress)
+	}
+
+	in := reachabilityTestInput(denyAllIngress("back"), allow)
+	in.port = "9090"
+	if got := evaluateNetworkReachability(in); got.Allowed {
+		t.Fatalf("expected other port denied, got %+v", got.Ingress)
+	}
+
+	// A pod selector without a namespace selector only matches the policy's namespace.
+	allow.IngressRules[0].Peers[0].NamespaceSelector = nil
+	if got := evaluateNetworkReachability(reachabilityTestInput(allow)); got.Allowed {
+		t.Fatalf("expected cross-namespace peer denied, got %+v", got.Ingress)
+	}
+}
+
+func TestEvaluateNetworkReachability_EgressPortRangeAndIPBlock(t *testing.T) {
+	egress := dto.NetworkPolicyDTO{
+		Name:        "egress-backend",
+		Namespace:   "front",
+		PolicyTypes: []string{"Egress"},
+		EgressRules: []dto.NetworkPolicyRuleDTO{{
+			Peers: []dto.NetworkPolicyPeerDTO{{IPBlock: &dto.NetworkPolicyIPBlockDTO{CIDR: "10.0.2.0/24", Except: []string{"10.0.2.128/25"}}}},
+			Ports: []dto.NetworkPolicyPortDTO{{Protocol: "TCP", Port: "8000", EndPort: 8100}},
+		}},
+	}
+	got := evaluateNetworkReachability(reachabilityTestInput(egress))
+	if !got.Allowed || got.Egress.AllowingPolicies[0] != "egress-backend" {
+		t.Fatalf("expected egress allowed, got %+v", got.Egress)
+	}
+
+	in := reachabilityTestInput(egress)
+	in.destination.PodIP = "10.0.2.200"
+	if got := evaluateNetworkReachability(in); got.Allowed || got.Egress.Allowed {
+		t.Fatalf("expected excepted IP denied, got %+v", got.Egress)
+	}
+
+	in = reachabilityTestInput(egress)
+	in.protocol = "UDP"
+	if got := evaluateNetworkReachability(in); got.Egress.Allowed {
+		t.Fatalf("expected UDP denied, got %+v", got.Egress)
+	}
+}
+
+func TestEvaluateNetworkReachability_UnknownNamedPortAndMissingNamespaces(t *testing.T) {
+	in := reachabilityTestInput(dto.NetworkPolicyDTO{
+		Name:         "allow-all-from-public",
+		Namespace:    "back",
+		PolicyTypes:  []string{"Ingress"},
+		IngressRules: []dto.NetworkPolicyRuleDTO{{Peers: []dto.NetworkPolicyPeerDTO{{NamespaceSelector: &dto.LabelSelectorDTO{}}}}},
+	})
+	in.port = "grpc"
+	in.namespaceLabels = nil
+	got := evaluateNetworkReachability(in)
+	if got.Allowed {
+		t.Fatalf("expected denial when namespace labels are unknown, got %+v", got)
+	}
+	if len(got.Notes) != 2 {
+		t.Fatalf("notes: got %v", got.Notes)
+	}
+}
@@ -78,7 +78,7 @@ func (p *clusterPlane) EnsureObservers(_ context.Context, sched *workScheduler,
 	p.observers = &clusterObservers{}
 	p.obsMu.Unlock()
 
-	observerCtx := context.Background()
+	observerCtx := p.lifetime
 	go p.runNamespaceObserver(observerCtx, sched, clients, rt)
 	go p.runNodeObserver(observerCtx, sched, clients, rt)
 }
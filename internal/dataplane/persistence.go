@@ -23,6 +23,9 @@ var (
 	dataplaneSearchBucket    = []byte("search_name_v1")
 	dataplaneCellIndexBucket = []byte("search_cell_v1")
 	dataplaneSignalBucket    = []byte("signals_v1")
+	// dataplaneRowDetailBucket holds json:"-" row fields per snapshot cell; see
+	// persistence_row_details.go.
+	dataplaneRowDetailBucket = []byte("row_details_v1")
 	dataplaneMetaBucket      = []byte("meta")
 	dataplaneSchemaKey       = []byte("schemaVersion")
 )
@@ -186,7 +189,7 @@ func writeDataplaneSchemaVersion(tx *bolt.Tx, version int) error {
 }
 
 func ensureDataplaneBuckets(tx *bolt.Tx) error {
-	for _, bucket := range [][]byte{dataplaneSnapshotBucket, dataplaneSearchBucket, dataplaneCellIndexBucket, dataplaneSignalBucket} {
+	for _, bucket := range [][]byte{dataplaneSnapshotBucket, dataplaneSearchBucket, dataplaneCellIndexBucket, dataplaneSignalBucket, dataplaneRowDetailBucket} {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
 		}
@@ -215,7 +218,7 @@ func (p *boltSnapshotPersistence) Load(cluster string, kind ResourceKind, namesp
 		return false, nil
 	}
 	key := snapshotKey(cluster, kind, namespace)
-	var payload []byte
+	var payload, details []byte
 	err := p.db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(dataplaneSnapshotBucket)
 		if b == nil {
@@ -226,12 +229,15 @@ func (p *boltSnapshotPersistence) Load(cluster string, kind ResourceKind, namesp
 			return nil
 		}
 		payload = append([]byte(nil), raw...)
+		if rd := tx.Bucket(dataplaneRowDetailBucket); rd != nil {
+			details = append([]byte(nil), rd.Get(key)...)
+		}
 		return nil
 	})
 	if err != nil || payload == nil {
 		return false, err
 	}
-	if err := json.Unmarshal(payload, into); err != nil {
+	if err := decodePersistedSnapshot(payload, details, into); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -241,7 +247,7 @@ func (p *boltSnapshotPersistence) Save(cluster string, kind ResourceKind, namesp
 	if p == nil || p.db == nil {
 		return nil
 	}
-	payload, err := json.Marshal(snap)
+	payload, details, err := encodePersistedSnapshot(snap)
 	if err != nil {
 		return err
 	}
@@ -263,6 +269,18 @@ func (p *boltSnapshotPersistence) Save(cluster string, kind ResourceKind, namesp
 		if err := snapshots.Put(cellKey, payload); err != nil {
 			return err
 		}
+		rowDetails, err := tx.CreateBucketIfNotExists(dataplaneRowDetailBucket)
+		if err != nil {
+			return err
+		}
+		if details != nil {
+			err = rowDetails.Put(cellKey, details)
+		} else {
+			err = rowDetails.Delete(cellKey)
+		}
+		if err != nil {
+			return err
+		}
 		if err := deleteCellIndex(search, cells, cellKey); err != nil {
 			return err
 		}
@@ -300,6 +318,11 @@ func (p *boltSnapshotPersistence) Delete(cluster string, kind ResourceKind, name
 				return err
 			}
 		}
+		if rowDetails := tx.Bucket(dataplaneRowDetailBucket); rowDetails != nil {
+			if err := rowDetails.Delete(cellKey); err != nil {
+				return err
+			}
+		}
 		if search != nil && cells != nil {
 			return deleteCellIndex(search, cells, cellKey)
 		}
@@ -316,6 +339,7 @@ func (p *boltSnapshotPersistence) PruneOlderThan(cluster string, maxAge time.Dur
 		snapshots := tx.Bucket(dataplaneSnapshotBucket)
 		search := tx.Bucket(dataplaneSearchBucket)
 		cells := tx.Bucket(dataplaneCellIndexBucket)
+		rowDetails := tx.Bucket(dataplaneRowDetailBucket)
 		if snapshots == nil {
 			return nil
 		}
@@ -338,6 +362,11 @@ func (p *boltSnapshotPersistence) PruneOlderThan(cluster string, maxAge time.Dur
 			if err := snapshots.Delete(key); err != nil {
 				return err
 			}
+			if rowDetails != nil {
+				if err := rowDetails.Delete(key); err != nil {
+					return err
+				}
+			}
 			if search != nil && cells != nil {
 				if err := deleteCellIndex(search, cells, key); err != nil {
 					return err
@@ -352,6 +381,8 @@ type persistedSnapshotCell struct {
 	Kind      ResourceKind
 	Namespace string
 	Payload   []byte
+	// Details are the row details saved beside the payload, if any.
+	Details []byte
 }
 
 func (p *boltSnapshotPersistence) ListSnapshots(cluster string) ([]persistedSnapshotCell, error) {
@@ -364,16 +395,21 @@ func (p *boltSnapshotPersistence) ListSnapshots(cluster string) ([]persistedSnap
 		if b == nil {
 			return nil
 		}
+		rowDetails := tx.Bucket(dataplaneRowDetailBucket)
 		return b.ForEach(func(key, value []byte) error {
 			keyCluster, kind, namespace, ok := decodeSnapshotKey(key)
 			if !ok || keyCluster != cluster {
 				return nil
 			}
-			cells = append(cells, persistedSnapshotCell{
+			cell := persistedSnapshotCell{
 				Kind:      ResourceKind(kind),
 				Namespace: namespace,
 				Payload:   append([]byte(nil), value...),
-			})
+			}
+			if rowDetails != nil {
+				cell.Details = append([]byte(nil), rowDetails.Get(key)...)
+			}
+			cells = append(cells, cell)
 			return nil
 		})
 	})
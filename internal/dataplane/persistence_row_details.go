@@ -0,0 +1,128 @@
+package dataplane
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+)
+
+// Row details are list row fields that only in-process consumers read (label
+// selectors, the reference index, reachability, topology). Every row DTO tags
+// them json:"-" so API responses and the snapshot cell stay lean; they are saved
+// in their own bucket under the same cell key, keyed by row name and Go field
+// name, so hydrated rows keep them.
+
+// rowDetailField is one json:"-" field of a row type.
+type rowDetailField struct {
+	index int
+	name  string
+}
+
+var rowDetailFieldsByType sync.Map // reflect.Type -> []rowDetailField
+
+// rowDetailFields returns the exported json:"-" fields of row type t; nil for rows
+// without them or without a string Name to key them by.
+func rowDetailFields(t reflect.Type) []rowDetailField {
+	if cached, ok := rowDetailFieldsByType.Load(t); ok {
+		return cached.([]rowDetailField)
+	}
+	var fields []rowDetailField
+	if t.Kind() == reflect.Struct {
+		if name, ok := t.FieldByName("Name"); ok && name.Type.Kind() == reflect.String {
+			for i := 0; i < t.NumField(); i++ {
+				f := t.Field(i)
+				if f.IsExported() && f.Tag.Get("json") == "-" {
+					fields = append(fields, rowDetailField{index: i, name: f.Name})
+				}
+			}
+		}
+	}
+	rowDetailFieldsByType.Store(t, fields)
+	return fields
+}
+
+// snapshotRows returns the Items slice of a Snapshot value (or pointer to one) and
+// the row detail fields of its element type.
+func snapshotRows(snap any) (reflect.Value, []rowDetailField) {
+	v := reflect.ValueOf(snap)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+	items := v.FieldByName("Items")
+	if !items.IsValid() || items.Kind() != reflect.Slice {
+		return reflect.Value{}, nil
+	}
+	return items, rowDetailFields(items.Type().Elem())
+}
+
+// encodePersistedSnapshot returns the snapshot cell payload and, for row types with
+// json:"-" fields, the row details keyed by row name (nil otherwise).
+func encodePersistedSnapshot(snap any) (payload []byte, details []byte, err error) {
+	payload, err = json.Marshal(snap)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, fields := snapshotRows(snap)
+	if len(fields) == 0 || items.Len() == 0 {
+		return payload, nil, nil
+	}
+	byName := make(map[string]map[string]any, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		row := items.Index(i)
+		d := map[string]any{}
+		for _, f := range fields {
+			if fv := row.Field(f.index); !fv.IsZero() {
+				d[f.name] = fv.Interface()
+			}
+		}
+		if len(d) > 0 {
+			byName[row.FieldByName("Name").String()] = d
+		}
+	}
+	if len(byName) == 0 {
+		return payload, nil, nil
+	}
+	if details, err = json.Marshal(byName); err != nil {
+		return nil, nil, err
+	}
+	return payload, details, nil
+}
+
+// decodePersistedSnapshot decodes a snapshot cell payload into into (a pointer to a
+// Snapshot) and merges the row details saved beside it, if any.
+func decodePersistedSnapshot(payload []byte, details []byte, into any) error {
+	if err := json.Unmarshal(payload, into); err != nil {
+		return err
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	items, fields := snapshotRows(into)
+	if len(fields) == 0 {
+		return nil
+	}
+	var byName map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(details, &byName); err != nil {
+		return err
+	}
+	for i := 0; i < items.Len(); i++ {
+		row := items.Index(i)
+		d, ok := byName[row.FieldByName("Name").String()]
+		if !ok {
+			continue
+		}
+		for _, f := range fields {
+			raw, ok := d[f.name]
+			if !ok {
+				continue
+			}
+			if err := json.Unmarshal(raw, row.Field(f.index).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
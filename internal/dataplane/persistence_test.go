@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,6 +72,87 @@ func TestBoltSnapshotPersistenceRoundTripAndIndexesNames(t *testing.T) {
 	}
 }
 
+func TestBoltSnapshotPersistenceKeepsPodRowDetailsOutOfThePayload(t *testing.T) {
+	store, err := openBoltSnapshotPersistence(t.TempDir() + "/cache.bbolt")
+	if err != nil {
+		t.Fatalf("open persistence: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	snap := PodsSnapshot{
+		Items: []dto.PodListItemDTO{{
+			Name:       "api-7f",
+			Namespace:  "app",
+			Labels:     map[string]string{"app": "api"},
+			PodIP:      "10.0.0.7",
+			Owner:      &dto.OwnerReferenceDTO{Kind: "ReplicaSet", Name: "api"},
+			References: []dto.ResourceReferenceDTO{{Kind: "ConfigMap", Name: "api-config"}},
+		}},
+		Meta: SnapshotMetadata{ObservedAt: time.Now().UTC()},
+	}
+	if err := store.Save("ctx", ResourceKindPods, "app", snap); err != nil {
+		t.Fatalf("save snapshot: %v", err)
+	}
+
+	cells, err := store.ListSnapshots("ctx")
+	if err != nil || len(cells) != 1 {
+		t.Fatalf("list snapshots = %d cells, err %v", len(cells), err)
+	}
+	if strings.Contains(string(cells[0].Payload), "10.0.0.7") || len(cells[0].Details) == 0 {
+		t.Fatalf("row details should be stored beside the payload: payload %s details %s", cells[0].Payload, cells[0].Details)
+	}
+
+	var got PodsSnapshot
+	if ok, err := store.Load("ctx", ResourceKindPods, "app", &got); err != nil || !ok {
+		t.Fatalf("load snapshot: ok %v err %v", ok, err)
+	}
+	it := got.Items[0]
+	if it.Labels["app"] != "api" || it.PodIP != "10.0.0.7" || it.Owner == nil || it.Owner.Name != "api" || len(it.References) != 1 {
+		t.Fatalf("row details not restored: %+v", it)
+	}
+
+	plane := newClusterPlane("ctx", ProfileFocused, DiscoveryModeTargeted, ObservationScope{ClusterName: "ctx"}, nil, func() snapshotPersistence { return store }, nil)
+	if err := plane.hydratePersistedSnapshots(time.Hour); err != nil {
+		t.Fatalf("hydrate: %v", err)
+	}
+	hydrated, ok := peekNamespacedSnapshot(&plane.podsStore, "app")
+	if !ok || len(hydrated.Items) != 1 || hydrated.Items[0].Labels["app"] != "api" {
+		t.Fatalf("hydrated rows lost their details: %+v", hydrated.Items)
+	}
+
+	if err := store.Delete("ctx", ResourceKindPods, "app"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if cells, _ := store.ListSnapshots("ctx"); len(cells) != 0 {
+		t.Fatalf("expected no cells after delete, got %d", len(cells))
+	}
+}
+
+func TestPersistedSnapshotRowDetailsCoverEveryRowKind(t *testing.T) {
+	refs := []dto.ResourceReferenceDTO{{Kind: "Secret", Name: "api-tls"}}
+	deps := DeploymentsSnapshot{Items: []dto.DeploymentListItemDTO{{Name: "api", Namespace: "app", References: refs}}}
+
+	payload, details, err := encodePersistedSnapshot(deps)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if strings.Contains(string(payload), "api-tls") || len(details) == 0 {
+		t.Fatalf("deployment references should be stored beside the payload: payload %s details %s", payload, details)
+	}
+	var got DeploymentsSnapshot
+	if err := decodePersistedSnapshot(payload, details, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got.Items) != 1 || len(got.Items[0].References) != 1 || got.Items[0].References[0].Name != "api-tls" {
+		t.Fatalf("deployment references not restored: %+v", got.Items)
+	}
+
+	nodes := NodesSnapshot{Items: []dto.NodeListItemDTO{{Name: "node-a"}}}
+	if _, details, err := encodePersistedSnapshot(nodes); err != nil || details != nil {
+		t.Fatalf("rows without in-process fields should have no details: %s, err %v", details, err)
+	}
+}
+
 func TestBoltSnapshotPersistenceMigrationFreshDB(t *testing.T) {
 	path := t.TempDir() + "/cache.bbolt"
 	store, err := openBoltSnapshotPersistence(path)
@@ -0,0 +1,453 @@
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// Scheduling checks, in the order the explainer evaluates them.
+const (
+	schedCheckUnschedulable  = "unschedulable"
+	schedCheckNodeSelector   = "nodeSelector"
+	schedCheckNodeAffinity   = "nodeAffinity"
+	schedCheckTaints         = "taints"
+	schedCheckPods           = "pods"
+	schedCheckCPU            = "cpu"
+	schedCheckMemory         = "memory"
+	schedCheckTopologySpread = "topologySpread"
+	schedCheckVolume         = "volume"
+)
+
+type podSchedulingInput struct {
+	spec  dto.PodSchedulingSpecDTO
+	nodes []dto.NodeListItemDTO
+	// pods are the pods of the pod's namespace, used for topology spread; nil when
+	// the snapshot is unavailable.
+	pods []dto.PodListItemDTO
+	// pvcs and pvs are keyed by name; nil when the snapshot is unavailable.
+	pvcs map[string]dto.PersistentVolumeClaimDTO
+	pvs  map[string]dto.PersistentVolumeDTO
+}
+
+// PodScheduling evaluates every node of the nodes snapshot against the pod's scheduling
+// inputs and explains per node why the pod does not fit. Pods, PVCs and PVs come from
+// their snapshots; checks whose snapshot is unavailable are skipped with a note.
+func (m *manager) PodScheduling(ctx context.Context, clusterName string, spec dto.PodSchedulingSpecDTO) (dto.PodSchedulingDTO, error) {
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return dto.PodSchedulingDTO{}, err
+	}
+	plane := planeAny.(*clusterPlane)
+
+	nodes, err := plane.NodesSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical)
+	if err != nil && len(nodes.Items) == 0 {
+		return dto.PodSchedulingDTO{}, err
+	}
+	in := podSchedulingInput{spec: spec, nodes: nodes.Items}
+	if len(spec.TopologySpread) > 0 {
+		if pods, err := plane.PodsSnapshot(ctx, m.scheduler, m.clients, spec.Namespace, WorkPriorityCritical); err == nil || len(pods.Items) > 0 {
+			in.pods = pods.Items
+		}
+	}
+	if len(spec.PVCs) > 0 {
+		if pvcs, err := plane.PVCsSnapshot(ctx, m.scheduler, m.clients, spec.Namespace, WorkPriorityCritical); err == nil || len(pvcs.Items) > 0 {
+			in.pvcs = make(map[string]dto.PersistentVolumeClaimDTO, len(pvcs.Items))
+			for _, pvc := range pvcs.Items {
+				in.pvcs[pvc.Name] = pvc
+			}
+		}
+		if pvs, err := plane.PersistentVolumesSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical); err == nil || len(pvs.Items) > 0 {
+			in.pvs = make(map[string]dto.PersistentVolumeDTO, len(pvs.Items))
+			for _, pv := range pvs.Items {
+				in.pvs[pv.Name] = pv
+			}
+		}
+	}
+	return explainPodScheduling(in), nil
+}
+
+func explainPodScheduling(in podSchedulingInput) dto.PodSchedulingDTO {
+	spec := in.spec
+	out := dto.PodSchedulingDTO{
+		Pod:                spec.Name,
+		Namespace:          spec.Namespace,
+		Phase:              spec.Phase,
+		NodeName:           spec.NodeName,
+		CPURequestMilli:    spec.CPURequestMilli,
+		MemoryRequestBytes: spec.MemoryRequestBytes,
+		TotalNodes:         len(in.nodes),
+		Summary:            []dto.SchedulingCheckCountDTO{},
+		Nodes:              make([]dto.NodeSchedulingFitDTO, 0, len(in.nodes)),
+	}
+	if spec.NodeName != "" {
+		out.Notes = append(out.Notes, fmt.Sprintf("pod is already bound to node %s; its own requests are not counted against that node", spec.NodeName))
+	}
+	if len(in.nodes) > 0 && !in.nodes[0].RequestsObserved {
+		out.Notes = append(out.Notes, "requested resources per node are unavailable; cpu and memory checks were skipped")
+	}
+
+	spread := newTopologySpreadState(in, &out.Notes)
+	volumes := podVolumeAffinities(in, &out.Notes)
+
+	failing := map[string]int{}
+	for _, node := range in.nodes {
+		fit := evaluateNodeFit(spec, node, spread, volumes)
+		fit.Fits = len(fit.Reasons) == 0
+		if fit.Fits {
+			out.FeasibleNodes++
+		}
+		seen := map[string]bool{}
+		for _, r := range fit.Reasons {
+			if !seen[r.Check] {
+				seen[r.Check] = true
+				failing[r.Check]++
+			}
+		}
+		out.Nodes = append(out.Nodes, fit)
+	}
+	sort.SliceStable(out.Nodes, func(i, j int) bool {
+		if out.Nodes[i].Fits != out.Nodes[j].Fits {
+			return out.Nodes[i].Fits
+		}
+		return out.Nodes[i].Node < out.Nodes[j].Node
+	})
+	for check, n := range failing {
+		out.Summary = append(out.Summary, dto.SchedulingCheckCountDTO{Check: check, Nodes: n})
+	}
+	sort.Slice(out.Summary, func(i, j int) bool {
+		if out.Summary[i].Nodes != out.Summary[j].Nodes {
+			return out.Summary[i].Nodes > out.Summary[j].Nodes
+		}
+		return out.Summary[i].Check < out.Summary[j].Check
+	})
+	return out
+}
+
+// podPhaseTerminated reports whether phase is Succeeded or Failed; such pods hold no
+// node resources.
+func podPhaseTerminated(phase string) bool {
+	return phase == string(corev1.PodSucceeded) || phase == string(corev1.PodFailed)
+}
+
+func evaluateNodeFit(spec dto.PodSchedulingSpecDTO, node dto.NodeListItemDTO, spread *topologySpreadState, volumes []volumeAffinity) dto.NodeSchedulingFitDTO {
+	fit := dto.NodeSchedulingFitDTO{Node: node.Name}
+	add := func(check, format string, args ...any) {
+		fit.Reasons = append(fit.Reasons, dto.SchedulingReasonDTO{Check: check, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if node.Unschedulable && !toleratesTaint(spec.Tolerations, dto.NodeTaintDTO{Key: corev1.TaintNodeUnschedulable, Effect: string(corev1.TaintEffectNoSchedule)}) {
+		add(schedCheckUnschedulable, "node is cordoned (unschedulable)")
+	}
+	selectorKeys := make([]string, 0, len(spec.NodeSelector))
+	for key := range spec.NodeSelector {
+		selectorKeys = append(selectorKeys, key)
+	}
+	sort.Strings(selectorKeys)
+	for _, key := range selectorKeys {
+		want := spec.NodeSelector[key]
+		if got, ok := node.Labels[key]; !ok {
+			add(schedCheckNodeSelector, "node selector %s=%s: label is missing", key, want)
+		} else if got != want {
+			add(schedCheckNodeSelector, "node selector %s=%s: node has %s=%s", key, want, key, got)
+		}
+	}
+	if len(spec.RequiredNodeAffinity) > 0 && !nodeSelectorTermsMatch(spec.RequiredNodeAffinity, node) {
+		add(schedCheckNodeAffinity, "node matches none of the required node affinity terms")
+	}
+	for _, t := range node.Taints {
+		if t.Effect != string(corev1.TaintEffectNoSchedule) && t.Effect != string(corev1.TaintEffectNoExecute) {
+			continue
+		}
+		// A cordoned node also carries the unschedulable taint; it is reported once above.
+		if node.Unschedulable && t.Key == corev1.TaintNodeUnschedulable {
+			continue
+		}
+		if !toleratesTaint(spec.Tolerations, t) {
+			add(schedCheckTaints, "untolerated taint %s", formatTaint(t))
+		}
+	}
+
+	// A pod bound to this node is already part of its usage; take it out so the pod is
+	// not compared against itself.
+	podsCount, cpuUsed, memUsed := node.PodsCount, node.CPURequestedMilli, node.MemoryRequestedBytes
+	if spec.NodeName != "" && spec.NodeName == node.Name && !podPhaseTerminated(spec.Phase) {
+		podsCount = max(podsCount-1, 0)
+		cpuUsed = max(cpuUsed-spec.CPURequestMilli, 0)
+		memUsed = max(memUsed-spec.MemoryRequestBytes, 0)
+	}
+	if podsAlloc, err := strconv.Atoi(strings.TrimSpace(node.PodsAllocatable)); err == nil && podsCount >= podsAlloc {
+		add(schedCheckPods, "too many pods: %d of %d allocatable", podsCount, podsAlloc)
+	}
+	cpuAlloc := parseQuantityOrZero(node.CPUAllocatable)
+	memAlloc := parseQuantityOrZero(node.MemoryAllocatable)
+	fit.CPUFreeMilli = cpuAlloc.MilliValue() - cpuUsed
+	fit.MemoryFreeBytes = memAlloc.Value() - memUsed
+	if node.RequestsObserved {
+		if spec.CPURequestMilli > fit.CPUFreeMilli {
+			add(schedCheckCPU, "insufficient cpu: requests %dm, %dm free of %dm allocatable", spec.CPURequestMilli, fit.CPUFreeMilli, cpuAlloc.MilliValue())
+		}
+		if spec.MemoryRequestBytes > fit.MemoryFreeBytes {
+			add(schedCheckMemory, "insufficient memory: requests %s, %s free of %s allocatable",
+				formatBytes(spec.MemoryRequestBytes), formatBytes(fit.MemoryFreeBytes), formatBytes(memAlloc.Value()))
+		}
+	}
+
+	for _, msg := range spread.violations(node) {
+		add(schedCheckTopologySpread, "%s", msg)
+	}
+	for _, v := range volumes {
+		if !nodeSelectorTermsMatch(v.terms, node) {
+			add(schedCheckVolume, "volume node affinity conflict: PV %s (claim %s) is not reachable from this node", v.pv, v.pvc)
+		}
+	}
+	return fit
+}
+
+// toleratesTaint mirrors the scheduler's toleration matching: an empty effect matches
+// every effect, Exists with an empty key tolerates everything, and Equal (the default
+// operator) also compares the value.
+func toleratesTaint(tols []dto.TolerationDTO, taint dto.NodeTaintDTO) bool {
+	for _, tol := range tols {
+		if tol.Effect != "" && tol.Effect != taint.Effect {
+			continue
+		}
+		if tol.Key == "" && tol.Operator == string(corev1.TolerationOpExists) {
+			return true
+		}
+		if tol.Key != taint.Key {
+			continue
+		}
+		switch tol.Operator {
+		case string(corev1.TolerationOpExists):
+			return true
+		case "", string(corev1.TolerationOpEqual):
+			if tol.Value == taint.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatTaint(t dto.NodeTaintDTO) string {
+	if t.Value == "" {
+		return t.Key + ":" + t.Effect
+	}
+	return t.Key + "=" + t.Value + ":" + t.Effect
+}
+
+// nodeSelectorTermsMatch reports whether the node satisfies any term. An empty term
+// matches no node, like in the API.
+func nodeSelectorTermsMatch(terms []dto.NodeSelectorTermDTO, node dto.NodeListItemDTO) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		if nodeSelectorRequirementsMatch(term.MatchExpressions, labels.Set(node.Labels)) &&
+			nodeSelectorRequirementsMatch(term.MatchFields, labels.Set{"metadata.name": node.Name}) {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeSelectorRequirementsMatch(reqs []dto.NodeSelectorRequirementDTO, set labels.Set) bool {
+	for _, r := range reqs {
+		req, err := nodeSelectorRequirement(r)
+		if err != nil || !req.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeSelectorRequirement(r dto.NodeSelectorRequirementDTO) (*labels.Requirement, error) {
+	var op selection.Operator
+	switch corev1.NodeSelectorOperator(r.Operator) {
+	case corev1.NodeSelectorOpIn:
+		op = selection.In
+	case corev1.NodeSelectorOpNotIn:
+		op = selection.NotIn
+	case corev1.NodeSelectorOpExists:
+		op = selection.Exists
+	case corev1.NodeSelectorOpDoesNotExist:
+		op = selection.DoesNotExist
+	case corev1.NodeSelectorOpGt:
+		op = selection.GreaterThan
+	case corev1.NodeSelectorOpLt:
+		op = selection.LessThan
+	default:
+		return nil, fmt.Errorf("unsupported node selector operator %q", r.Operator)
+	}
+	return labels.NewRequirement(r.Key, op, r.Values)
+}
+
+// topologySpreadState holds the per-domain pod counts of every DoNotSchedule
+// constraint. ScheduleAnyway constraints only score nodes and never block them.
+type topologySpreadState struct {
+	constraints []topologySpreadCount
+}
+
+type topologySpreadCount struct {
+	constraint dto.TopologySpreadConstraintDTO
+	counts     map[string]int
+	min        int
+	selfMatch  int
+}
+
+func newTopologySpreadState(in podSchedulingInput, notes *[]string) *topologySpreadState {
+	state := &topologySpreadState{}
+	var hard []dto.TopologySpreadConstraintDTO
+	for _, c := range in.spec.TopologySpread {
+		if c.WhenUnsatisfiable == string(corev1.DoNotSchedule) {
+			hard = append(hard, c)
+		}
+	}
+	if len(hard) == 0 {
+		return state
+	}
+	if in.pods == nil {
+		*notes = append(*notes, "pods snapshot is unavailable; topology spread constraints were skipped")
+		return state
+	}
+
+	nodeLabels := make(map[string]map[string]string, len(in.nodes))
+	for _, n := range in.nodes {
+		nodeLabels[n.Name] = n.Labels
+	}
+	for _, c := range hard {
+		// An empty selector string means the constraint has no selector and matches no pods.
+		var sel labels.Selector = labels.Nothing()
+		if strings.TrimSpace(c.LabelSelector) != "" {
+			parsed, err := labels.Parse(c.LabelSelector)
+			if err != nil {
+				*notes = append(*notes, fmt.Sprintf("topology spread on %s: invalid selector ignored: %v", c.TopologyKey, err))
+				continue
+			}
+			sel = parsed
+		}
+		tc := topologySpreadCount{constraint: c, counts: map[string]int{}}
+		// Domains are the values of the topology key on nodes that pass the pod's node
+		// selector and required affinity.
+		for _, n := range in.nodes {
+			v, ok := n.Labels[c.TopologyKey]
+			if !ok || !nodePassesPodNodeSelection(in.spec, n) {
+				continue
+			}
+			tc.counts[v] += 0
+		}
+		for _, p := range in.pods {
+			if p.Name == in.spec.Name || p.Node == "" || podPhaseTerminated(p.Phase) {
+				continue
+			}
+			if !sel.Matches(labels.Set(p.Labels)) {
+				continue
+			}
+			v, ok := nodeLabels[p.Node][c.TopologyKey]
+			if _, domain := tc.counts[v]; ok && domain {
+				tc.counts[v]++
+			}
+		}
+		first := true
+		for _, n := range tc.counts {
+			if first || n < tc.min {
+				tc.min, first = n, false
+			}
+		}
+		if sel.Matches(labels.Set(in.spec.Labels)) {
+			tc.selfMatch = 1
+		}
+		state.constraints = append(state.constraints, tc)
+	}
+	return state
+}
+
+func (s *topologySpreadState) violations(node dto.NodeListItemDTO) []string {
+	var out []string
+	for _, tc := range s.constraints {
+		c := tc.constraint
+		v, ok := node.Labels[c.TopologyKey]
+		if !ok {
+			out = append(out, fmt.Sprintf("node has no %s label required by a topology spread constraint", c.TopologyKey))
+			continue
+		}
+		skew := tc.counts[v] + tc.selfMatch - tc.min
+		if skew > int(c.MaxSkew) {
+			out = append(out, fmt.Sprintf("topology spread on %s: %s=%s would have skew %d (maxSkew %d)", c.TopologyKey, c.TopologyKey, v, skew, c.MaxSkew))
+		}
+	}
+	return out
+}
+
+func nodePassesPodNodeSelection(spec dto.PodSchedulingSpecDTO, node dto.NodeListItemDTO) bool {
+	for k, v := range spec.NodeSelector {
+		if node.Labels[k] != v {
+			return false
+		}
+	}
+	return len(spec.RequiredNodeAffinity) == 0 || nodeSelectorTermsMatch(spec.RequiredNodeAffinity, node)
+}
+
+// volumeAffinity is the required node affinity of a PV bound to one of the pod's claims.
+type volumeAffinity struct {
+	pvc   string
+	pv    string
+	terms []dto.NodeSelectorTermDTO
+}
+
+func podVolumeAffinities(in podSchedulingInput, notes *[]string) []volumeAffinity {
+	if len(in.spec.PVCs) == 0 {
+		return nil
+	}
+	if in.pvcs == nil {
+		*notes = append(*notes, "PVC snapshot is unavailable; volume checks were skipped")
+		return nil
+	}
+	var out []volumeAffinity
+	for _, name := range in.spec.PVCs {
+		pvc, ok := in.pvcs[name]
+		switch {
+		case !ok:
+			*notes = append(*notes, fmt.Sprintf("PVC %s does not exist; the pod cannot be scheduled until it is created", name))
+			continue
+		case pvc.VolumeName == "":
+			*notes = append(*notes, fmt.Sprintf("PVC %s is not bound yet; a WaitForFirstConsumer volume is provisioned for the chosen node", name))
+			continue
+		}
+		if in.pvs == nil {
+			*notes = append(*notes, fmt.Sprintf("PV snapshot is unavailable; node affinity of PV %s was not checked", pvc.VolumeName))
+			continue
+		}
+		pv, ok := in.pvs[pvc.VolumeName]
+		if !ok {
+			*notes = append(*notes, fmt.Sprintf("PV %s bound to PVC %s was not found", pvc.VolumeName, name))
+			continue
+		}
+		if len(pv.NodeAffinity) > 0 {
+			out = append(out, volumeAffinity{pvc: name, pv: pv.Name, terms: pv.NodeAffinity})
+		}
+	}
+	return out
+}
+
+func parseQuantityOrZero(s string) resource.Quantity {
+	q, err := resource.ParseQuantity(strings.TrimSpace(s))
+	if err != nil {
+		return resource.Quantity{}
+	}
+	return q
+}
+
+func formatBytes(n int64) string {
+	return resource.NewQuantity(n, resource.BinarySI).String()
+}
@@ -0,0 +1,231 @@
+package dataplane
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func schedulingTestNode(name, zone string) dto.NodeListItemDTO {
+	return dto.NodeListItemDTO{
+		Name:              name,
+		CPUAllocatable:    "4",
+		MemoryAllocatable: "8Gi",
+		PodsAllocatable:   "110",
+		Labels:            map[string]string{"topology.kubernetes.io/zone": zone, "kubernetes.io/hostname": name},
+		RequestsObserved:  true,
+	}
+}
+
+func schedulingFit(t *testing.T, res dto.PodSchedulingDTO, node string) dto.NodeSchedulingFitDTO {
+	t.Helper()
+	for _, fit := range res.Nodes {
+		if fit.Node == node {
+			return fit
+		}
+	}
+	t.Fatalf("node %s missing from result", node)
+	return dto.NodeSchedulingFitDTO{}
+}
+
+func fitChecks(fit dto.NodeSchedulingFitDTO) string {
+	checks := make([]string, 0, len(fit.Reasons))
+	for _, r := range fit.Reasons {
+		checks = append(checks, r.Check)
+	}
+	return strings.Join(checks, ",")
+}
+
+func TestExplainPodSchedulingNodeChecks(t *testing.T) {
+	cordoned := schedulingTestNode("cordoned", "a")
+	cordoned.Unschedulable = true
+	cordoned.Taints = []dto.NodeTaintDTO{{Key: "node.kubernetes.io/unschedulable", Effect: "NoSchedule"}}
+	tainted := schedulingTestNode("tainted", "a")
+	tainted.Taints = []dto.NodeTaintDTO{
+		{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"},
+		{Key: "soft", Effect: "PreferNoSchedule"},
+	}
+	tolerated := schedulingTestNode("tolerated", "a")
+	tolerated.Taints = []dto.NodeTaintDTO{{Key: "spot", Value: "true", Effect: "NoExecute"}}
+	full := schedulingTestNode("full", "a")
+	full.CPURequestedMilli = 3800
+	wrongPool := schedulingTestNode("wrong-pool", "a")
+	wrongPool.Labels["pool"] = "batch"
+	wrongZone := schedulingTestNode("wrong-zone", "c")
+
+	nodes := []dto.NodeListItemDTO{cordoned, tainted, tolerated, full, wrongPool, wrongZone}
+	for i := range nodes {
+		if nodes[i].Name != "wrong-pool" {
+			nodes[i].Labels["pool"] = "web"
+		}
+	}
+	res := explainPodScheduling(podSchedulingInput{
+		spec: dto.PodSchedulingSpecDTO{
+			Name:         "web-0",
+			Namespace:    "prod",
+			Phase:        "Pending",
+			NodeSelector: map[string]string{"pool": "web"},
+			RequiredNodeAffinity: []dto.NodeSelectorTermDTO{{MatchExpressions: []dto.NodeSelectorRequirementDTO{
+				{Key: "topology.kubernetes.io/zone", Operator: "In", Values: []string{"a", "b"}},
+			}}},
+			Tolerations:        []dto.TolerationDTO{{Key: "spot", Operator: "Exists"}},
+			CPURequestMilli:    500,
+			MemoryRequestBytes: 256 << 20,
+		},
+		nodes: nodes,
+	})
+
+	if res.TotalNodes != 6 || res.FeasibleNodes != 1 {
+		t.Fatalf("expected 1 of 6 feasible nodes, got %d of %d", res.FeasibleNodes, res.TotalNodes)
+	}
+	if res.Nodes[0].Node != "tolerated" || !res.Nodes[0].Fits {
+		t.Fatalf("expected the fitting node first, got %+v", res.Nodes[0])
+	}
+	want := map[string]string{
+		"cordoned":   "unschedulable",
+		"tainted":    "taints",
+		"full":       "cpu",
+		"wrong-pool": "nodeSelector",
+		"wrong-zone": "nodeAffinity",
+	}
+	for node, checks := range want {
+		if got := fitChecks(schedulingFit(t, res, node)); got != checks {
+			t.Fatalf("%s: expected checks %q, got %q", node, checks, got)
+		}
+	}
+	if free := schedulingFit(t, res, "full").CPUFreeMilli; free != 200 {
+		t.Fatalf("expected 200m free on full node, got %d", free)
+	}
+	if len(res.Summary) != 5 || res.Summary[0].Nodes != 1 {
+		t.Fatalf("unexpected summary: %+v", res.Summary)
+	}
+}
+
+func TestExplainPodSchedulingTopologySpread(t *testing.T) {
+	nodes := []dto.NodeListItemDTO{
+		schedulingTestNode("n1", "a"),
+		schedulingTestNode("n2", "b"),
+		schedulingTestNode("n3", "b"),
+	}
+	unlabeled := schedulingTestNode("n4", "")
+	delete(unlabeled.Labels, "topology.kubernetes.io/zone")
+	nodes = append(nodes, unlabeled)
+
+	res := explainPodScheduling(podSchedulingInput{
+		spec: dto.PodSchedulingSpecDTO{
+			Name:      "web-2",
+			Namespace: "prod",
+			Labels:    map[string]string{"app": "web"},
+			TopologySpread: []dto.TopologySpreadConstraintDTO{
+				{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: "DoNotSchedule", LabelSelector: "app=web"},
+				{MaxSkew: 1, TopologyKey: "kubernetes.io/hostname", WhenUnsatisfiable: "ScheduleAnyway", LabelSelector: "app=web"},
+			},
+		},
+		nodes: nodes,
+		pods: []dto.PodListItemDTO{
+			{Name: "web-0", Node: "n1", Phase: "Running", Labels: map[string]string{"app": "web"}},
+			{Name: "web-1", Node: "n1", Phase: "Running", Labels: map[string]string{"app": "web"}},
+			{Name: "web-old", Node: "n2", Phase: "Succeeded", Labels: map[string]string{"app": "web"}},
+			{Name: "db-0", Node: "n2", Phase: "Running", Labels: map[string]string{"app": "db"}},
+		},
+	})
+
+	if got := fitChecks(schedulingFit(t, res, "n1")); got != "topologySpread" {
+		t.Fatalf("zone a already holds 2 pods; expected a topology spread failure, got %q", got)
+	}
+	if fit := schedulingFit(t, res, "n2"); !fit.Fits {
+		t.Fatalf("zone b is empty and should fit, got %+v", fit.Reasons)
+	}
+	if got := fitChecks(schedulingFit(t, res, "n4")); got != "topologySpread" {
+		t.Fatalf("node without the topology key should fail, got %q", got)
+	}
+}
+
+func TestExplainPodSchedulingExcludesBoundPodFromItsNode(t *testing.T) {
+	home := schedulingTestNode("home", "a")
+	home.PodsAllocatable = "2"
+	home.PodsCount = 2
+	home.CPURequestedMilli = 3800
+	home.MemoryRequestedBytes = 7 << 30
+	other := schedulingTestNode("other", "a")
+	other.PodsAllocatable = "2"
+	other.PodsCount = 2
+	other.CPURequestedMilli = 3800
+
+	res := explainPodScheduling(podSchedulingInput{
+		spec: dto.PodSchedulingSpecDTO{
+			Name:               "web-0",
+			Namespace:          "prod",
+			Phase:              "Running",
+			NodeName:           "home",
+			CPURequestMilli:    1000,
+			MemoryRequestBytes: 2 << 30,
+		},
+		nodes: []dto.NodeListItemDTO{home, other},
+	})
+
+	fit := schedulingFit(t, res, "home")
+	if !fit.Fits {
+		t.Fatalf("expected the pod to fit its own node, got %+v", fit.Reasons)
+	}
+	if fit.CPUFreeMilli != 1200 || fit.MemoryFreeBytes != 3<<30 {
+		t.Fatalf("expected own requests returned to home, got %dm and %d bytes free", fit.CPUFreeMilli, fit.MemoryFreeBytes)
+	}
+	if got := fitChecks(schedulingFit(t, res, "other")); got != "pods,cpu" {
+		t.Fatalf("other: expected checks %q, got %q", "pods,cpu", got)
+	}
+}
+
+func TestExplainPodSchedulingVolumes(t *testing.T) {
+	zoneAffinity := func(zone string) []dto.NodeSelectorTermDTO {
+		return []dto.NodeSelectorTermDTO{{MatchExpressions: []dto.NodeSelectorRequirementDTO{
+			{Key: "topology.kubernetes.io/zone", Operator: "In", Values: []string{zone}},
+		}}}
+	}
+	res := explainPodScheduling(podSchedulingInput{
+		spec: dto.PodSchedulingSpecDTO{
+			Name:      "db-0",
+			Namespace: "prod",
+			PVCs:      []string{"data", "pending", "missing"},
+		},
+		nodes: []dto.NodeListItemDTO{schedulingTestNode("n1", "a"), schedulingTestNode("n2", "b")},
+		pvcs: map[string]dto.PersistentVolumeClaimDTO{
+			"data":    {Name: "data", VolumeName: "pv-data"},
+			"pending": {Name: "pending"},
+		},
+		pvs: map[string]dto.PersistentVolumeDTO{
+			"pv-data": {Name: "pv-data", NodeAffinity: zoneAffinity("a")},
+		},
+	})
+
+	if fit := schedulingFit(t, res, "n1"); !fit.Fits {
+		t.Fatalf("n1 is in the volume zone, got %+v", fit.Reasons)
+	}
+	if got := fitChecks(schedulingFit(t, res, "n2")); got != "volume" {
+		t.Fatalf("expected a volume conflict on n2, got %q", got)
+	}
+	if len(res.Notes) != 2 {
+		t.Fatalf("expected notes for the unbound and missing claims, got %v", res.Notes)
+	}
+}
+
+func TestToleratesTaint(t *testing.T) {
+	taint := dto.NodeTaintDTO{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}
+	cases := []struct {
+		name string
+		tol  dto.TolerationDTO
+		want bool
+	}{
+		{"exists all", dto.TolerationDTO{Operator: "Exists"}, true},
+		{"equal value", dto.TolerationDTO{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}, true},
+		{"default operator", dto.TolerationDTO{Key: "dedicated", Value: "gpu"}, true},
+		{"wrong value", dto.TolerationDTO{Key: "dedicated", Value: "cpu"}, false},
+		{"wrong effect", dto.TolerationDTO{Key: "dedicated", Operator: "Exists", Effect: "NoExecute"}, false},
+	}
+	for _, tc := range cases {
+		if got := toleratesTaint([]dto.TolerationDTO{tc.tol}, taint); got != tc.want {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
@@ -22,6 +22,7 @@ type DataplanePolicy struct {
 	Snapshots            SnapshotPolicy             `json:"snapshots"`
 	Persistence          PersistencePolicy          `json:"persistence"`
 	Observers            ObserverPolicy             `json:"observers"`
+	Watch                WatchPolicy                `json:"watch"`
 	NamespaceEnrichment  NamespaceEnrichmentPolicy  `json:"namespaceEnrichment"`
 	AllContextEnrichment AllContextEnrichmentPolicy `json:"allContextEnrichment"`
 	BackgroundBudget     BackgroundBudgetPolicy     `json:"backgroundBudget"`
@@ -40,6 +41,7 @@ type DataplanePolicyOverride struct {
 	Profile              *DataplaneProfile                   `json:"profile,omitempty"`
 	Snapshots            *SnapshotPolicyOverride             `json:"snapshots,omitempty"`
 	Observers            *ObserverPolicyOverride             `json:"observers,omitempty"`
+	Watch                *WatchPolicyOverride                `json:"watch,omitempty"`
 	NamespaceEnrichment  *NamespaceEnrichmentPolicyOverride  `json:"namespaceEnrichment,omitempty"`
 	AllContextEnrichment *AllContextEnrichmentPolicyOverride `json:"allContextEnrichment,omitempty"`
 	BackgroundBudget     *BackgroundBudgetPolicyOverride     `json:"backgroundBudget,omitempty"`
@@ -63,6 +65,13 @@ type ObserverPolicyOverride struct {
 	NodesBackoffMaxSec    *int  `json:"nodesBackoffMaxSec,omitempty"`
 }
 
+type WatchPolicyOverride struct {
+	Kinds                *[]string `json:"kinds,omitempty"`
+	MaxWatchesPerCluster *int      `json:"maxWatchesPerCluster,omitempty"`
+	IdleStopSec          *int      `json:"idleStopSec,omitempty"`
+	PersistIntervalSec   *int      `json:"persistIntervalSec,omitempty"`
+}
+
 type NamespaceEnrichmentPolicyOverride struct {
 	Enabled           *bool                         `json:"enabled,omitempty"`
 	IncludeFocus      *bool                         `json:"includeFocus,omitempty"`
@@ -244,6 +253,16 @@ type ObserverPolicy struct {
 	NodesBackoffMaxSec    int  `json:"nodesBackoffMaxSec"`
 }
 
+// WatchPolicy opts snapshot kinds into watch-backed freshness. Listed kinds are
+// kept current from watch events after their first list; everything else keeps
+// TTL list polling. See snapshot_watch.go for the supported kinds.
+type WatchPolicy struct {
+	Kinds                []string `json:"kinds"`
+	MaxWatchesPerCluster int      `json:"maxWatchesPerCluster"`
+	IdleStopSec          int      `json:"idleStopSec"`
+	PersistIntervalSec   int      `json:"persistIntervalSec"`
+}
+
 type NamespaceEnrichmentPolicy struct {
 	Enabled           bool     `json:"enabled"`
 	IncludeFocus      bool     `json:"includeFocus"`
@@ -310,6 +329,9 @@ func DefaultDataplanePolicy() DataplanePolicy {
 				string(ResourceKindClusterRoles):        300,
 				string(ResourceKindClusterRoleBindings): 300,
 				string(ResourceKindCRDs):                300,
+				string(ResourceKindStorageClasses):      300,
+				string(ResourceKindCSIDrivers):          300,
+				string(ResourceKindVolumeAttachments):   60,
 				string(ResourceKindPods):                15,
 				string(ResourceKindDeployments):         45,
 				string(ResourceKindDaemonSets):          45,
@@ -329,6 +351,9 @@ func DefaultDataplanePolicy() DataplanePolicy {
 				string(ResourceKindHelmReleases):        120,
 				string(ResourceKindResourceQuotas):      180,
 				string(ResourceKindLimitRanges):         180,
+				string(ResourceKindNetworkPolicies):     120,
+				string(ResourceKindPDBs):                60,
+				string(ResourceKindGenericResources):    60,
 				string(ResourceKindPodMetrics):          30,
 				string(ResourceKindNodeMetrics):         30,
 			},
@@ -347,6 +372,12 @@ func DefaultDataplanePolicy() DataplanePolicy {
 			NodesIntervalSec:      180,
 			NodesBackoffMaxSec:    300,
 		},
+		Watch: WatchPolicy{
+			Kinds:                []string{},
+			MaxWatchesPerCluster: 32,
+			IdleStopSec:          600,
+			PersistIntervalSec:   60,
+		},
 		NamespaceEnrichment: NamespaceEnrichmentPolicy{
 			Enabled:           true,
 			IncludeFocus:      true,
@@ -461,6 +492,11 @@ func ValidateDataplanePolicy(in DataplanePolicy) DataplanePolicy {
 	out.Observers.NodesIntervalSec = clampInt(out.Observers.NodesIntervalSec, 10, 3600, def.Observers.NodesIntervalSec)
 	out.Observers.NodesBackoffMaxSec = clampInt(out.Observers.NodesBackoffMaxSec, 30, 3600, def.Observers.NodesBackoffMaxSec)
 
+	out.Watch.Kinds = normalizeWatchResourceKinds(out.Watch.Kinds)
+	out.Watch.MaxWatchesPerCluster = clampInt(out.Watch.MaxWatchesPerCluster, 1, 256, def.Watch.MaxWatchesPerCluster)
+	out.Watch.IdleStopSec = clampInt(out.Watch.IdleStopSec, 60, 86400, def.Watch.IdleStopSec)
+	out.Watch.PersistIntervalSec = clampInt(out.Watch.PersistIntervalSec, 10, 3600, def.Watch.PersistIntervalSec)
+
 	ne := &out.NamespaceEnrichment
 	ne.RecentLimit = clampInt(ne.RecentLimit, 0, 200, def.NamespaceEnrichment.RecentLimit)
 	ne.FavouriteLimit = clampInt(ne.FavouriteLimit, 0, 200, def.NamespaceEnrichment.FavouriteLimit)
@@ -552,6 +588,7 @@ func ValidateDataplanePolicy(in DataplanePolicy) DataplanePolicy {
 
 	if out.Profile == DataplaneProfileManual {
 		out.Observers.Enabled = false
+		out.Watch.Kinds = []string{}
 		out.NamespaceEnrichment.Enabled = false
 		out.NamespaceEnrichment.Sweep.Enabled = false
 	}
@@ -606,6 +643,7 @@ func (b DataplanePolicyBundle) EffectivePolicy(contextName string) DataplanePoli
 func CloneDataplanePolicy(in DataplanePolicy) DataplanePolicy {
 	out := in
 	out.Snapshots.TTLSeconds = cloneStringIntMap(in.Snapshots.TTLSeconds)
+	out.Watch.Kinds = append([]string(nil), in.Watch.Kinds...)
 	out.NamespaceEnrichment.WarmResourceKinds = append([]string(nil), in.NamespaceEnrichment.WarmResourceKinds...)
 	out.Signals.Overrides = cloneSignalOverrideMap(in.Signals.Overrides)
 	out.Signals.ContextOverrides = cloneContextSignalOverrideMap(in.Signals.ContextOverrides)
@@ -671,6 +709,20 @@ func applyDataplanePolicyOverride(global DataplanePolicy, override DataplanePoli
 			out.Observers.NodesBackoffMaxSec = *ov.NodesBackoffMaxSec
 		}
 	}
+	if ov := override.Watch; ov != nil {
+		if ov.Kinds != nil {
+			out.Watch.Kinds = append([]string(nil), (*ov.Kinds)...)
+		}
+		if ov.MaxWatchesPerCluster != nil {
+			out.Watch.MaxWatchesPerCluster = *ov.MaxWatchesPerCluster
+		}
+		if ov.IdleStopSec != nil {
+			out.Watch.IdleStopSec = *ov.IdleStopSec
+		}
+		if ov.PersistIntervalSec != nil {
+			out.Watch.PersistIntervalSec = *ov.PersistIntervalSec
+		}
+	}
 	if ov := override.NamespaceEnrichment; ov != nil {
 		if ov.Enabled != nil {
 			out.NamespaceEnrichment.Enabled = *ov.Enabled
@@ -895,6 +947,39 @@ func normalizeNamespaceWarmResourceKinds(in []string, fallback []string) []strin
 	return out
 }
 
+// normalizeWatchResourceKinds keeps supported, de-duplicated kinds. Unlike warm
+// kinds there is no fallback: an empty list means watch mode is off.
+func normalizeWatchResourceKinds(in []string) []string {
+	allowed := map[string]struct{}{}
+	for _, kind := range snapshotWatchResourceKinds() {
+		allowed[string(kind)] = struct{}{}
+	}
+	out := make([]string, 0, len(in))
+	seen := map[string]struct{}{}
+	for _, raw := range in {
+		kind := strings.TrimSpace(raw)
+		if _, ok := allowed[kind]; !ok {
+			continue
+		}
+		if _, ok := seen[kind]; ok {
+			continue
+		}
+		seen[kind] = struct{}{}
+		out = append(out, kind)
+	}
+	return out
+}
+
+// WatchesKind reports whether kind is opted into watch-backed snapshots.
+func (p WatchPolicy) WatchesKind(kind ResourceKind) bool {
+	for _, k := range p.Kinds {
+		if k == string(kind) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p DataplanePolicy) SnapshotTTL(kind ResourceKind) time.Duration {
 	def := DefaultDataplanePolicy()
 	secs := p.Snapshots.TTLSeconds[string(kind)]
@@ -1,6 +1,7 @@
 package dataplane
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 )
@@ -231,6 +232,34 @@ func TestManagerSetPolicyBundlePreservesOverridesAndScopedMetrics(t *testing.T)
 	}
 }
 
+func TestManagerEvictsOldestImpersonatedPlane(t *testing.T) {
+	dm := NewManager(ManagerConfig{})
+	m := dm.(*manager)
+	if _, err := m.PlaneForCluster(t.Context(), "ctx"); err != nil {
+		t.Fatalf("plain plane: %v", err)
+	}
+	scoped := func(i int) string { return fmt.Sprintf("ctx|as=user:user-%d", i) }
+	first, _ := m.PlaneForCluster(t.Context(), scoped(0))
+	for i := 1; i <= maxImpersonatedPlanes; i++ {
+		if _, err := m.PlaneForCluster(t.Context(), scoped(i)); err != nil {
+			t.Fatalf("scoped plane %d: %v", i, err)
+		}
+	}
+
+	if len(m.planes) != maxImpersonatedPlanes+1 {
+		t.Fatalf("planes = %d, want %d", len(m.planes), maxImpersonatedPlanes+1)
+	}
+	if _, ok := m.planes["ctx"]; !ok {
+		t.Fatalf("plain plane was evicted")
+	}
+	if _, ok := m.planes[scoped(0)]; ok {
+		t.Fatalf("oldest impersonated plane was not evicted")
+	}
+	if first.(*clusterPlane).lifetime.Err() == nil {
+		t.Fatalf("evicted plane was not stopped")
+	}
+}
+
 func TestManagerActiveContextSwitchDoesNotRewriteGlobalPolicy(t *testing.T) {
 	dm := NewManager(ManagerConfig{})
 	m := dm.(*manager)
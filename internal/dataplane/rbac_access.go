@@ -0,0 +1,431 @@
+package dataplane
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// WhoCanRequest is a reverse RBAC question: which subjects may perform Verb on
+// Resource (optionally Subresource and a single object Name) in Namespace. An empty
+// Namespace asks about cluster-wide access, which only ClusterRoleBindings grant.
+type WhoCanRequest struct {
+	Verb        string
+	Group       string
+	Resource    string
+	Subresource string
+	Name        string
+	Namespace   string
+}
+
+// rbacSnapshot is the RBAC state the evaluation reads, taken from snapshots.
+type rbacSnapshot struct {
+	roles               map[string]map[string]dto.RoleListItemDTO // namespace → name
+	clusterRoles        map[string]dto.ClusterRoleListItemDTO
+	roleBindings        []dto.RoleBindingListItemDTO
+	clusterRoleBindings []dto.ClusterRoleBindingListItemDTO
+}
+
+// rbacBinding is a RoleBinding or ClusterRoleBinding reduced to what evaluation needs.
+type rbacBinding struct {
+	kind      string
+	name      string
+	namespace string
+	roleKind  string
+	roleName  string
+	subjects  []dto.SubjectDTO
+}
+
+// sourcedRule is a rule of a bound role; aggregatedFrom names the ClusterRole it was
+// aggregated from, if any.
+type sourcedRule struct {
+	rule           dto.PolicyRuleDTO
+	aggregatedFrom string
+}
+
+// WhoCan answers which subjects may perform the requested access, with the
+// binding → role → rule path behind each grant. It reads the RBAC snapshots (fetching
+// cold ones); snapshots that cannot be read are reported in Unavailable.
+func (m *manager) WhoCan(ctx context.Context, clusterName string, req WhoCanRequest) (dto.WhoCanDTO, error) {
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return dto.WhoCanDTO{}, err
+	}
+	plane := planeAny.(*clusterPlane)
+
+	s, unavailable := m.rbacSnapshotFor(ctx, plane, []string{req.Namespace})
+	out := evaluateWhoCan(req, s)
+	out.Unavailable = unavailable
+	return out, nil
+}
+
+// ServiceAccountPermissions returns the effective permission matrix of a ServiceAccount.
+// RoleBindings are read for its own namespace and for every namespace whose RoleBindings
+// are already cached; ClusterRoleBindings always apply.
+func (m *manager) ServiceAccountPermissions(ctx context.Context, clusterName, namespace, name string) (dto.ServiceAccountPermissionsDTO, error) {
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return dto.ServiceAccountPermissionsDTO{}, err
+	}
+	plane := planeAny.(*clusterPlane)
+
+	namespaces := []string{namespace}
+	for _, ns := range cachedSnapshotNamespaces(&plane.roleBindingsStore) {
+		if ns != namespace && ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	s, unavailable := m.rbacSnapshotFor(ctx, plane, namespaces)
+	out := serviceAccountPermissionMatrix(namespace, name, s)
+	sort.Strings(namespaces[1:])
+	out.NamespacesChecked = namespaces
+	out.Unavailable = unavailable
+	return out, nil
+}
+
+// rbacSnapshotFor reads the cluster RBAC snapshots plus the Roles and RoleBindings of
+// the given namespaces. The first namespace is fetched when cold; the others are read
+// from cache only. An empty namespace is skipped.
+func (m *manager) rbacSnapshotFor(ctx context.Context, plane *clusterPlane, namespaces []string) (rbacSnapshot, []string) {
+	s := rbacSnapshot{
+		roles:        map[string]map[string]dto.RoleListItemDTO{},
+		clusterRoles: map[string]dto.ClusterRoleListItemDTO{},
+	}
+	var unavailable []string
+	usable := func(kind ResourceKind, items int, err error) bool {
+		if err != nil && items == 0 {
+			unavailable = append(unavailable, string(kind))
+			return false
+		}
+		return true
+	}
+
+	if crs, err := plane.ClusterRolesSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical); usable(ResourceKindClusterRoles, len(crs.Items), err) {
+		for _, cr := range crs.Items {
+			s.clusterRoles[cr.Name] = cr
+		}
+	}
+	if crbs, err := plane.ClusterRoleBindingsSnapshot(ctx, m.scheduler, m.clients, WorkPriorityCritical); usable(ResourceKindClusterRoleBindings, len(crbs.Items), err) {
+		s.clusterRoleBindings = crbs.Items
+	}
+	for i, ns := range namespaces {
+		if ns == "" {
+			continue
+		}
+		var roles RolesSnapshot
+		var bindings RoleBindingsSnapshot
+		if i == 0 {
+			var rolesErr, bindingsErr error
+			roles, rolesErr = plane.RolesSnapshot(ctx, m.scheduler, m.clients, ns, WorkPriorityCritical)
+			if !usable(ResourceKindRoles, len(roles.Items), rolesErr) {
+				roles = RolesSnapshot{}
+			}
+			bindings, bindingsErr = plane.RoleBindingsSnapshot(ctx, m.scheduler, m.clients, ns, WorkPriorityCritical)
+			if !usable(ResourceKindRoleBindings, len(bindings.Items), bindingsErr) {
+				bindings = RoleBindingsSnapshot{}
+			}
+		} else {
+			roles, _ = plane.rolesStore.getCached(ns)
+			bindings, _ = plane.roleBindingsStore.getCached(ns)
+		}
+		byName := make(map[string]dto.RoleListItemDTO, len(roles.Items))
+		for _, r := range roles.Items {
+			byName[r.Name] = r
+		}
+		s.roles[ns] = byName
+		for _, rb := range bindings.Items {
+			if rb.Namespace == "" {
+				rb.Namespace = ns
+			}
+			s.roleBindings = append(s.roleBindings, rb)
+		}
+	}
+	return s, unavailable
+}
+
+func (s rbacSnapshot) bindings() []rbacBinding {
+	out := make([]rbacBinding, 0, len(s.clusterRoleBindings)+len(s.roleBindings))
+	for _, b := range s.clusterRoleBindings {
+		out = append(out, rbacBinding{kind: "ClusterRoleBinding", name: b.Name, roleKind: b.RoleRefKind, roleName: b.RoleRefName, subjects: b.Subjects})
+	}
+	for _, b := range s.roleBindings {
+		out = append(out, rbacBinding{kind: "RoleBinding", name: b.Name, namespace: b.Namespace, roleKind: b.RoleRefKind, roleName: b.RoleRefName, subjects: b.Subjects})
+	}
+	return out
+}
+
+// rulesFor returns the rules of the role a binding refers to. A RoleBinding's Role is
+// looked up in the binding's namespace.
+func (s rbacSnapshot) rulesFor(b rbacBinding) []sourcedRule {
+	switch b.roleKind {
+	case "Role":
+		role, ok := s.roles[b.namespace][b.roleName]
+		if !ok {
+			return nil
+		}
+		return ownRules(role.Rules)
+	case "ClusterRole":
+		return s.clusterRoleRules(b.roleName)
+	}
+	return nil
+}
+
+// clusterRoleRules resolves aggregation: the rules of an aggregated ClusterRole are
+// attributed to the ClusterRoles its selectors match. When no source role is cached,
+// its own (controller-aggregated) rules are used.
+func (s rbacSnapshot) clusterRoleRules(name string) []sourcedRule {
+	role, ok := s.clusterRoles[name]
+	if !ok {
+		return nil
+	}
+	if len(role.AggregationSelectors) == 0 {
+		return ownRules(role.Rules)
+	}
+	var selectors []labels.Selector
+	for _, raw := range role.AggregationSelectors {
+		if sel, err := labels.Parse(raw); err == nil {
+			selectors = append(selectors, sel)
+		}
+	}
+	names := make([]string, 0, len(s.clusterRoles))
+	for n := range s.clusterRoles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var out []sourcedRule
+	for _, n := range names {
+		src := s.clusterRoles[n]
+		if n == name || len(src.Rules) == 0 {
+			continue
+		}
+		for _, sel := range selectors {
+			if sel.Matches(labels.Set(src.Labels)) {
+				for _, r := range src.Rules {
+					out = append(out, sourcedRule{rule: r, aggregatedFrom: n})
+				}
+				break
+			}
+		}
+	}
+	if len(out) == 0 {
+		return ownRules(role.Rules)
+	}
+	return out
+}
+
+func ownRules(rules []dto.PolicyRuleDTO) []sourcedRule {
+	out := make([]sourcedRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, sourcedRule{rule: r})
+	}
+	return out
+}
+
+func grantPath(b rbacBinding, r sourcedRule) dto.RBACGrantPathDTO {
+	return dto.RBACGrantPathDTO{
+		BindingKind:      b.kind,
+		BindingName:      b.name,
+		BindingNamespace: b.namespace,
+		RoleKind:         b.roleKind,
+		RoleName:         b.roleName,
+		AggregatedFrom:   r.aggregatedFrom,
+		Rule:             r.rule,
+	}
+}
+
+// ruleAllows mirrors the API server's RBAC rule matching, including "*" wildcards and
+// "*/<subresource>" resources. A rule restricted to resourceNames only allows requests
+// for one of those names.
+func ruleAllows(rule dto.PolicyRuleDTO, req WhoCanRequest) bool {
+	if !containsOrWildcard(rule.Verbs, req.Verb) || !containsOrWildcard(rule.APIGroups, req.Group) {
+		return false
+	}
+	if len(rule.ResourceNames) > 0 && !containsString(rule.ResourceNames, req.Name) {
+		return false
+	}
+	combined := req.Resource
+	if req.Subresource != "" {
+		combined += "/" + req.Subresource
+	}
+	for _, res := range rule.Resources {
+		if res == "*" || res == combined {
+			return true
+		}
+		if req.Subresource != "" && res == "*/"+req.Subresource {
+			return true
+		}
+	}
+	return false
+}
+
+func containsOrWildcard(items []string, v string) bool {
+	for _, item := range items {
+		if item == "*" || item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(items []string, v string) bool {
+	for _, item := range items {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func evaluateWhoCan(req WhoCanRequest, s rbacSnapshot) dto.WhoCanDTO {
+	out := dto.WhoCanDTO{
+		Verb:         req.Verb,
+		Group:        req.Group,
+		Resource:     req.Resource,
+		Subresource:  req.Subresource,
+		ResourceName: req.Name,
+		Namespace:    req.Namespace,
+		Subjects:     []dto.WhoCanSubjectDTO{},
+	}
+	subjects := map[dto.SubjectDTO]*dto.WhoCanSubjectDTO{}
+	for _, b := range s.bindings() {
+		if b.kind == "RoleBinding" && (req.Namespace == "" || b.namespace != req.Namespace) {
+			continue
+		}
+		for _, r := range s.rulesFor(b) {
+			if !ruleAllows(r.rule, req) {
+				continue
+			}
+			for _, sub := range b.subjects {
+				entry, ok := subjects[sub]
+				if !ok {
+					entry = &dto.WhoCanSubjectDTO{Kind: sub.Kind, Name: sub.Name, Namespace: sub.Namespace}
+					subjects[sub] = entry
+				}
+				entry.Grants = append(entry.Grants, grantPath(b, r))
+			}
+		}
+	}
+	for _, entry := range subjects {
+		out.Subjects = append(out.Subjects, *entry)
+	}
+	sort.Slice(out.Subjects, func(i, j int) bool {
+		a, b := out.Subjects[i], out.Subjects[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return out
+}
+
+// serviceAccountIdentities returns the user and groups a ServiceAccount authenticates as.
+func serviceAccountIdentities(namespace, name string) (user string, groups []string) {
+	return "system:serviceaccount:" + namespace + ":" + name,
+		[]string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"}
+}
+
+func subjectMatchesServiceAccount(sub dto.SubjectDTO, namespace, name, user string, groups []string) bool {
+	switch sub.Kind {
+	case "ServiceAccount":
+		return sub.Name == name && sub.Namespace == namespace
+	case "User":
+		return sub.Name == user
+	case "Group":
+		return containsString(groups, sub.Name)
+	}
+	return false
+}
+
+func serviceAccountPermissionMatrix(namespace, name string, s rbacSnapshot) dto.ServiceAccountPermissionsDTO {
+	user, groups := serviceAccountIdentities(namespace, name)
+	out := dto.ServiceAccountPermissionsDTO{
+		Namespace:  namespace,
+		Name:       name,
+		Identities: append([]string{user}, groups...),
+		Rows:       []dto.PermissionMatrixRowDTO{},
+	}
+
+	rows := map[string]*dto.PermissionMatrixRowDTO{}
+	var order []string
+	row := func(key string, init dto.PermissionMatrixRowDTO) *dto.PermissionMatrixRowDTO {
+		if r, ok := rows[key]; ok {
+			return r
+		}
+		r := init
+		rows[key] = &r
+		order = append(order, key)
+		return &r
+	}
+	addVerbs := func(r *dto.PermissionMatrixRowDTO, verbs []string) {
+		for _, v := range verbs {
+			if !containsString(r.Verbs, v) {
+				r.Verbs = append(r.Verbs, v)
+			}
+		}
+	}
+
+	for _, b := range s.bindings() {
+		matched := false
+		for _, sub := range b.subjects {
+			if subjectMatchesServiceAccount(sub, namespace, name, user, groups) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		scope := "*"
+		if b.kind == "RoleBinding" {
+			scope = b.namespace
+		}
+		for _, r := range s.rulesFor(b) {
+			path := grantPath(b, r)
+			// Non-resource URLs are only honored through ClusterRoleBindings.
+			if scope == "*" {
+				for _, url := range r.rule.NonResourceURLs {
+					mr := row(scope+"|url|"+url, dto.PermissionMatrixRowDTO{Scope: scope, NonResourceURL: url})
+					addVerbs(mr, r.rule.Verbs)
+					mr.Grants = append(mr.Grants, path)
+				}
+			}
+			names := strings.Join(r.rule.ResourceNames, ",")
+			for _, group := range r.rule.APIGroups {
+				for _, res := range r.rule.Resources {
+					key := scope + "|" + group + "|" + res + "|" + names
+					mr := row(key, dto.PermissionMatrixRowDTO{Scope: scope, APIGroup: group, Resource: res, ResourceNames: r.rule.ResourceNames})
+					addVerbs(mr, r.rule.Verbs)
+					mr.Grants = append(mr.Grants, path)
+				}
+			}
+		}
+	}
+
+	for _, key := range order {
+		r := rows[key]
+		sort.Strings(r.Verbs)
+		out.Rows = append(out.Rows, *r)
+	}
+	sort.SliceStable(out.Rows, func(i, j int) bool {
+		a, b := out.Rows[i], out.Rows[j]
+		if a.Scope != b.Scope {
+			return a.Scope == "*" || (b.Scope != "*" && a.Scope < b.Scope)
+		}
+		if a.APIGroup != b.APIGroup {
+			return a.APIGroup < b.APIGroup
+		}
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		return a.NonResourceURL < b.NonResourceURL
+	})
+	return out
+}
@@ -0,0 +1,150 @@
+package dataplane
+
+import (
+	"testing"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func rbacTestSnapshot() rbacSnapshot {
+	return rbacSnapshot{
+		roles: map[string]map[string]dto.RoleListItemDTO{
+			"team-a": {
+				"pod-reader": {Name: "pod-reader", Namespace: "team-a", Rules: []dto.PolicyRuleDTO{
+					{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list"}},
+				}},
+				"one-secret": {Name: "one-secret", Namespace: "team-a", Rules: []dto.PolicyRuleDTO{
+					{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"db"}, Verbs: []string{"get"}},
+				}},
+			},
+		},
+		clusterRoles: map[string]dto.ClusterRoleListItemDTO{
+			"admin": {Name: "admin", AggregationSelectors: []string{"rbac.example.com/aggregate-to-admin=true"}},
+			"admin-pods": {Name: "admin-pods", Labels: map[string]string{"rbac.example.com/aggregate-to-admin": "true"}, Rules: []dto.PolicyRuleDTO{
+				{APIGroups: []string{"", "apps"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			}},
+			"metrics": {Name: "metrics", Rules: []dto.PolicyRuleDTO{
+				{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}},
+			}},
+			"node-reader": {Name: "node-reader", Rules: []dto.PolicyRuleDTO{
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "list", "watch"}},
+			}},
+		},
+		roleBindings: []dto.RoleBindingListItemDTO{
+			{Name: "readers", Namespace: "team-a", RoleRefKind: "Role", RoleRefName: "pod-reader", Subjects: []dto.SubjectDTO{
+				{Kind: "ServiceAccount", Name: "deployer", Namespace: "team-a"},
+				{Kind: "User", Name: "alice"},
+			}},
+			{Name: "db-secret", Namespace: "team-a", RoleRefKind: "Role", RoleRefName: "one-secret", Subjects: []dto.SubjectDTO{
+				{Kind: "Group", Name: "system:serviceaccounts:team-a"},
+			}},
+			{Name: "admins", Namespace: "team-a", RoleRefKind: "ClusterRole", RoleRefName: "admin", Subjects: []dto.SubjectDTO{
+				{Kind: "Group", Name: "team-a-admins"},
+			}},
+			{Name: "elsewhere", Namespace: "team-b", RoleRefKind: "ClusterRole", RoleRefName: "admin", Subjects: []dto.SubjectDTO{
+				{Kind: "User", Name: "bob"},
+			}},
+		},
+		clusterRoleBindings: []dto.ClusterRoleBindingListItemDTO{
+			{Name: "nodes", RoleRefKind: "ClusterRole", RoleRefName: "node-reader", Subjects: []dto.SubjectDTO{
+				{Kind: "Group", Name: "system:authenticated"},
+			}},
+			{Name: "scrape", RoleRefKind: "ClusterRole", RoleRefName: "metrics", Subjects: []dto.SubjectDTO{
+				{Kind: "ServiceAccount", Name: "deployer", Namespace: "team-a"},
+			}},
+		},
+	}
+}
+
+func whoCanNames(res dto.WhoCanDTO) []string {
+	out := make([]string, 0, len(res.Subjects))
+	for _, s := range res.Subjects {
+		out = append(out, s.Kind+":"+s.Name)
+	}
+	return out
+}
+
+func TestEvaluateWhoCan(t *testing.T) {
+	s := rbacTestSnapshot()
+
+	res := evaluateWhoCan(WhoCanRequest{Verb: "get", Resource: "pods", Subresource: "log", Namespace: "team-a"}, s)
+	got := whoCanNames(res)
+	want := []string{"Group:team-a-admins", "ServiceAccount:deployer", "User:alice"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+	admins := res.Subjects[0].Grants[0]
+	if admins.RoleName != "admin" || admins.AggregatedFrom != "admin-pods" || admins.BindingNamespace != "team-a" {
+		t.Fatalf("unexpected aggregated grant path: %+v", admins)
+	}
+
+	res = evaluateWhoCan(WhoCanRequest{Verb: "get", Resource: "secrets", Namespace: "team-a"}, s)
+	if got := whoCanNames(res); len(got) != 1 || got[0] != "Group:team-a-admins" {
+		t.Fatalf("resourceNames rule must not grant unnamed access, got %v", got)
+	}
+	res = evaluateWhoCan(WhoCanRequest{Verb: "get", Resource: "secrets", Name: "db", Namespace: "team-a"}, s)
+	if len(res.Subjects) != 2 {
+		t.Fatalf("expected the named secret grant too, got %v", whoCanNames(res))
+	}
+
+	res = evaluateWhoCan(WhoCanRequest{Verb: "list", Resource: "nodes"}, s)
+	if got := whoCanNames(res); len(got) != 1 || got[0] != "Group:system:authenticated" {
+		t.Fatalf("cluster-wide lookup should only use ClusterRoleBindings, got %v", got)
+	}
+}
+
+func TestRuleAllows(t *testing.T) {
+	cases := []struct {
+		name string
+		rule dto.PolicyRuleDTO
+		req  WhoCanRequest
+		want bool
+	}{
+		{"exact", dto.PolicyRuleDTO{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get"}}, WhoCanRequest{Verb: "get", Group: "apps", Resource: "deployments"}, true},
+		{"wrong group", dto.PolicyRuleDTO{APIGroups: []string{""}, Resources: []string{"deployments"}, Verbs: []string{"get"}}, WhoCanRequest{Verb: "get", Group: "apps", Resource: "deployments"}, false},
+		{"subresource not implied", dto.PolicyRuleDTO{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"create"}}, WhoCanRequest{Verb: "create", Resource: "pods", Subresource: "exec"}, false},
+		{"wildcard subresource", dto.PolicyRuleDTO{APIGroups: []string{""}, Resources: []string{"*/scale"}, Verbs: []string{"update"}}, WhoCanRequest{Verb: "update", Resource: "deployments", Subresource: "scale"}, true},
+		{"wildcard verb", dto.PolicyRuleDTO{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}, WhoCanRequest{Verb: "delete", Group: "batch", Resource: "jobs"}, true},
+	}
+	for _, tc := range cases {
+		if got := ruleAllows(tc.rule, tc.req); got != tc.want {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestServiceAccountPermissionMatrix(t *testing.T) {
+	res := serviceAccountPermissionMatrix("team-a", "deployer", rbacTestSnapshot())
+
+	if res.Identities[0] != "system:serviceaccount:team-a:deployer" {
+		t.Fatalf("unexpected identities: %v", res.Identities)
+	}
+	type key struct{ scope, resource, url string }
+	rows := map[key]dto.PermissionMatrixRowDTO{}
+	for _, r := range res.Rows {
+		rows[key{r.Scope, r.Resource, r.NonResourceURL}] = r
+	}
+	if len(rows) != 5 {
+		t.Fatalf("expected 5 rows, got %+v", res.Rows)
+	}
+	if res.Rows[0].Scope != "*" {
+		t.Fatalf("cluster-wide rows should sort first, got %+v", res.Rows[0])
+	}
+	if r := rows[key{"*", "nodes", ""}]; len(r.Verbs) != 3 || r.Grants[0].BindingName != "nodes" {
+		t.Fatalf("expected node access through system:authenticated, got %+v", r)
+	}
+	if _, ok := rows[key{"*", "", "/metrics"}]; !ok {
+		t.Fatalf("expected a /metrics row")
+	}
+	if r := rows[key{"team-a", "secrets", ""}]; len(r.ResourceNames) != 1 || r.Grants[0].BindingName != "db-secret" {
+		t.Fatalf("expected the namespace group grant, got %+v", r)
+	}
+	if r := rows[key{"team-a", "pods", ""}]; r.Verbs[0] != "get" || r.Verbs[1] != "list" {
+		t.Fatalf("unexpected pod verbs: %+v", r)
+	}
+}
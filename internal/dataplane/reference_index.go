@@ -0,0 +1,149 @@
+package dataplane
+
+import (
+	"context"
+	"sort"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
+)
+
+// referenceKindsByRoute maps the route segment of a referenced kind to its Kind.
+var referenceKindsByRoute = map[string]string{
+	"configmaps":             kubepods.RefKindConfigMap,
+	"secrets":                kubepods.RefKindSecret,
+	"persistentvolumeclaims": kubepods.RefKindPVC,
+	"serviceaccounts":        kubepods.RefKindServiceAccount,
+}
+
+// ReferenceKindFromRoute resolves a route segment such as "secrets" to the kind the
+// reverse-reference index answers for.
+func ReferenceKindFromRoute(route string) (string, bool) {
+	kind, ok := referenceKindsByRoute[route]
+	return kind, ok
+}
+
+// referrerRow is one snapshot row with its outgoing references.
+type referrerRow struct {
+	kind string
+	name string
+	refs []dto.ResourceReferenceDTO
+}
+
+type referenceKey struct {
+	kind string
+	name string
+}
+
+// referenceIndex maps a referenced object to the rows that reference it.
+type referenceIndex map[referenceKey][]dto.ResourceReferrerDTO
+
+func buildReferenceIndex(rows []referrerRow) referenceIndex {
+	idx := referenceIndex{}
+	for _, row := range rows {
+		uses := map[referenceKey][]dto.ResourceReferenceUseDTO{}
+		var order []referenceKey
+		for _, ref := range row.refs {
+			key := referenceKey{kind: ref.Kind, name: ref.Name}
+			if _, ok := uses[key]; !ok {
+				order = append(order, key)
+			}
+			uses[key] = append(uses[key], dto.ResourceReferenceUseDTO{Via: ref.Via, Source: ref.Source})
+		}
+		for _, key := range order {
+			idx[key] = append(idx[key], dto.ResourceReferrerDTO{Kind: row.kind, Name: row.name, Uses: uses[key]})
+		}
+	}
+	for key := range idx {
+		referrers := idx[key]
+		sort.SliceStable(referrers, func(i, j int) bool {
+			if referrers[i].Kind != referrers[j].Kind {
+				return referrers[i].Kind < referrers[j].Kind
+			}
+			return referrers[i].Name < referrers[j].Name
+		})
+	}
+	return idx
+}
+
+func (idx referenceIndex) referrers(kind, name string) []dto.ResourceReferrerDTO {
+	out := idx[referenceKey{kind: kind, name: name}]
+	if out == nil {
+		return []dto.ResourceReferrerDTO{}
+	}
+	return out
+}
+
+// ResourceReferrers answers which pods, workloads, Ingresses and ServiceAccounts in the
+// namespace reference the named ConfigMap, Secret, PVC or ServiceAccount, and how. It
+// reads the namespace snapshots (fetching cold ones); snapshots that cannot be read are
+// reported in Unavailable instead of failing the answer.
+func (m *manager) ResourceReferrers(ctx context.Context, clusterName, namespace, kind, name string) (dto.ResourceReferrersDTO, error) {
+	planeAny, err := m.PlaneForCluster(ctx, clusterName)
+	if err != nil {
+		return dto.ResourceReferrersDTO{}, err
+	}
+	plane := planeAny.(*clusterPlane)
+
+	out := dto.ResourceReferrersDTO{Kind: kind, Namespace: namespace, Name: name}
+	var rows []referrerRow
+	collect := func(snapKind ResourceKind, items int, err error, add func()) {
+		if err != nil && items == 0 {
+			out.Unavailable = append(out.Unavailable, string(snapKind))
+			return
+		}
+		add()
+	}
+
+	pods, err := plane.PodsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindPods, len(pods.Items), err, func() {
+		for _, it := range pods.Items {
+			rows = append(rows, referrerRow{kind: "Pod", name: it.Name, refs: it.References})
+		}
+	})
+	deps, err := plane.DeploymentsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindDeployments, len(deps.Items), err, func() {
+		for _, it := range deps.Items {
+			rows = append(rows, referrerRow{kind: "Deployment", name: it.Name, refs: it.References})
+		}
+	})
+	dss, err := plane.DaemonSetsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindDaemonSets, len(dss.Items), err, func() {
+		for _, it := range dss.Items {
+			rows = append(rows, referrerRow{kind: "DaemonSet", name: it.Name, refs: it.References})
+		}
+	})
+	sts, err := plane.StatefulSetsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindStatefulSets, len(sts.Items), err, func() {
+		for _, it := range sts.Items {
+			rows = append(rows, referrerRow{kind: "StatefulSet", name: it.Name, refs: it.References})
+		}
+	})
+	jobs, err := plane.JobsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindJobs, len(jobs.Items), err, func() {
+		for _, it := range jobs.Items {
+			rows = append(rows, referrerRow{kind: "Job", name: it.Name, refs: it.References})
+		}
+	})
+	cjs, err := plane.CronJobsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindCronJobs, len(cjs.Items), err, func() {
+		for _, it := range cjs.Items {
+			rows = append(rows, referrerRow{kind: "CronJob", name: it.Name, refs: it.References})
+		}
+	})
+	ings, err := plane.IngressesSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindIngresses, len(ings.Items), err, func() {
+		for _, it := range ings.Items {
+			rows = append(rows, referrerRow{kind: "Ingress", name: it.Name, refs: it.References})
+		}
+	})
+	sas, err := plane.ServiceAccountsSnapshot(ctx, m.scheduler, m.clients, namespace, WorkPriorityCritical)
+	collect(ResourceKindServiceAccounts, len(sas.Items), err, func() {
+		for _, it := range sas.Items {
+			rows = append(rows, referrerRow{kind: "ServiceAccount", name: it.Name, refs: it.References})
+		}
+	})
+
+	out.Referrers = buildReferenceIndex(rows).referrers(kind, name)
+	return out, nil
+}
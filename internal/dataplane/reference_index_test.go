@@ -0,0 +1,51 @@
+package dataplane
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func TestBuildReferenceIndex(t *testing.T) {
+	rows := []referrerRow{
+		{kind: "Pod", name: "web-1", refs: []dto.ResourceReferenceDTO{
+			{Kind: "Secret", Name: "db", Via: "env", Source: "container/app"},
+			{Kind: "Secret", Name: "db", Via: "volume", Source: "volume/creds"},
+			{Kind: "ConfigMap", Name: "settings", Via: "envFrom", Source: "container/app"},
+		}},
+		{kind: "Deployment", name: "web", refs: []dto.ResourceReferenceDTO{
+			{Kind: "Secret", Name: "db", Via: "env", Source: "container/app"},
+		}},
+		{kind: "Ingress", name: "web", refs: []dto.ResourceReferenceDTO{
+			{Kind: "Secret", Name: "web-tls", Via: "tls"},
+		}},
+	}
+	idx := buildReferenceIndex(rows)
+
+	want := []dto.ResourceReferrerDTO{
+		{Kind: "Deployment", Name: "web", Uses: []dto.ResourceReferenceUseDTO{{Via: "env", Source: "container/app"}}},
+		{Kind: "Pod", Name: "web-1", Uses: []dto.ResourceReferenceUseDTO{
+			{Via: "env", Source: "container/app"},
+			{Via: "volume", Source: "volume/creds"},
+		}},
+	}
+	if got := idx.referrers("Secret", "db"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("referrers(Secret/db) = %#v, want %#v", got, want)
+	}
+	if got := idx.referrers("Secret", "web-tls"); len(got) != 1 || got[0].Kind != "Ingress" || got[0].Uses[0].Via != "tls" {
+		t.Fatalf("referrers(Secret/web-tls) = %#v", got)
+	}
+	if got := idx.referrers("ConfigMap", "db"); got == nil || len(got) != 0 {
+		t.Fatalf("kind must be part of the key, got %#v", got)
+	}
+}
+
+func TestReferenceKindFromRoute(t *testing.T) {
+	if kind, ok := ReferenceKindFromRoute("persistentvolumeclaims"); !ok || kind != "PersistentVolumeClaim" {
+		t.Fatalf("persistentvolumeclaims = %q, %v", kind, ok)
+	}
+	if _, ok := ReferenceKindFromRoute("pods"); ok {
+		t.Fatal("pods is not a referenced kind")
+	}
+}
@@ -41,6 +41,7 @@ var resourceSignalsNamespaceRouteToKind = map[string]string{
 	"jobs":                     "Job",
 	"cronjobs":                 "CronJob",
 	"horizontalpodautoscalers": "HorizontalPodAutoscaler",
+	"poddisruptionbudgets":     "PodDisruptionBudget",
 	"services":                 "Service",
 	"ingresses":                "Ingress",
 	"persistentvolumeclaims":   "PersistentVolumeClaim",
@@ -64,6 +65,9 @@ var resourceSignalsClusterRouteToKind = map[string]string{
 	"clusterroles":              "ClusterRole",
 	"clusterrolebindings":       "ClusterRoleBinding",
 	"customresourcedefinitions": "CustomResourceDefinition",
+	"storageclasses":            "StorageClass",
+	"csidrivers":                "CSIDriver",
+	"volumeattachments":         "VolumeAttachment",
 }
 
 // ResourceSignalKindFromRoute resolves a URL plural segment to the canonical
@@ -123,7 +127,7 @@ func (m *manager) ResourceSignals(ctx context.Context, clusterName, scope, names
 		meta = mergeSnapshotMetaForResourceSignals(s)
 	case ResourceSignalsScopeCluster:
 		nodesSnap, _ := peekClusterSnapshot(&plane.nodesStore)
-		store.Add(m.attachSignalHistory(clusterName, now, applySignalPolicy(detectNodeResourcePressureSignals(now, plane, nodesSnap, thresholds.NodeResourcePressurePct), policy, clusterName)...)...)
+		store.Add(m.attachSignalHistory(clusterName, now, applySignalPolicy(detectClusterScopedSignals(now, plane, nodesSnap, thresholds), policy, clusterName)...)...)
 		meta = nodesSnap.Meta
 	}
 
@@ -250,6 +254,13 @@ func fallbackNamespaceSignals(_ time.Time, namespace, kind, name string, plane *
 				return []dto.NamespaceInsightSignalDTO{fallbackSignal(kind, namespace, name, severity, score, reason)}
 			}
 		}
+	case "PodDisruptionBudget":
+		snap, _ := peekNamespacedSnapshot(&plane.pdbStore, namespace)
+		for _, item := range EnrichPodDisruptionBudgetListItemsForAPI(snap.Items) {
+			if item.Name == name && item.NeedsAttention {
+				return []dto.NamespaceInsightSignalDTO{fallbackSignal(kind, namespace, name, item.ListSignalSeverity, listSeverityScore(item.ListSignalSeverity), "PodDisruptionBudget needs attention.")}
+			}
+		}
 	case "Service":
 		snap, _ := peekNamespacedSnapshot(&plane.svcsStore, namespace)
 		for _, item := range EnrichServiceListItemsForAPI(snap.Items) {
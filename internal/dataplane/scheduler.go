@@ -26,6 +26,9 @@ const (
 	ResourceKindClusterRoles        ResourceKind = "clusterroles"
 	ResourceKindClusterRoleBindings ResourceKind = "clusterrolebindings"
 	ResourceKindCRDs                ResourceKind = "customresourcedefinitions"
+	ResourceKindStorageClasses      ResourceKind = "storageclasses"
+	ResourceKindCSIDrivers          ResourceKind = "csidrivers"
+	ResourceKindVolumeAttachments   ResourceKind = "volumeattachments"
 	ResourceKindServices            ResourceKind = "services"
 	ResourceKindIngresses           ResourceKind = "ingresses"
 	ResourceKindPVCs                ResourceKind = "persistentvolumeclaims"
@@ -43,6 +46,12 @@ const (
 	ResourceKindHPAs                ResourceKind = "horizontalpodautoscalers"
 	ResourceKindResourceQuotas      ResourceKind = "resourcequotas"
 	ResourceKindLimitRanges         ResourceKind = "limitranges"
+	ResourceKindNetworkPolicies     ResourceKind = "networkpolicies"
+	ResourceKindPDBs                ResourceKind = "poddisruptionbudgets"
+	// ResourceKindGenericResources is the TTL policy key for generic API resource lists.
+	// Each listed resource runs under its own kind from genericResourceKind so scheduler
+	// work, revisions, and stats stay separate per resource.
+	ResourceKindGenericResources ResourceKind = "genericresources"
 	// ResourceKindPodMetrics and ResourceKindNodeMetrics hold point-in-time
 	// usage samples from metrics.k8s.io. They are intentionally not in
 	// dataplaneNamespacedListResourceKinds — metrics are not a namespace list
@@ -73,6 +82,8 @@ func dataplaneNamespacedListResourceKinds() []ResourceKind {
 		ResourceKindHelmReleases,
 		ResourceKindResourceQuotas,
 		ResourceKindLimitRanges,
+		ResourceKindNetworkPolicies,
+		ResourceKindPDBs,
 	}
 }
 
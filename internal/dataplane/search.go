@@ -95,6 +95,8 @@ func (p *clusterPlane) inMemorySearchRows() []dataplaneSearchRow {
 	appendNamespacedSnapshotSearchRows(&rows, p.name, ResourceKindHPAs, &p.hpaStore)
 	appendNamespacedSnapshotSearchRows(&rows, p.name, ResourceKindResourceQuotas, &p.rqStore)
 	appendNamespacedSnapshotSearchRows(&rows, p.name, ResourceKindLimitRanges, &p.lrStore)
+	appendNamespacedSnapshotSearchRows(&rows, p.name, ResourceKindNetworkPolicies, &p.netpolStore)
+	appendNamespacedSnapshotSearchRows(&rows, p.name, ResourceKindPDBs, &p.pdbStore)
 	return rows
 }
 
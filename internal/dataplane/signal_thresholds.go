@@ -37,6 +37,11 @@ const (
 	// client-side in the deployment drawer.
 	signalDeploymentUnavailableDuration = 10 * time.Minute
 
+	// signalVolumeDetachStuckDuration is how long a VolumeAttachment may stay
+	// attached after being marked for deletion before it is reported as stuck
+	// detaching (fixed presentation threshold).
+	signalVolumeDetachStuckDuration = 10 * time.Minute
+
 	// quotaWarnRatio / quotaCritRatio are the quota utilisation thresholds
 	// for warning and critical severity, shared by signal detectors and list rows.
 	quotaWarnRatio = 0.8
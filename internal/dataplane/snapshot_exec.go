@@ -18,6 +18,10 @@ type clusterSnapshotDescriptor[I any] struct {
 	// are high-churn and short-TTL (e.g. metrics.k8s.io). Leaving this false
 	// preserves the default persistence path for every existing kind.
 	skipPersistence bool
+	// watch lets the kind be kept current from watch events when it is listed in
+	// the watch policy; nil keeps plain TTL list polling. Its list replaces fetch so
+	// the list resourceVersion is kept for the watcher.
+	watch *snapshotWatchSource[I]
 }
 
 type namespacedSnapshotDescriptor[I any] struct {
@@ -30,6 +34,8 @@ type namespacedSnapshotDescriptor[I any] struct {
 	// skipPersistence opts out of bbolt save/hydrate; see the cluster
 	// descriptor for rationale.
 	skipPersistence bool
+	// watch opts the kind into watch-backed freshness; see the cluster descriptor.
+	watch *snapshotWatchSource[I]
 }
 
 func (p *clusterPlane) snapshotMetaUnknown(now time.Time) SnapshotMetadata {
@@ -76,12 +82,35 @@ func executeClusterSnapshot[I any](
 		if p.stats != nil {
 			p.stats.recordRequest(source, desc.kind, true)
 		}
+		if desc.watch != nil {
+			ensureClusterSnapshotWatch(p, sched, clients, store, desc)
+		}
 		return cached, nil
 	}
 	if p.stats != nil {
 		p.stats.recordRequest(source, desc.kind, false)
 	}
+	out, err := refreshClusterSnapshot(p, ctx, sched, prio, clients, store, desc)
+	// Watchers start only after a successful list and resume from its
+	// resourceVersion, so they never list again on start.
+	if err == nil && desc.watch != nil {
+		ensureClusterSnapshotWatch(p, sched, clients, store, desc)
+	}
+	return out, err
+}
 
+// refreshClusterSnapshot lists the kind through the scheduler regardless of TTL,
+// stores the result (or the persisted fallback) and persists successful lists.
+func refreshClusterSnapshot[I any](
+	p *clusterPlane,
+	ctx context.Context,
+	sched *workScheduler,
+	prio WorkPriority,
+	clients ClientsProvider,
+	store *snapshotStore[Snapshot[I]],
+	desc clusterSnapshotDescriptor[I],
+) (Snapshot[I], error) {
+	source := workSourceOrAPI(ctx)
 	var persisted Snapshot[I]
 	var havePersisted bool
 	if sp := p.currentPersistence(); sp != nil && !desc.skipPersistence {
@@ -100,6 +129,7 @@ func executeClusterSnapshot[I any](
 	}
 
 	var out Snapshot[I]
+	var listRV string
 	runErr := sched.Run(ctx, prio, key, func(runCtx context.Context) error {
 		if p.stats != nil {
 			p.stats.recordFetchAttempt(source, desc.kind)
@@ -125,7 +155,12 @@ func executeClusterSnapshot[I any](
 			return err
 		}
 
-		items, err := desc.fetch(runCtx, c)
+		var items []I
+		if desc.watch != nil {
+			items, listRV, err = desc.watch.list(runCtx, c, "")
+		} else {
+			items, err = desc.fetch(runCtx, c)
+		}
 		if err != nil {
 			n := NormalizeError(err)
 			out.Err = &n
@@ -154,6 +189,9 @@ func executeClusterSnapshot[I any](
 		return fallback, runErr
 	}
 	setClusterSnapshot(store, out)
+	if runErr == nil && out.Err == nil && listRV != "" {
+		p.watches.recordList(snapshotWatchKey{kind: desc.kind}, listRV)
+	}
 	if runErr == nil && out.Err == nil && !desc.skipPersistence {
 		if sp := p.currentPersistence(); sp != nil {
 			_ = sp.Save(p.name, desc.kind, "", out)
@@ -177,12 +215,33 @@ func executeNamespacedSnapshot[I any](
 		if p.stats != nil {
 			p.stats.recordRequest(source, desc.kind, true)
 		}
+		if desc.watch != nil {
+			ensureNamespacedSnapshotWatch(p, sched, clients, namespace, store, desc)
+		}
 		return cached, nil
 	}
 	if p.stats != nil {
 		p.stats.recordRequest(source, desc.kind, false)
 	}
+	out, err := refreshNamespacedSnapshot(p, ctx, sched, prio, clients, namespace, store, desc)
+	if err == nil && desc.watch != nil {
+		ensureNamespacedSnapshotWatch(p, sched, clients, namespace, store, desc)
+	}
+	return out, err
+}
 
+// refreshNamespacedSnapshot is the per-namespace variant of refreshClusterSnapshot.
+func refreshNamespacedSnapshot[I any](
+	p *clusterPlane,
+	ctx context.Context,
+	sched *workScheduler,
+	prio WorkPriority,
+	clients ClientsProvider,
+	namespace string,
+	store *namespacedSnapshotStore[Snapshot[I]],
+	desc namespacedSnapshotDescriptor[I],
+) (Snapshot[I], error) {
+	source := workSourceOrAPI(ctx)
 	var persisted Snapshot[I]
 	var havePersisted bool
 	if sp := p.currentPersistence(); sp != nil && !desc.skipPersistence {
@@ -201,6 +260,7 @@ func executeNamespacedSnapshot[I any](
 	}
 
 	var out Snapshot[I]
+	var listRV string
 	runErr := sched.Run(ctx, prio, key, func(runCtx context.Context) error {
 		if p.stats != nil {
 			p.stats.recordFetchAttempt(source, desc.kind)
@@ -226,7 +286,12 @@ func executeNamespacedSnapshot[I any](
 			return err
 		}
 
-		items, err := desc.fetch(runCtx, c, namespace)
+		var items []I
+		if desc.watch != nil {
+			items, listRV, err = desc.watch.list(runCtx, c, namespace)
+		} else {
+			items, err = desc.fetch(runCtx, c, namespace)
+		}
 		if err != nil {
 			n := NormalizeError(err)
 			out.Err = &n
@@ -255,6 +320,9 @@ func executeNamespacedSnapshot[I any](
 		return fallback, runErr
 	}
 	setNamespacedSnapshot(store, namespace, out)
+	if runErr == nil && out.Err == nil && listRV != "" {
+		p.watches.recordList(snapshotWatchKey{kind: desc.kind, namespace: namespace}, listRV)
+	}
 	if runErr == nil && out.Err == nil && !desc.skipPersistence {
 		if sp := p.currentPersistence(); sp != nil {
 			_ = sp.Save(p.name, desc.kind, namespace, out)
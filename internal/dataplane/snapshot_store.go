@@ -18,6 +18,7 @@ type snapshotStore[T observedAtGetter] struct {
 
 type snapshotStoreTelemetry struct {
 	stats   *dataplaneSessionStats
+	events  *planeEventSink
 	cluster string
 	kind    ResourceKind
 }
@@ -45,6 +46,7 @@ func setClusterSnapshot[I any](s *snapshotStore[Snapshot[I]], snap Snapshot[I])
 	snap.Meta.Revision = s.rev
 	s.snap = snap
 	s.telemetry.recordCacheWrite("", snap)
+	s.telemetry.recordRevision("", s.rev)
 }
 
 func peekClusterSnapshot[I any](s *snapshotStore[Snapshot[I]]) (snap Snapshot[I], ok bool) {
@@ -67,12 +69,12 @@ func newNamespacedSnapshotStore[T observedAtGetter]() namespacedSnapshotStore[T]
 	return namespacedSnapshotStore[T]{snaps: make(map[string]T)}
 }
 
-func (s *snapshotStore[T]) configureTelemetry(stats *dataplaneSessionStats, cluster string, kind ResourceKind) {
-	s.telemetry = snapshotStoreTelemetry{stats: stats, cluster: cluster, kind: kind}
+func (s *snapshotStore[T]) configureTelemetry(stats *dataplaneSessionStats, events *planeEventSink, cluster string, kind ResourceKind) {
+	s.telemetry = snapshotStoreTelemetry{stats: stats, events: events, cluster: cluster, kind: kind}
 }
 
-func (s *namespacedSnapshotStore[T]) configureTelemetry(stats *dataplaneSessionStats, cluster string, kind ResourceKind) {
-	s.telemetry = snapshotStoreTelemetry{stats: stats, cluster: cluster, kind: kind}
+func (s *namespacedSnapshotStore[T]) configureTelemetry(stats *dataplaneSessionStats, events *planeEventSink, cluster string, kind ResourceKind) {
+	s.telemetry = snapshotStoreTelemetry{stats: stats, events: events, cluster: cluster, kind: kind}
 }
 
 func (s *namespacedSnapshotStore[T]) getFresh(namespace string, ttl time.Duration) (T, bool) {
@@ -119,6 +121,7 @@ func setNamespacedSnapshot[I any](s *namespacedSnapshotStore[Snapshot[I]], names
 	snap.Meta.Revision = s.nsRev[namespace]
 	s.snaps[namespace] = snap
 	s.telemetry.recordCacheWrite(namespace, snap)
+	s.telemetry.recordRevision(namespace, s.nsRev[namespace])
 }
 
 func clearNamespacedSnapshot[I any](s *namespacedSnapshotStore[Snapshot[I]], namespace string) {
@@ -133,6 +136,7 @@ func clearNamespacedSnapshot[I any](s *namespacedSnapshotStore[Snapshot[I]], nam
 	s.nsRev[namespace]++
 	delete(s.snaps, namespace)
 	s.telemetry.recordCacheDelete(namespace)
+	s.telemetry.recordRevision(namespace, s.nsRev[namespace])
 }
 
 func peekNamespacedSnapshot[I any](s *namespacedSnapshotStore[Snapshot[I]], namespace string) (snap Snapshot[I], ok bool) {
@@ -165,9 +169,81 @@ func (t snapshotStoreTelemetry) recordCacheWrite(namespace string, snap any) {
 	t.stats.recordCacheWrite(t.cluster, t.kind, namespace, estimateSnapshotPayloadBytes(snap))
 }
 
+// recordRevision notifies event subscribers of a revision bump. It runs under the store
+// lock so events for one list cell are published in revision order.
+func (t snapshotStoreTelemetry) recordRevision(namespace string, revision uint64) {
+	if t.kind == "" {
+		return
+	}
+	t.events.revision(t.cluster, t.kind, namespace, revision)
+}
+
 func (t snapshotStoreTelemetry) recordCacheDelete(namespace string) {
 	if t.stats == nil || t.kind == "" {
 		return
 	}
 	t.stats.recordCacheDelete(t.cluster, t.kind, namespace)
 }
+
+// updateClusterSnapshot applies fn to the stored cluster snapshot under the store lock.
+// It is a no-op when nothing is stored yet or the stored snapshot carries an error,
+// so watch deltas never paper over a failed or persisted fallback list.
+func updateClusterSnapshot[I any](s *snapshotStore[Snapshot[I]], fn func(Snapshot[I]) Snapshot[I]) (Snapshot[I], bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snap.Meta.ObservedAt.IsZero() || s.snap.Err != nil {
+		return Snapshot[I]{}, false
+	}
+	next := fn(s.snap)
+	s.rev++
+	next.Meta.Revision = s.rev
+	s.snap = next
+	s.telemetry.recordCacheWrite("", next)
+	s.telemetry.recordRevision("", s.rev)
+	return next, true
+}
+
+// touchClusterSnapshot refreshes snapshot metadata without bumping the revision; the
+// items are unchanged, only the time they were last confirmed current moves.
+func touchClusterSnapshot[I any](s *snapshotStore[Snapshot[I]], meta SnapshotMetadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snap.Meta.ObservedAt.IsZero() || s.snap.Err != nil {
+		return
+	}
+	meta.Revision = s.snap.Meta.Revision
+	s.snap.Meta = meta
+}
+
+// updateNamespacedSnapshot is the per-namespace variant of updateClusterSnapshot.
+func updateNamespacedSnapshot[I any](s *namespacedSnapshotStore[Snapshot[I]], namespace string, fn func(Snapshot[I]) Snapshot[I]) (Snapshot[I], bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.snaps[namespace]
+	if !ok || cur.Meta.ObservedAt.IsZero() || cur.Err != nil {
+		return Snapshot[I]{}, false
+	}
+	if s.nsRev == nil {
+		s.nsRev = make(map[string]uint64)
+	}
+	next := fn(cur)
+	s.nsRev[namespace]++
+	next.Meta.Revision = s.nsRev[namespace]
+	s.snaps[namespace] = next
+	s.telemetry.recordCacheWrite(namespace, next)
+	s.telemetry.recordRevision(namespace, s.nsRev[namespace])
+	return next, true
+}
+
+// touchNamespacedSnapshot is the per-namespace variant of touchClusterSnapshot.
+func touchNamespacedSnapshot[I any](s *namespacedSnapshotStore[Snapshot[I]], namespace string, meta SnapshotMetadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.snaps[namespace]
+	if !ok || cur.Meta.ObservedAt.IsZero() || cur.Err != nil {
+		return
+	}
+	meta.Revision = cur.Meta.Revision
+	cur.Meta = meta
+	s.snaps[namespace] = cur
+}
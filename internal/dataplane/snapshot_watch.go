@@ -0,0 +1,503 @@
+package dataplane
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+)
+
+// Watch-backed snapshots.
+//
+// Kinds listed in DataplanePolicy.Watch.Kinds keep their list snapshot current
+// from watch events after the first successful list instead of relisting every
+// TTL. A watcher is started lazily per (kind, namespace) by the snapshot read
+// path, resumes from the resourceVersion of the list just stored, keeps
+// ObservedAt inside the TTL while the stream is healthy, and simply
+// stops touching the snapshot when it is not — the normal TTL relist then takes
+// over, so freshness metadata never claims more than was actually observed.
+//
+// 410 Gone (expired resourceVersion) triggers a relist through the scheduler and
+// a new watch. Access denial on list or watch stops the watcher and parks the key
+// for snapshotWatchBlockedRetry; the kind keeps TTL polling in the meantime.
+
+const (
+	snapshotWatchFlushInterval = 250 * time.Millisecond
+	snapshotWatchBackoffMin    = 2 * time.Second
+	snapshotWatchBackoffMax    = 2 * time.Minute
+	snapshotWatchBlockedRetry  = 10 * time.Minute
+	snapshotWatchRelistTimeout = 60 * time.Second
+)
+
+// snapshotWatchResourceKinds lists kinds whose list rows can be rebuilt from a
+// single watched object. Kinds whose rows join other resources (nodes count pods,
+// for example) are intentionally absent.
+func snapshotWatchResourceKinds() []ResourceKind {
+	return []ResourceKind{
+		ResourceKindNamespaces,
+		ResourceKindPods,
+		ResourceKindDeployments,
+	}
+}
+
+// snapshotWatchSource describes how to keep a list snapshot current from watch events.
+type snapshotWatchSource[I any] struct {
+	// list lists the kind and returns the list resourceVersion to watch from.
+	list  func(context.Context, *cluster.Clients, string) ([]I, string, error)
+	watch func(context.Context, *cluster.Clients, string, string) (watch.Interface, error)
+	item  func(runtime.Object, time.Time) (I, bool)
+	key   func(I) string
+	// merge optionally carries list-only enrichment (for example pod last events)
+	// from the previous row into the row rebuilt from a watch event.
+	merge func(prev, next I) I
+}
+
+type snapshotWatchKey struct {
+	kind      ResourceKind
+	namespace string
+}
+
+type snapshotWatch struct {
+	cancel   context.CancelFunc
+	lastUsed time.Time
+}
+
+// snapshotWatchRegistry tracks running watchers for one cluster plane.
+type snapshotWatchRegistry struct {
+	mu      sync.Mutex
+	active  map[snapshotWatchKey]*snapshotWatch
+	blocked map[snapshotWatchKey]time.Time
+	// listed holds the resourceVersion of the last stored list per key until a
+	// watcher takes it.
+	listed map[snapshotWatchKey]string
+	closed bool
+}
+
+// start marks key as used and returns a context for a new watcher when none is
+// running, the key is not parked after an access denial, and the cap allows it.
+func (r *snapshotWatchRegistry) start(key snapshotWatchKey, now time.Time, maxWatches int) (context.Context, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, false
+	}
+	if w, ok := r.active[key]; ok {
+		w.lastUsed = now
+		return nil, false
+	}
+	if until, ok := r.blocked[key]; ok {
+		if now.Before(until) {
+			return nil, false
+		}
+		delete(r.blocked, key)
+	}
+	if maxWatches > 0 && len(r.active) >= maxWatches {
+		return nil, false
+	}
+	if r.active == nil {
+		r.active = make(map[snapshotWatchKey]*snapshotWatch)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.active[key] = &snapshotWatch{cancel: cancel, lastUsed: now}
+	return ctx, true
+}
+
+func (r *snapshotWatchRegistry) lastUsed(key snapshotWatchKey) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if w, ok := r.active[key]; ok {
+		return w.lastUsed
+	}
+	return time.Time{}
+}
+
+// stop cancels the watcher for key. A non-zero blockedUntil parks the key so the
+// read path does not restart it before then.
+func (r *snapshotWatchRegistry) stop(key snapshotWatchKey, blockedUntil time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if w, ok := r.active[key]; ok {
+		w.cancel()
+		delete(r.active, key)
+	}
+	if !blockedUntil.IsZero() {
+		if r.blocked == nil {
+			r.blocked = make(map[snapshotWatchKey]time.Time)
+		}
+		r.blocked[key] = blockedUntil
+	}
+}
+
+// recordList keeps the resourceVersion of a list just stored for key.
+func (r *snapshotWatchRegistry) recordList(key snapshotWatchKey, resourceVersion string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.listed == nil {
+		r.listed = make(map[snapshotWatchKey]string)
+	}
+	r.listed[key] = resourceVersion
+}
+
+// takeList returns and forgets the recorded list resourceVersion for key.
+func (r *snapshotWatchRegistry) takeList(key snapshotWatchKey) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rv := r.listed[key]
+	delete(r.listed, key)
+	return rv
+}
+
+// stopAll cancels every watcher and refuses new ones; used when the plane is evicted.
+func (r *snapshotWatchRegistry) stopAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	for key, w := range r.active {
+		w.cancel()
+		delete(r.active, key)
+	}
+}
+
+// snapshotWatchTarget binds a watch source to one cluster or namespaced store.
+type snapshotWatchTarget[I any] struct {
+	key         snapshotWatchKey
+	capGroup    string
+	capResource string
+	capScope    CapabilityScope
+	source      *snapshotWatchSource[I]
+	ttl         time.Duration
+	relist      func(context.Context) error
+	update      func(func(Snapshot[I]) Snapshot[I]) (Snapshot[I], bool)
+	touch       func(SnapshotMetadata)
+	persist     func(Snapshot[I])
+}
+
+type snapshotWatchChange[I any] struct {
+	item    I
+	deleted bool
+}
+
+func ensureClusterSnapshotWatch[I any](
+	p *clusterPlane,
+	sched *workScheduler,
+	clients ClientsProvider,
+	store *snapshotStore[Snapshot[I]],
+	desc clusterSnapshotDescriptor[I],
+) {
+	if clients == nil || !p.currentPolicy().Watch.WatchesKind(desc.kind) {
+		return
+	}
+	key := snapshotWatchKey{kind: desc.kind}
+	ctx, ok := p.watches.start(key, time.Now(), p.currentPolicy().Watch.MaxWatchesPerCluster)
+	if !ok {
+		return
+	}
+	t := snapshotWatchTarget[I]{
+		key:         key,
+		capGroup:    desc.capGroup,
+		capResource: desc.capResource,
+		capScope:    desc.capScope,
+		source:      desc.watch,
+		ttl:         desc.ttl,
+		relist: func(ctx context.Context) error {
+			_, err := refreshClusterSnapshot(p, ctx, sched, WorkPriorityLow, clients, store, desc)
+			return err
+		},
+		update: func(fn func(Snapshot[I]) Snapshot[I]) (Snapshot[I], bool) {
+			return updateClusterSnapshot(store, fn)
+		},
+		touch: func(meta SnapshotMetadata) { touchClusterSnapshot(store, meta) },
+		persist: func(snap Snapshot[I]) {
+			if sp := p.currentPersistence(); sp != nil && !desc.skipPersistence {
+				_ = sp.Save(p.name, desc.kind, "", snap)
+			}
+		},
+	}
+	go runSnapshotWatch(p, ctx, clients, t)
+}
+
+func ensureNamespacedSnapshotWatch[I any](
+	p *clusterPlane,
+	sched *workScheduler,
+	clients ClientsProvider,
+	namespace string,
+	store *namespacedSnapshotStore[Snapshot[I]],
+	desc namespacedSnapshotDescriptor[I],
+) {
+	if clients == nil || namespace == "" || !p.currentPolicy().Watch.WatchesKind(desc.kind) {
+		return
+	}
+	key := snapshotWatchKey{kind: desc.kind, namespace: namespace}
+	ctx, ok := p.watches.start(key, time.Now(), p.currentPolicy().Watch.MaxWatchesPerCluster)
+	if !ok {
+		return
+	}
+	t := snapshotWatchTarget[I]{
+		key:         key,
+		capGroup:    desc.capGroup,
+		capResource: desc.capResource,
+		capScope:    desc.capScope,
+		source:      desc.watch,
+		ttl:         desc.ttl,
+		relist: func(ctx context.Context) error {
+			_, err := refreshNamespacedSnapshot(p, ctx, sched, WorkPriorityLow, clients, namespace, store, desc)
+			return err
+		},
+		update: func(fn func(Snapshot[I]) Snapshot[I]) (Snapshot[I], bool) {
+			return updateNamespacedSnapshot(store, namespace, fn)
+		},
+		touch: func(meta SnapshotMetadata) { touchNamespacedSnapshot(store, namespace, meta) },
+		persist: func(snap Snapshot[I]) {
+			if sp := p.currentPersistence(); sp != nil && !desc.skipPersistence {
+				_ = sp.Save(p.name, desc.kind, namespace, snap)
+			}
+		},
+	}
+	go runSnapshotWatch(p, ctx, clients, t)
+}
+
+func runSnapshotWatch[I any](p *clusterPlane, ctx context.Context, clients ClientsProvider, t snapshotWatchTarget[I]) {
+	defer p.watches.stop(t.key, time.Time{})
+
+	ctx = ContextWithWorkSource(ctx, WorkSourceObserver)
+	backoff := snapshotWatchBackoffMin
+	wait := func() bool {
+		timer := time.NewTimer(backoff)
+		defer timer.Stop()
+		backoff *= 2
+		if backoff > snapshotWatchBackoffMax {
+			backoff = snapshotWatchBackoffMax
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		}
+	}
+
+	// The caller stored a list right before starting the watcher; resume from it.
+	resourceVersion := p.watches.takeList(t.key)
+	for ctx.Err() == nil {
+		if !p.currentPolicy().Watch.WatchesKind(t.key.kind) {
+			return
+		}
+		c, _, err := clients.GetClientsForContext(ctx, p.name)
+		if err != nil {
+			if !wait() {
+				return
+			}
+			continue
+		}
+
+		if resourceVersion == "" {
+			relistCtx, cancel := context.WithTimeout(ctx, snapshotWatchRelistTimeout)
+			err := t.relist(relistCtx)
+			cancel()
+			if err != nil {
+				if snapshotWatchDenied(p, t, err) || !wait() {
+					return
+				}
+				continue
+			}
+			// A relist that joined another caller's in-flight list may find the
+			// resourceVersion not yet recorded; retry after the backoff.
+			if resourceVersion = p.watches.takeList(t.key); resourceVersion == "" {
+				if !wait() {
+					return
+				}
+				continue
+			}
+		}
+
+		w, err := t.source.watch(ctx, c, t.key.namespace, resourceVersion)
+		if err != nil {
+			if isSnapshotWatchExpired(err) {
+				resourceVersion = ""
+				continue
+			}
+			if snapshotWatchDenied(p, t, err) || !wait() {
+				return
+			}
+			continue
+		}
+		p.capRegistry.LearnReadResult(p.name, t.capGroup, t.capResource, t.key.namespace, "watch", t.capScope, nil)
+		backoff = snapshotWatchBackoffMin
+
+		next, idle, err := consumeSnapshotWatch(p, ctx, w, t, resourceVersion)
+		w.Stop()
+		if idle {
+			return
+		}
+		resourceVersion = next
+		if err != nil {
+			resourceVersion = ""
+			if isSnapshotWatchExpired(err) {
+				continue
+			}
+			if snapshotWatchDenied(p, t, err) || !wait() {
+				return
+			}
+		}
+		// A closed result channel without an error is the server-side watch timeout;
+		// resume from the last seen resourceVersion without relisting.
+	}
+}
+
+// consumeSnapshotWatch applies events from w until the stream ends, fails, or the
+// watcher goes idle. It returns the last seen resourceVersion.
+func consumeSnapshotWatch[I any](p *clusterPlane, ctx context.Context, w watch.Interface, t snapshotWatchTarget[I], resourceVersion string) (string, bool, error) {
+	policy := p.currentPolicy()
+	idleAfter := time.Duration(policy.Watch.IdleStopSec) * time.Second
+	persistEvery := time.Duration(policy.Watch.PersistIntervalSec) * time.Second
+	keepalive := t.ttl / 2
+	if keepalive < time.Second {
+		keepalive = time.Second
+	}
+
+	flushTicker := time.NewTicker(snapshotWatchFlushInterval)
+	defer flushTicker.Stop()
+	keepaliveTicker := time.NewTicker(keepalive)
+	defer keepaliveTicker.Stop()
+
+	pending := map[string]snapshotWatchChange[I]{}
+	var unsaved *Snapshot[I]
+	lastPersist := time.Now()
+	flush := func(force bool) {
+		if len(pending) > 0 {
+			changes := pending
+			pending = map[string]snapshotWatchChange[I]{}
+			now := time.Now().UTC()
+			snap, ok := t.update(func(cur Snapshot[I]) Snapshot[I] {
+				cur.Items = applySnapshotWatchChanges(cur.Items, changes, t.source.key, t.source.merge)
+				cur.Meta = p.snapshotMetaHot(now)
+				return cur
+			})
+			if ok {
+				unsaved = &snap
+			}
+		}
+		// bbolt writes are throttled; the relist path persists every full list anyway.
+		if unsaved != nil && (force || time.Since(lastPersist) >= persistEvery) {
+			t.persist(*unsaved)
+			unsaved = nil
+			lastPersist = time.Now()
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			flush(true)
+			return resourceVersion, false, nil
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				flush(false)
+				return resourceVersion, false, nil
+			}
+			switch ev.Type {
+			case watch.Error:
+				flush(false)
+				return resourceVersion, false, apierrors.FromObject(ev.Object)
+			case watch.Bookmark:
+				if rv := objectResourceVersion(ev.Object); rv != "" {
+					resourceVersion = rv
+				}
+			case watch.Added, watch.Modified, watch.Deleted:
+				item, ok := t.source.item(ev.Object, time.Now())
+				if !ok {
+					continue
+				}
+				pending[t.source.key(item)] = snapshotWatchChange[I]{item: item, deleted: ev.Type == watch.Deleted}
+				if rv := objectResourceVersion(ev.Object); rv != "" {
+					resourceVersion = rv
+				}
+			}
+		case <-flushTicker.C:
+			if len(pending) > 0 {
+				flush(false)
+			}
+		case <-keepaliveTicker.C:
+			flush(false)
+			if idleAfter > 0 && time.Since(p.watches.lastUsed(t.key)) >= idleAfter {
+				flush(true)
+				return resourceVersion, true, nil
+			}
+			if !p.currentPolicy().Watch.WatchesKind(t.key.kind) {
+				flush(true)
+				return resourceVersion, true, nil
+			}
+			// The stream is healthy, so the stored list is still current.
+			t.touch(p.snapshotMetaHot(time.Now().UTC()))
+		}
+	}
+}
+
+// applySnapshotWatchChanges folds a batch of watch changes into list rows. Existing
+// rows keep their position; new rows are appended in key order.
+func applySnapshotWatchChanges[I any](items []I, changes map[string]snapshotWatchChange[I], key func(I) string, merge func(prev, next I) I) []I {
+	out := make([]I, 0, len(items)+len(changes))
+	seen := make(map[string]struct{}, len(changes))
+	for _, it := range items {
+		k := key(it)
+		ch, ok := changes[k]
+		if !ok {
+			out = append(out, it)
+			continue
+		}
+		seen[k] = struct{}{}
+		if ch.deleted {
+			continue
+		}
+		next := ch.item
+		if merge != nil {
+			next = merge(it, next)
+		}
+		out = append(out, next)
+	}
+	added := make([]string, 0, len(changes))
+	for k, ch := range changes {
+		if _, ok := seen[k]; ok || ch.deleted {
+			continue
+		}
+		added = append(added, k)
+	}
+	sort.Strings(added)
+	for _, k := range added {
+		out = append(out, changes[k].item)
+	}
+	return out
+}
+
+func snapshotWatchDenied[I any](p *clusterPlane, t snapshotWatchTarget[I], err error) bool {
+	n := NormalizeError(err)
+	switch n.Class {
+	case NormalizedErrorClassAccessDenied, NormalizedErrorClassUnauthorized:
+		p.capRegistry.LearnReadResult(p.name, t.capGroup, t.capResource, t.key.namespace, "watch", t.capScope, err)
+		p.watches.stop(t.key, time.Now().Add(snapshotWatchBlockedRetry))
+		return true
+	}
+	return false
+}
+
+func isSnapshotWatchExpired(err error) bool {
+	return apierrors.IsResourceExpired(err) || apierrors.IsGone(err)
+}
+
+func objectResourceVersion(obj runtime.Object) string {
+	if obj == nil {
+		return ""
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetResourceVersion()
+}
@@ -0,0 +1,243 @@
+package dataplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	pods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
+)
+
+func TestApplySnapshotWatchChanges(t *testing.T) {
+	items := []dto.PodListItemDTO{
+		{Name: "a", Phase: "Pending", LastEvent: &dto.EventBriefDTO{Reason: "Scheduled"}},
+		{Name: "b", Phase: "Running"},
+		{Name: "c", Phase: "Running"},
+	}
+	changes := map[string]snapshotWatchChange[dto.PodListItemDTO]{
+		"a": {item: dto.PodListItemDTO{Name: "a", Phase: "Running"}},
+		"b": {item: dto.PodListItemDTO{Name: "b"}, deleted: true},
+		"e": {item: dto.PodListItemDTO{Name: "e", Phase: "Pending"}},
+		"d": {item: dto.PodListItemDTO{Name: "d", Phase: "Pending"}},
+		"x": {item: dto.PodListItemDTO{Name: "x"}, deleted: true},
+	}
+	merge := func(prev, next dto.PodListItemDTO) dto.PodListItemDTO {
+		next.LastEvent = prev.LastEvent
+		return next
+	}
+
+	got := applySnapshotWatchChanges(items, changes, func(it dto.PodListItemDTO) string { return it.Name }, merge)
+
+	var names []string
+	for _, it := range got {
+		names = append(names, it.Name)
+	}
+	want := []string{"a", "c", "d", "e"}
+	if len(names) != len(want) {
+		t.Fatalf("names: got %v want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("names: got %v want %v", names, want)
+		}
+	}
+	if got[0].Phase != "Running" || got[0].LastEvent == nil || got[0].LastEvent.Reason != "Scheduled" {
+		t.Fatalf("updated row lost phase or merged event: %#v", got[0])
+	}
+}
+
+func TestNormalizeWatchResourceKinds(t *testing.T) {
+	policy := ValidateDataplanePolicy(DataplanePolicy{
+		Profile: DataplaneProfileFocused,
+		Snapshots: SnapshotPolicy{
+			TTLSeconds: map[string]int{},
+		},
+		Watch: WatchPolicy{
+			Kinds: []string{"pods", "pods", "nodes", "bogus", " deployments "},
+		},
+	})
+	if len(policy.Watch.Kinds) != 2 || policy.Watch.Kinds[0] != "pods" || policy.Watch.Kinds[1] != "deployments" {
+		t.Fatalf("kinds: got %v", policy.Watch.Kinds)
+	}
+	if !policy.Watch.WatchesKind(ResourceKindPods) || policy.Watch.WatchesKind(ResourceKindNodes) {
+		t.Fatalf("WatchesKind mismatch for %v", policy.Watch.Kinds)
+	}
+	if policy.Watch.MaxWatchesPerCluster != DefaultDataplanePolicy().Watch.MaxWatchesPerCluster {
+		t.Fatalf("max watches not defaulted: %d", policy.Watch.MaxWatchesPerCluster)
+	}
+
+	policy.Profile = DataplaneProfileManual
+	if got := ValidateDataplanePolicy(policy).Watch.Kinds; len(got) != 0 {
+		t.Fatalf("manual profile should disable watches, got %v", got)
+	}
+}
+
+func TestSnapshotWatchRegistryParksBlockedKeys(t *testing.T) {
+	var r snapshotWatchRegistry
+	key := snapshotWatchKey{kind: ResourceKindPods, namespace: "app"}
+	now := time.Now()
+
+	if _, ok := r.start(key, now, 1); !ok {
+		t.Fatalf("expected first start to succeed")
+	}
+	if _, ok := r.start(key, now, 1); ok {
+		t.Fatalf("expected duplicate start to be refused")
+	}
+	if _, ok := r.start(snapshotWatchKey{kind: ResourceKindPods, namespace: "other"}, now, 1); ok {
+		t.Fatalf("expected cap to refuse a second watcher")
+	}
+
+	r.stop(key, now.Add(time.Minute))
+	if _, ok := r.start(key, now.Add(30*time.Second), 1); ok {
+		t.Fatalf("expected blocked key to stay parked")
+	}
+	if _, ok := r.start(key, now.Add(2*time.Minute), 1); !ok {
+		t.Fatalf("expected blocked key to restart after retry window")
+	}
+}
+
+func TestSnapshotWatchRegistryHandsListResourceVersionOnce(t *testing.T) {
+	var r snapshotWatchRegistry
+	key := snapshotWatchKey{kind: ResourceKindPods, namespace: "app"}
+	if got := r.takeList(key); got != "" {
+		t.Fatalf("expected no resourceVersion before a list, got %q", got)
+	}
+	r.recordList(key, "41")
+	r.recordList(key, "42")
+	if got := r.takeList(snapshotWatchKey{kind: ResourceKindPods, namespace: "other"}); got != "" {
+		t.Fatalf("expected keys to be separate, got %q", got)
+	}
+	if got := r.takeList(key); got != "42" {
+		t.Fatalf("expected the latest list resourceVersion, got %q", got)
+	}
+	if got := r.takeList(key); got != "" {
+		t.Fatalf("expected the resourceVersion to be taken once, got %q", got)
+	}
+}
+
+func TestSnapshotWatchRegistryStopAllCancelsAndRefuses(t *testing.T) {
+	var r snapshotWatchRegistry
+	key := snapshotWatchKey{kind: ResourceKindPods, namespace: "app"}
+	ctx, ok := r.start(key, time.Now(), 0)
+	if !ok {
+		t.Fatalf("expected first start to succeed")
+	}
+
+	r.stopAll()
+	if ctx.Err() == nil {
+		t.Fatalf("expected running watcher to be cancelled")
+	}
+	if _, ok := r.start(key, time.Now(), 0); ok {
+		t.Fatalf("expected closed registry to refuse new watchers")
+	}
+}
+
+func TestTouchNamespacedSnapshotKeepsRevisionAndSkipsErrors(t *testing.T) {
+	store := newNamespacedSnapshotStore[PodsSnapshot]()
+	old := time.Now().Add(-time.Hour).UTC()
+	setNamespacedSnapshot(&store, "app", PodsSnapshot{Meta: SnapshotMetadata{ObservedAt: old}})
+	before, _ := peekNamespacedSnapshot(&store, "app")
+
+	now := time.Now().UTC()
+	touchNamespacedSnapshot(&store, "app", SnapshotMetadata{ObservedAt: now, Freshness: FreshnessClassHot})
+	after, _ := peekNamespacedSnapshot(&store, "app")
+	if after.Meta.Revision != before.Meta.Revision {
+		t.Fatalf("touch bumped revision: %d -> %d", before.Meta.Revision, after.Meta.Revision)
+	}
+	if !after.Meta.ObservedAt.Equal(now) || after.Meta.Freshness != FreshnessClassHot {
+		t.Fatalf("touch did not refresh metadata: %#v", after.Meta)
+	}
+
+	n := NormalizeError(context.DeadlineExceeded)
+	setNamespacedSnapshot(&store, "app", PodsSnapshot{Err: &n, Meta: SnapshotMetadata{ObservedAt: old}})
+	touchNamespacedSnapshot(&store, "app", SnapshotMetadata{ObservedAt: now})
+	if _, ok := updateNamespacedSnapshot(&store, "app", func(s PodsSnapshot) PodsSnapshot { return s }); ok {
+		t.Fatalf("update should skip snapshots carrying an error")
+	}
+	errored, _ := peekNamespacedSnapshot(&store, "app")
+	if !errored.Meta.ObservedAt.Equal(old) {
+		t.Fatalf("touch should not refresh an errored snapshot: %#v", errored.Meta)
+	}
+}
+
+func TestConsumeSnapshotWatchAppliesEventsUntilGone(t *testing.T) {
+	p := newClusterPlane("c", ProfileFocused, DiscoveryModeTargeted, ObservationScope{}, nil, nil, nil)
+	setNamespacedSnapshot(&p.podsStore, "app", PodsSnapshot{
+		Items: []dto.PodListItemDTO{{Name: "a", Namespace: "app", Phase: "Pending", LastEvent: &dto.EventBriefDTO{Reason: "Scheduled"}}},
+		Meta:  p.snapshotMetaHot(time.Now().Add(-time.Minute).UTC()),
+	})
+	before, _ := peekNamespacedSnapshot(&p.podsStore, "app")
+
+	var persisted []PodsSnapshot
+	target := snapshotWatchTarget[dto.PodListItemDTO]{
+		key: snapshotWatchKey{kind: ResourceKindPods, namespace: "app"},
+		source: &snapshotWatchSource[dto.PodListItemDTO]{
+			item: pods.PodListItemFromObject,
+			key:  func(it dto.PodListItemDTO) string { return it.Name },
+			merge: func(prev, next dto.PodListItemDTO) dto.PodListItemDTO {
+				next.LastEvent = prev.LastEvent
+				return next
+			},
+		},
+		ttl: time.Minute,
+		update: func(fn func(PodsSnapshot) PodsSnapshot) (PodsSnapshot, bool) {
+			return updateNamespacedSnapshot(&p.podsStore, "app", fn)
+		},
+		touch:   func(meta SnapshotMetadata) { touchNamespacedSnapshot(&p.podsStore, "app", meta) },
+		persist: func(s PodsSnapshot) { persisted = append(persisted, s) },
+	}
+
+	fw := watch.NewFake()
+	type result struct {
+		rv   string
+		idle bool
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		rv, idle, err := consumeSnapshotWatch(p, context.Background(), fw, target, "1")
+		done <- result{rv: rv, idle: idle, err: err}
+	}()
+
+	fw.Modify(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "app", ResourceVersion: "5"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	})
+	fw.Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "app", ResourceVersion: "6"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	})
+	fw.Error(&metav1.Status{Status: metav1.StatusFailure, Code: 410, Reason: metav1.StatusReasonExpired})
+
+	var res result
+	select {
+	case res = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("consumeSnapshotWatch did not return after error event")
+	}
+	if !isSnapshotWatchExpired(res.err) {
+		t.Fatalf("expected expired error, got %v", res.err)
+	}
+	if res.rv != "6" || res.idle {
+		t.Fatalf("unexpected result: %#v", res)
+	}
+
+	after, ok := peekNamespacedSnapshot(&p.podsStore, "app")
+	if !ok || len(after.Items) != 2 {
+		t.Fatalf("expected two pods after events, got %#v", after.Items)
+	}
+	if after.Items[0].Phase != "Running" || after.Items[0].LastEvent == nil {
+		t.Fatalf("modified pod not merged: %#v", after.Items[0])
+	}
+	if after.Items[1].Name != "b" {
+		t.Fatalf("added pod missing: %#v", after.Items[1])
+	}
+	if after.Meta.Revision <= before.Meta.Revision || after.Meta.Freshness != FreshnessClassHot || !after.Meta.ObservedAt.After(before.Meta.ObservedAt) {
+		t.Fatalf("metadata not refreshed honestly: before=%#v after=%#v", before.Meta, after.Meta)
+	}
+}
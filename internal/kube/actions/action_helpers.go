@@ -3,10 +3,12 @@ package actions
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -72,6 +74,20 @@ func boolParam(params map[string]any, key string) (bool, *ActionResult) {
 	return value, nil
 }
 
+// nonNegativeIntParam reads an optional whole-number param. ok is false when the param is
+// absent.
+func nonNegativeIntParam(params map[string]any, key string) (value int64, ok bool, result *ActionResult) {
+	raw, present := params[key]
+	if !present {
+		return 0, false, nil
+	}
+	f, isNumber := raw.(float64)
+	if !isNumber || f < 0 || f != math.Trunc(f) {
+		return 0, false, &ActionResult{Status: "error", Message: fmt.Sprintf("params.%s must be an integer >= 0", key)}
+	}
+	return int64(f), true, nil
+}
+
 // handleNamespacedDelete is the shared helper for simple namespaced-delete
 // action handlers. It validates the target, builds DeleteOptions from the
 // request params, calls deleteFn, and returns the canonical ActionResult.
@@ -145,6 +161,25 @@ func rolloutRestartPatch(restartedAt string) []byte {
 	return patch
 }
 
+// IsResourceVersionConflict reports whether a patch guarded by a resourceVersion test
+// op was refused because the object changed after it was read. The API server answers a
+// failed JSON patch test op with a bare 422, without the field causes a validation
+// failure of the patched object carries; an outright conflict is 409.
+func IsResourceVersionConflict(err error) bool {
+	if apierrors.IsConflict(err) {
+		return true
+	}
+	if !apierrors.IsInvalid(err) {
+		return false
+	}
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return false
+	}
+	details := status.Status().Details
+	return details == nil || len(details.Causes) == 0
+}
+
 // handleNamespacedScale is the shared helper for scale action handlers.
 // It validates the target, parses replicas, builds the spec.replicas patch,
 // calls patchFn, and returns the canonical ActionResult.
@@ -264,3 +299,38 @@ func handleNamespacedRolloutRestart(
 		},
 	}, nil
 }
+
+// handleNamespacedSuspend is the shared helper for suspend/resume action handlers. It
+// validates the target, patches spec.suspend, and returns the canonical ActionResult.
+func handleNamespacedSuspend(
+	ctx context.Context,
+	req ActionRequest,
+	expectedGroup, expectedResource, kindLabel string,
+	suspend bool,
+	patchFn func(ctx context.Context, ns, name string, patch []byte) error,
+) (*ActionResult, error) {
+	if err := validateNamespacedTarget(req, expectedGroup, expectedResource); err != nil {
+		return &ActionResult{Status: "error", Message: err.Error()}, nil
+	}
+
+	patch, _ := json.Marshal(map[string]any{
+		"spec": map[string]any{"suspend": suspend},
+	})
+	if err := patchFn(ctx, req.Namespace, req.Name, patch); err != nil {
+		return nil, err
+	}
+
+	verb := "Resumed"
+	if suspend {
+		verb = "Suspended"
+	}
+	return &ActionResult{
+		Status:  "ok",
+		Message: fmt.Sprintf("%s %s %s/%s", verb, kindLabel, req.Namespace, req.Name),
+		Details: map[string]any{
+			"namespace": req.Namespace,
+			"name":      req.Name,
+			"suspend":   suspend,
+		},
+	}, nil
+}
@@ -1,6 +1,14 @@
 package actions
 
-import "testing"
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
 
 func TestBuildDeleteOptions_ForceSetsZeroGracePeriod(t *testing.T) {
 	opts, result := buildDeleteOptions(ActionRequest{
@@ -37,3 +45,23 @@ func TestBuildDeleteOptions_InvalidForceParam(t *testing.T) {
 		t.Fatalf("message: got %q", result.Message)
 	}
 }
+
+func TestIsResourceVersionConflict(t *testing.T) {
+	gr := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	testFailed := apierrors.NewGenericServerResponse(http.StatusUnprocessableEntity, "patch", gr, "web", "", 0, false)
+	if !IsResourceVersionConflict(testFailed) {
+		t.Fatal("a failed resourceVersion test op is a conflict")
+	}
+	invalid := apierrors.NewInvalid(schema.GroupKind{Group: "apps", Kind: "Deployment"}, "web", field.ErrorList{
+		field.Required(field.NewPath("spec", "template", "spec", "containers"), ""),
+	})
+	if IsResourceVersionConflict(invalid) {
+		t.Fatal("a validation failure is not a conflict")
+	}
+	if !IsResourceVersionConflict(apierrors.NewConflict(gr, "web", errors.New("modified"))) {
+		t.Fatal("409 is a conflict")
+	}
+	if IsResourceVersionConflict(apierrors.NewForbidden(gr, "web", errors.New("denied"))) {
+		t.Fatal("forbidden is not a conflict")
+	}
+}
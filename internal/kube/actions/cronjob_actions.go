@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 )
@@ -44,3 +45,20 @@ func HandleCronJobRun(ctx context.Context, c *cluster.Clients, req ActionRequest
 		},
 	}, nil
 }
+
+// HandleCronJobSuspend stops the cronjob from scheduling new jobs.
+func HandleCronJobSuspend(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleNamespacedSuspend(ctx, req, "batch", "cronjobs", "cronjob", true, cronJobPatch(c))
+}
+
+// HandleCronJobResume lets a suspended cronjob schedule jobs again.
+func HandleCronJobResume(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleNamespacedSuspend(ctx, req, "batch", "cronjobs", "cronjob", false, cronJobPatch(c))
+}
+
+func cronJobPatch(c *cluster.Clients) func(ctx context.Context, ns, name string, patch []byte) error {
+	return func(ctx context.Context, ns, name string, patch []byte) error {
+		_, err := c.Clientset.BatchV1().CronJobs(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	}
+}
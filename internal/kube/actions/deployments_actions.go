@@ -2,6 +2,8 @@ package actions
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -41,3 +43,40 @@ func HandleDeploymentDelete(ctx context.Context, c *cluster.Clients, req ActionR
 		},
 	)
 }
+
+// HandleDeploymentPause pauses rollouts of the deployment (spec.paused=true).
+func HandleDeploymentPause(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleDeploymentPaused(ctx, c, req, true)
+}
+
+// HandleDeploymentResume resumes paused rollouts of the deployment.
+func HandleDeploymentResume(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleDeploymentPaused(ctx, c, req, false)
+}
+
+func handleDeploymentPaused(ctx context.Context, c *cluster.Clients, req ActionRequest, paused bool) (*ActionResult, error) {
+	if err := validateNamespacedTarget(req, "apps", "deployments"); err != nil {
+		return &ActionResult{Status: "error", Message: err.Error()}, nil
+	}
+	patch, _ := json.Marshal(map[string]any{
+		"spec": map[string]any{"paused": paused},
+	})
+	if _, err := c.Clientset.AppsV1().Deployments(req.Namespace).Patch(
+		ctx, req.Name, types.MergePatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return nil, err
+	}
+	verb := "Resumed"
+	if paused {
+		verb = "Paused"
+	}
+	return &ActionResult{
+		Status:  "ok",
+		Message: fmt.Sprintf("%s rollout of %s/%s", verb, req.Namespace, req.Name),
+		Details: map[string]any{
+			"namespace": req.Namespace,
+			"name":      req.Name,
+			"paused":    paused,
+		},
+	}, nil
+}
@@ -0,0 +1,278 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+)
+
+// HandleResourceFinalizersRemove removes metadata.finalizers from any object, the
+// last-resort cleanup for objects stuck in Terminating. params.confirm must repeat the
+// target ("namespace/name", or "name" for cluster-scoped objects). params.finalizers
+// optionally limits the removal to the listed finalizers; by default all are removed.
+// Only objects already being deleted are accepted. A namespace's spec.finalizers are
+// cleared through the finalize subresource. Every write is guarded by the object's
+// resourceVersion so a concurrent update fails instead of being overwritten.
+func HandleResourceFinalizersRemove(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	if req.Resource == "" || req.Name == "" {
+		return &ActionResult{Status: "error", Message: "resource and name are required"}, nil
+	}
+	if errResult := checkFinalizerConfirm(req); errResult != nil {
+		return errResult, nil
+	}
+	selected, errResult := finalizersParam(req.Params)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	gvr, err := finalizerTargetGVR(c, req)
+	if err != nil {
+		return &ActionResult{Status: "error", Message: err.Error()}, nil
+	}
+	dyn, err := dynamic.NewForConfig(c.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	var ri dynamic.ResourceInterface = dyn.Resource(gvr)
+	if req.Namespace != "" {
+		ri = dyn.Resource(gvr).Namespace(req.Namespace)
+	}
+
+	obj, err := ri.Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	plan, errResult := planFinalizerRemoval(req, obj, isNamespaceGVR(gvr), selected)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	resourceVersion := obj.GetResourceVersion()
+	if len(plan.specRemoved) > 0 {
+		ns, err := finalizeNamespace(ctx, c, req.Name, resourceVersion, plan.specKept)
+		if err != nil {
+			if IsResourceVersionConflict(err) {
+				return finalizerConflictResult(req), nil
+			}
+			return nil, err
+		}
+		resourceVersion = ns.ResourceVersion
+	}
+	if len(plan.removed) > 0 {
+		patch := finalizersPatch(resourceVersion, plan.kept)
+		if _, err := ri.Patch(ctx, req.Name, types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+			if IsResourceVersionConflict(err) {
+				return finalizerConflictResult(req), nil
+			}
+			return nil, err
+		}
+	}
+	details := map[string]any{
+		"namespace":  req.Namespace,
+		"name":       req.Name,
+		"removed":    append(append([]string{}, plan.removed...), plan.specRemoved...),
+		"remaining":  append(append([]string{}, plan.kept...), plan.specKept...),
+		"terminated": len(plan.kept) == 0 && len(plan.specKept) == 0,
+	}
+	if len(plan.specRemoved) > 0 {
+		details["specRemoved"] = plan.specRemoved
+	}
+	return &ActionResult{
+		Status:  "ok",
+		Message: fmt.Sprintf("Removed %d finalizer(s) from %s %s", len(plan.removed)+len(plan.specRemoved), req.Resource, finalizerConfirmTarget(req)),
+		Details: details,
+	}, nil
+}
+
+// finalizerRemovalPlan splits the object's finalizers into those kept and removed. For a
+// namespace, spec.finalizers (e.g. "kubernetes") are planned separately because only the
+// finalize subresource can change them.
+type finalizerRemovalPlan struct {
+	kept, removed         []string
+	specKept, specRemoved []string
+}
+
+// planFinalizerRemoval refuses objects that are not being deleted: stripping finalizers
+// from a live object skips cleanup the owning controller still expects to run.
+func planFinalizerRemoval(req ActionRequest, obj *unstructured.Unstructured, namespace bool, selected []string) (finalizerRemovalPlan, *ActionResult) {
+	var plan finalizerRemovalPlan
+	if obj.GetDeletionTimestamp() == nil {
+		return plan, &ActionResult{Status: "error", Message: fmt.Sprintf("%s is not being deleted; finalizers can only be removed from objects stuck in Terminating", finalizerConfirmTarget(req))}
+	}
+	current := obj.GetFinalizers()
+	var specCurrent []string
+	if namespace {
+		specCurrent, _, _ = unstructured.NestedStringSlice(obj.Object, "spec", "finalizers")
+	}
+	if len(current) == 0 && len(specCurrent) == 0 {
+		return plan, &ActionResult{Status: "error", Message: fmt.Sprintf("%s has no finalizers", finalizerConfirmTarget(req))}
+	}
+	var missing, specMissing []string
+	plan.kept, plan.removed, missing = splitFinalizers(current, selected)
+	plan.specKept, plan.specRemoved, specMissing = splitFinalizers(specCurrent, selected)
+	if notFound := intersectStrings(missing, specMissing); len(notFound) > 0 {
+		return plan, &ActionResult{Status: "error", Message: fmt.Sprintf("finalizers not present on the object: %s", strings.Join(notFound, ", "))}
+	}
+	return plan, nil
+}
+
+func isNamespaceGVR(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == "" && gvr.Resource == "namespaces"
+}
+
+// finalizeNamespace sets a namespace's spec.finalizers through the finalize subresource.
+// The update carries resourceVersion, so a namespace changed since it was read conflicts.
+func finalizeNamespace(ctx context.Context, c *cluster.Clients, name, resourceVersion string, kept []string) (*corev1.Namespace, error) {
+	ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if ns.ResourceVersion != resourceVersion {
+		return nil, apierrors.NewConflict(corev1.Resource("namespaces"), name, fmt.Errorf("the namespace was modified"))
+	}
+	ns.Spec.Finalizers = make([]corev1.FinalizerName, 0, len(kept))
+	for _, f := range kept {
+		ns.Spec.Finalizers = append(ns.Spec.Finalizers, corev1.FinalizerName(f))
+	}
+	return c.Clientset.CoreV1().Namespaces().Finalize(ctx, ns, metav1.UpdateOptions{})
+}
+
+func finalizerConflictResult(req ActionRequest) *ActionResult {
+	return &ActionResult{
+		Status:  "error",
+		Message: fmt.Sprintf("%s changed while removing finalizers; reload and retry", finalizerConfirmTarget(req)),
+		Details: map[string]any{"namespace": req.Namespace, "name": req.Name, "reason": "Conflict"},
+	}
+}
+
+// intersectStrings returns the values of a that are also in b, in a's order.
+func intersectStrings(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+	var out []string
+	for _, v := range a {
+		if in[v] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// finalizerConfirmTarget is the text the caller must type to confirm the removal.
+func finalizerConfirmTarget(req ActionRequest) string {
+	if req.Namespace == "" {
+		return req.Name
+	}
+	return req.Namespace + "/" + req.Name
+}
+
+func checkFinalizerConfirm(req ActionRequest) *ActionResult {
+	confirm, errResult := optionalStringParam(req.Params, "confirm")
+	if errResult != nil {
+		return errResult
+	}
+	want := finalizerConfirmTarget(req)
+	if strings.TrimSpace(confirm) != want {
+		return &ActionResult{Status: "error", Message: fmt.Sprintf("params.confirm must be %q to remove finalizers", want)}
+	}
+	return nil
+}
+
+// finalizersParam reads the optional params.finalizers list. A nil result means all.
+func finalizersParam(params map[string]any) ([]string, *ActionResult) {
+	raw, ok := params["finalizers"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, &ActionResult{Status: "error", Message: "params.finalizers must be a list of strings"}
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, &ActionResult{Status: "error", Message: "params.finalizers must be a list of strings"}
+		}
+		out = append(out, strings.TrimSpace(s))
+	}
+	if len(out) == 0 {
+		return nil, &ActionResult{Status: "error", Message: "params.finalizers must not be empty"}
+	}
+	return out, nil
+}
+
+// splitFinalizers partitions current into kept and removed. A nil selection removes
+// every finalizer; selected names missing from current are returned as missing.
+func splitFinalizers(current, selected []string) (kept, removed, missing []string) {
+	kept, removed = []string{}, []string{}
+	if selected == nil {
+		return kept, append(removed, current...), nil
+	}
+	want := make(map[string]bool, len(selected))
+	for _, f := range selected {
+		want[f] = true
+	}
+	present := make(map[string]bool, len(current))
+	for _, f := range current {
+		present[f] = true
+		if want[f] {
+			removed = append(removed, f)
+		} else {
+			kept = append(kept, f)
+		}
+	}
+	for _, f := range selected {
+		if !present[f] {
+			missing = append(missing, f)
+		}
+	}
+	return kept, removed, missing
+}
+
+func finalizersPatch(resourceVersion string, kept []string) []byte {
+	patch, _ := json.Marshal([]jsonPatchOp{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": resourceVersion},
+		{"op": "replace", "path": "/metadata/finalizers", "value": kept},
+	})
+	return patch
+}
+
+// finalizerTargetGVR resolves the target resource. When req.APIVersion is empty the
+// preferred version of the group is looked up through discovery.
+func finalizerTargetGVR(c *cluster.Clients, req ActionRequest) (schema.GroupVersionResource, error) {
+	if req.APIVersion != "" {
+		gv, err := schema.ParseGroupVersion(req.APIVersion)
+		if err != nil {
+			return schema.GroupVersionResource{}, fmt.Errorf("invalid apiVersion %q: %w", req.APIVersion, err)
+		}
+		if req.Group != "" && gv.Group != req.Group {
+			return schema.GroupVersionResource{}, fmt.Errorf("apiVersion %q does not match group %q", req.APIVersion, req.Group)
+		}
+		return gv.WithResource(req.Resource), nil
+	}
+	groupResources, err := restmapper.GetAPIGroupResources(c.Discovery)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("discover API resources: %w", err)
+	}
+	mapper := restmapper.NewDiscoveryRESTMapper(groupResources)
+	gvr, err := mapper.ResourceFor(schema.GroupVersionResource{Group: req.Group, Resource: req.Resource})
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("resolve resource %q: %w", req.Resource, err)
+	}
+	return gvr, nil
+}
@@ -0,0 +1,121 @@
+package actions
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCheckFinalizerConfirm(t *testing.T) {
+	req := ActionRequest{Resource: "widgets", Namespace: "team-a", Name: "w1"}
+	if res := checkFinalizerConfirm(req); res == nil {
+		t.Fatal("expected missing confirmation to fail")
+	}
+	req.Params = map[string]any{"confirm": "w1"}
+	if res := checkFinalizerConfirm(req); res == nil {
+		t.Fatal("expected name-only confirmation to fail for a namespaced object")
+	}
+	req.Params = map[string]any{"confirm": "team-a/w1"}
+	if res := checkFinalizerConfirm(req); res != nil {
+		t.Fatalf("unexpected error: %+v", res)
+	}
+
+	cluster := ActionRequest{Resource: "namespaces", Name: "team-a", Params: map[string]any{"confirm": "team-a"}}
+	if res := checkFinalizerConfirm(cluster); res != nil {
+		t.Fatalf("unexpected error for cluster-scoped target: %+v", res)
+	}
+}
+
+func TestFinalizersParam(t *testing.T) {
+	if got, res := finalizersParam(nil); got != nil || res != nil {
+		t.Fatalf("absent param: got %v %+v", got, res)
+	}
+	got, res := finalizersParam(map[string]any{"finalizers": []any{"example.io/cleanup"}})
+	if res != nil || !reflect.DeepEqual(got, []string{"example.io/cleanup"}) {
+		t.Fatalf("got %v %+v", got, res)
+	}
+	for _, bad := range []any{"example.io/cleanup", []any{1}, []any{}} {
+		if _, res := finalizersParam(map[string]any{"finalizers": bad}); res == nil {
+			t.Fatalf("expected %v to be rejected", bad)
+		}
+	}
+}
+
+func TestSplitFinalizers(t *testing.T) {
+	current := []string{"a", "b", "c"}
+
+	kept, removed, missing := splitFinalizers(current, nil)
+	if len(kept) != 0 || !reflect.DeepEqual(removed, current) || missing != nil {
+		t.Fatalf("remove all: kept=%v removed=%v missing=%v", kept, removed, missing)
+	}
+
+	kept, removed, missing = splitFinalizers(current, []string{"b", "z"})
+	if !reflect.DeepEqual(kept, []string{"a", "c"}) || !reflect.DeepEqual(removed, []string{"b"}) || !reflect.DeepEqual(missing, []string{"z"}) {
+		t.Fatalf("selected: kept=%v removed=%v missing=%v", kept, removed, missing)
+	}
+}
+
+func TestFinalizersPatchGuardsResourceVersion(t *testing.T) {
+	var ops []map[string]any
+	if err := json.Unmarshal(finalizersPatch("42", []string{}), &ops); err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 2 || ops[0]["op"] != "test" || ops[0]["value"] != "42" {
+		t.Fatalf("expected resourceVersion test op first, got %v", ops)
+	}
+	if ops[1]["path"] != "/metadata/finalizers" || !reflect.DeepEqual(ops[1]["value"], []any{}) {
+		t.Fatalf("expected empty finalizers replace, got %v", ops[1])
+	}
+}
+
+func finalizerTestObject(deleting bool, finalizers []string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetName("team-a")
+	obj.SetFinalizers(finalizers)
+	if deleting {
+		now := metav1.Now()
+		obj.SetDeletionTimestamp(&now)
+	}
+	if spec != nil {
+		obj.Object["spec"] = spec
+	}
+	return obj
+}
+
+func TestPlanFinalizerRemoval(t *testing.T) {
+	req := ActionRequest{Resource: "widgets", Namespace: "team-a", Name: "w1"}
+
+	if _, res := planFinalizerRemoval(req, finalizerTestObject(false, []string{"example.io/cleanup"}, nil), false, nil); res == nil {
+		t.Fatal("expected a live object to be rejected")
+	}
+	if _, res := planFinalizerRemoval(req, finalizerTestObject(true, nil, nil), false, nil); res == nil || res.Message != "team-a/w1 has no finalizers" {
+		t.Fatalf("expected no-finalizers error, got %+v", res)
+	}
+	plan, res := planFinalizerRemoval(req, finalizerTestObject(true, []string{"a", "b"}, nil), false, []string{"b"})
+	if res != nil || !reflect.DeepEqual(plan.kept, []string{"a"}) || !reflect.DeepEqual(plan.removed, []string{"b"}) || len(plan.specRemoved) != 0 {
+		t.Fatalf("unexpected plan %+v %+v", plan, res)
+	}
+	if _, res := planFinalizerRemoval(req, finalizerTestObject(true, []string{"a"}, nil), false, []string{"z"}); res == nil {
+		t.Fatal("expected unknown finalizer to be rejected")
+	}
+}
+
+func TestPlanFinalizerRemoval_NamespaceSpecFinalizers(t *testing.T) {
+	req := ActionRequest{Resource: "namespaces", Name: "team-a"}
+	spec := map[string]any{"finalizers": []any{"kubernetes"}}
+
+	plan, res := planFinalizerRemoval(req, finalizerTestObject(true, nil, spec), true, nil)
+	if res != nil || len(plan.removed) != 0 || !reflect.DeepEqual(plan.specRemoved, []string{"kubernetes"}) {
+		t.Fatalf("expected spec.finalizers to be planned for the finalize subresource, got %+v %+v", plan, res)
+	}
+	plan, res = planFinalizerRemoval(req, finalizerTestObject(true, []string{"example.io/cleanup"}, spec), true, []string{"example.io/cleanup"})
+	if res != nil || !reflect.DeepEqual(plan.removed, []string{"example.io/cleanup"}) || !reflect.DeepEqual(plan.specKept, []string{"kubernetes"}) || len(plan.specRemoved) != 0 {
+		t.Fatalf("selection should only touch the named finalizer, got %+v %+v", plan, res)
+	}
+	if _, res := planFinalizerRemoval(req, finalizerTestObject(true, nil, spec), false, nil); res == nil {
+		t.Fatal("spec.finalizers only count for namespaces")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 )
@@ -21,6 +22,23 @@ func HandleJobDelete(ctx context.Context, c *cluster.Clients, req ActionRequest)
 	)
 }
 
+// HandleJobSuspend suspends the job; its active pods are terminated until it resumes.
+func HandleJobSuspend(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleNamespacedSuspend(ctx, req, "batch", "jobs", "job", true, jobPatch(c))
+}
+
+// HandleJobResume resumes a suspended job.
+func HandleJobResume(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleNamespacedSuspend(ctx, req, "batch", "jobs", "job", false, jobPatch(c))
+}
+
+func jobPatch(c *cluster.Clients) func(ctx context.Context, ns, name string, patch []byte) error {
+	return func(ctx context.Context, ns, name string, patch []byte) error {
+		_, err := c.Clientset.BatchV1().Jobs(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	}
+}
+
 // BuildJobRerun returns a fresh Job from an existing Job's spec.
 func BuildJobRerun(ctx context.Context, c *cluster.Clients, namespace, name, runID string) (*batchv1.Job, error) {
 	source, err := c.Clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
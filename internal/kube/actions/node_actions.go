@@ -2,10 +2,22 @@ package actions
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/runtime"
 )
 
 // HandleNodeDelete deletes a node (cluster-scoped).
@@ -16,3 +28,355 @@ func HandleNodeDelete(ctx context.Context, c *cluster.Clients, req ActionRequest
 		},
 	)
 }
+
+// HandleNodeCordon marks a node unschedulable.
+func HandleNodeCordon(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleNodeSchedulable(ctx, c, req, true)
+}
+
+// HandleNodeUncordon marks a node schedulable again.
+func HandleNodeUncordon(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	return handleNodeSchedulable(ctx, c, req, false)
+}
+
+func handleNodeSchedulable(ctx context.Context, c *cluster.Clients, req ActionRequest, unschedulable bool) (*ActionResult, error) {
+	if err := validateClusterTarget(req, "", "nodes"); err != nil {
+		return &ActionResult{Status: "error", Message: err.Error()}, nil
+	}
+	if err := setNodeUnschedulable(ctx, c, req.Name, unschedulable); err != nil {
+		return nil, err
+	}
+	verb := "Uncordoned"
+	if unschedulable {
+		verb = "Cordoned"
+	}
+	return &ActionResult{
+		Status:  "ok",
+		Message: fmt.Sprintf("%s node %s", verb, req.Name),
+		Details: map[string]any{
+			"name":          req.Name,
+			"unschedulable": unschedulable,
+		},
+	}, nil
+}
+
+func setNodeUnschedulable(ctx context.Context, c *cluster.Clients, name string, unschedulable bool) error {
+	patch, _ := json.Marshal(map[string]any{
+		"spec": map[string]any{"unschedulable": unschedulable},
+	})
+	_, err := c.Clientset.CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+const (
+	defaultNodeDrainTimeout = 10 * time.Minute
+	nodeDrainRetryInterval  = 5 * time.Second
+	nodeDrainActivityTTL    = 10 * time.Minute
+	// maxConcurrentEvictions bounds in-flight evictions per drain; each also polls for
+	// its pod's deletion, so an unbounded fan-out on a dense node floods the API server.
+	maxConcurrentEvictions = 10
+)
+
+// nodeDrainOptions mirrors the kubectl drain flags kview supports.
+type nodeDrainOptions struct {
+	ignoreDaemonSets   bool
+	deleteEmptyDirData bool
+	force              bool
+	gracePeriodSeconds *int64
+	timeout            time.Duration
+}
+
+func parseNodeDrainOptions(params map[string]any) (nodeDrainOptions, *ActionResult) {
+	opts := nodeDrainOptions{timeout: defaultNodeDrainTimeout}
+	var errResult *ActionResult
+	if opts.ignoreDaemonSets, errResult = boolParam(params, "ignoreDaemonSets"); errResult != nil {
+		return opts, errResult
+	}
+	if opts.deleteEmptyDirData, errResult = boolParam(params, "deleteEmptyDirData"); errResult != nil {
+		return opts, errResult
+	}
+	if opts.force, errResult = boolParam(params, "force"); errResult != nil {
+		return opts, errResult
+	}
+	if grace, ok, errResult := nonNegativeIntParam(params, "gracePeriodSeconds"); errResult != nil {
+		return opts, errResult
+	} else if ok {
+		opts.gracePeriodSeconds = &grace
+	}
+	if timeout, ok, errResult := nonNegativeIntParam(params, "timeoutSeconds"); errResult != nil {
+		return opts, errResult
+	} else if ok && timeout > 0 {
+		opts.timeout = time.Duration(timeout) * time.Second
+	}
+	return opts, nil
+}
+
+// drainPlan splits the pods on a node into those to evict and those drain leaves alone,
+// and lists the pods that block the drain under the chosen options.
+type drainPlan struct {
+	evict    []corev1.Pod
+	skipped  []string
+	blockers []string
+}
+
+func planNodeDrain(pods []corev1.Pod, opts nodeDrainOptions) drainPlan {
+	var plan drainPlan
+	for _, pod := range pods {
+		key := pod.Namespace + "/" + pod.Name
+		if _, mirror := pod.Annotations[corev1.MirrorPodAnnotationKey]; mirror {
+			plan.skipped = append(plan.skipped, key+" (mirror pod)")
+			continue
+		}
+		if pod.DeletionTimestamp != nil {
+			plan.skipped = append(plan.skipped, key+" (terminating)")
+			continue
+		}
+		finished := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		ref := metav1.GetControllerOf(&pod)
+		if ref != nil && ref.Kind == "DaemonSet" && !finished {
+			if opts.ignoreDaemonSets {
+				plan.skipped = append(plan.skipped, key+" (DaemonSet)")
+			} else {
+				plan.blockers = append(plan.blockers, key+" is managed by a DaemonSet (set ignoreDaemonSets)")
+			}
+			continue
+		}
+		if ref == nil && !finished && !opts.force {
+			plan.blockers = append(plan.blockers, key+" is not managed by a controller (set force)")
+			continue
+		}
+		if podHasEmptyDir(pod) && !finished && !opts.deleteEmptyDirData {
+			plan.blockers = append(plan.blockers, key+" uses emptyDir data (set deleteEmptyDirData)")
+			continue
+		}
+		plan.evict = append(plan.evict, pod)
+	}
+	return plan
+}
+
+func podHasEmptyDir(pod corev1.Pod) bool {
+	for _, v := range pod.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// NewNodeDrainHandler returns the nodes.drain action handler. The handler validates and
+// cordons synchronously, then evicts pods in the background and reports per-pod progress
+// through a runtime activity so /api/actions returns immediately.
+func NewNodeDrainHandler(reg runtime.ActivityRegistry) ActionHandler {
+	return func(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+		if err := validateClusterTarget(req, "", "nodes"); err != nil {
+			return &ActionResult{Status: "error", Message: err.Error()}, nil
+		}
+		opts, errResult := parseNodeDrainOptions(req.Params)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		list, err := c.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=" + req.Name})
+		if err != nil {
+			return nil, err
+		}
+		plan := planNodeDrain(list.Items, opts)
+		if len(plan.blockers) > 0 {
+			return &ActionResult{
+				Status:  "error",
+				Message: fmt.Sprintf("Cannot drain node %s: %s", req.Name, strings.Join(plan.blockers, "; ")),
+				Details: map[string]any{"name": req.Name, "blockers": plan.blockers},
+			}, nil
+		}
+
+		if err := setNodeUnschedulable(ctx, c, req.Name, true); err != nil {
+			return nil, err
+		}
+
+		d := newNodeDrain(reg, req.Name, plan, opts)
+		go d.run(c)
+
+		return &ActionResult{
+			Status:  "ok",
+			Message: fmt.Sprintf("Cordoned node %s; draining %d pods", req.Name, len(plan.evict)),
+			Details: map[string]any{
+				"name":       req.Name,
+				"activityId": d.activityID,
+				"evicting":   len(plan.evict),
+				"skipped":    plan.skipped,
+			},
+		}, nil
+	}
+}
+
+// nodeDrain tracks one background drain. Pod progress is published as activity metadata
+// keys "pod:<namespace>/<name>".
+type nodeDrain struct {
+	reg        runtime.ActivityRegistry
+	activityID string
+	node       string
+	pods       []corev1.Pod
+	opts       nodeDrainOptions
+
+	mu  sync.Mutex
+	act runtime.Activity
+}
+
+func newNodeDrain(reg runtime.ActivityRegistry, node string, plan drainPlan, opts nodeDrainOptions) *nodeDrain {
+	now := time.Now().UTC()
+	d := &nodeDrain{
+		reg:        reg,
+		activityID: fmt.Sprintf("node-drain-%s-%d", node, now.UnixNano()),
+		node:       node,
+		pods:       plan.evict,
+		opts:       opts,
+	}
+	d.act = runtime.Activity{
+		ID:           d.activityID,
+		Kind:         runtime.ActivityKindWorker,
+		Type:         runtime.ActivityTypeNodeDrain,
+		Title:        fmt.Sprintf("Drain node %s", node),
+		Status:       runtime.ActivityStatusRunning,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		StartedAt:    now,
+		ResourceType: "kubernetes:node",
+		Metadata: map[string]string{
+			"node":    node,
+			"total":   strconv.Itoa(len(plan.evict)),
+			"skipped": strconv.Itoa(len(plan.skipped)),
+			"done":    "0",
+			"failed":  "0",
+			"timeout": opts.timeout.String(),
+		},
+	}
+	for _, pod := range plan.evict {
+		d.act.Metadata["pod:"+pod.Namespace+"/"+pod.Name] = "pending"
+	}
+	if reg != nil {
+		_ = reg.Register(context.Background(), d.act)
+	}
+	return d
+}
+
+func (d *nodeDrain) run(c *cluster.Clients) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.opts.timeout)
+	defer cancel()
+
+	sem := make(chan struct{}, maxConcurrentEvictions)
+	var wg sync.WaitGroup
+	var failed sync.Map
+	for _, pod := range d.pods {
+		wg.Add(1)
+		go func(pod corev1.Pod) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := d.evictAndWait(ctx, c, pod); err != nil {
+				failed.Store(pod.Namespace+"/"+pod.Name, err.Error())
+				d.setPod(pod, "failed: "+err.Error(), false, true)
+				return
+			}
+			d.setPod(pod, "deleted", true, false)
+		}(pod)
+	}
+	wg.Wait()
+
+	var failures []string
+	failed.Range(func(k, _ any) bool {
+		failures = append(failures, k.(string))
+		return true
+	})
+	sort.Strings(failures)
+	d.finish(failures)
+}
+
+// evictAndWait evicts the pod through the eviction API, retrying while a
+// PodDisruptionBudget refuses the disruption, then waits for the pod to go away. A pod
+// selected by several budgets fails at once, since waiting never clears that refusal.
+func (d *nodeDrain) evictAndWait(ctx context.Context, c *cluster.Clients, pod corev1.Pod) error {
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+	}
+	if d.opts.gracePeriodSeconds != nil {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: d.opts.gracePeriodSeconds}
+	}
+	for {
+		d.setPod(pod, "evicting", false, false)
+		err := c.Clientset.PolicyV1().Evictions(pod.Namespace).Evict(ctx, eviction)
+		if err == nil || apierrors.IsNotFound(err) {
+			break
+		}
+		if !isDisruptionBudgetRefusal(err) {
+			return err
+		}
+		blocked := "PodDisruptionBudget"
+		if blockers, lookupErr := podDisruptionBlockers(ctx, c, pod.Namespace, pod.Name); lookupErr == nil && len(blockers) > 0 {
+			blocked = describePDBBlockers(blockers)
+		}
+		if !isRetryableEvictionRefusal(err) {
+			return fmt.Errorf("blocked by %s: %v", blocked, err)
+		}
+		d.setPod(pod, "blocked by "+blocked+", retrying", false, false)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for PodDisruptionBudget to allow eviction")
+		case <-time.After(nodeDrainRetryInterval):
+		}
+	}
+
+	d.setPod(pod, "evicted, waiting for deletion", false, false)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		cur, err := c.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && cur.UID != pod.UID) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for pod deletion")
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *nodeDrain) setPod(pod corev1.Pod, state string, done, failed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.act.Metadata = copyStringMap(d.act.Metadata)
+	d.act.Metadata["pod:"+pod.Namespace+"/"+pod.Name] = state
+	if done {
+		d.act.Metadata["done"] = incrementCount(d.act.Metadata["done"])
+	}
+	if failed {
+		d.act.Metadata["failed"] = incrementCount(d.act.Metadata["failed"])
+	}
+	d.act.UpdatedAt = time.Now().UTC()
+	if d.reg != nil {
+		_ = d.reg.Update(context.Background(), d.act)
+	}
+}
+
+func (d *nodeDrain) finish(failures []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.act.Metadata = copyStringMap(d.act.Metadata)
+	d.act.Status = runtime.ActivityStatusStopped
+	d.act.Metadata["outcome"] = "drained"
+	if len(failures) > 0 {
+		d.act.Status = runtime.ActivityStatusFailed
+		d.act.Metadata["outcome"] = fmt.Sprintf("%d pods not evicted: %s", len(failures), strings.Join(failures, ", "))
+	}
+	d.act.UpdatedAt = time.Now().UTC()
+	if d.reg != nil {
+		_ = d.reg.Update(context.Background(), d.act)
+		runtime.ScheduleActivityTTLRemoval(d.reg, d.activityID, d.act.UpdatedAt, nodeDrainActivityTTL)
+	}
+}
+
+func incrementCount(v string) string {
+	n, _ := strconv.Atoi(v)
+	return strconv.Itoa(n + 1)
+}
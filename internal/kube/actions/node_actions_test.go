@@ -0,0 +1,114 @@
+package actions
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/runtime"
+)
+
+func drainTestPod(name, ownerKind string, emptyDir bool) corev1.Pod {
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: name}}
+	if ownerKind != "" {
+		controller := true
+		pod.OwnerReferences = []metav1.OwnerReference{{Kind: ownerKind, Name: "owner", Controller: &controller}}
+	}
+	if emptyDir {
+		pod.Spec.Volumes = []corev1.Volume{{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+	}
+	pod.Status.Phase = corev1.PodRunning
+	return pod
+}
+
+func TestPlanNodeDrain_BlockersAndSkips(t *testing.T) {
+	mirror := drainTestPod("static", "", false)
+	mirror.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "x"}
+	finished := drainTestPod("done", "", true)
+	finished.Status.Phase = corev1.PodSucceeded
+	pods := []corev1.Pod{
+		drainTestPod("web", "ReplicaSet", false),
+		drainTestPod("agent", "DaemonSet", false),
+		drainTestPod("cache", "StatefulSet", true),
+		drainTestPod("bare", "", false),
+		mirror,
+		finished,
+	}
+
+	plan := planNodeDrain(pods, nodeDrainOptions{})
+	if len(plan.blockers) != 3 {
+		t.Fatalf("blockers: got %v", plan.blockers)
+	}
+
+	plan = planNodeDrain(pods, nodeDrainOptions{ignoreDaemonSets: true, deleteEmptyDirData: true, force: true})
+	if len(plan.blockers) != 0 {
+		t.Fatalf("blockers: got %v", plan.blockers)
+	}
+	var evict []string
+	for _, p := range plan.evict {
+		evict = append(evict, p.Name)
+	}
+	if got := strings.Join(evict, ","); got != "web,cache,bare,done" {
+		t.Fatalf("evict: got %s", got)
+	}
+	if len(plan.skipped) != 2 {
+		t.Fatalf("skipped: got %v", plan.skipped)
+	}
+}
+
+func TestParseNodeDrainOptions(t *testing.T) {
+	opts, result := parseNodeDrainOptions(map[string]any{
+		"ignoreDaemonSets":   true,
+		"gracePeriodSeconds": float64(30),
+		"timeoutSeconds":     float64(120),
+	})
+	if result != nil {
+		t.Fatalf("unexpected result: %#v", result)
+	}
+	if !opts.ignoreDaemonSets || opts.deleteEmptyDirData || opts.gracePeriodSeconds == nil || *opts.gracePeriodSeconds != 30 || opts.timeout != 2*time.Minute {
+		t.Fatalf("options: got %+v", opts)
+	}
+
+	opts, _ = parseNodeDrainOptions(nil)
+	if opts.gracePeriodSeconds != nil || opts.timeout != defaultNodeDrainTimeout {
+		t.Fatalf("defaults: got %+v", opts)
+	}
+
+	if _, result := parseNodeDrainOptions(map[string]any{"timeoutSeconds": float64(1.5)}); result == nil || result.Message != "params.timeoutSeconds must be an integer >= 0" {
+		t.Fatalf("expected validation result, got %#v", result)
+	}
+}
+
+func TestNodeDrain_ReportsProgressToActivity(t *testing.T) {
+	reg := runtime.NewInMemoryActivityRegistry()
+	web := drainTestPod("web", "ReplicaSet", false)
+	api := drainTestPod("api", "ReplicaSet", false)
+	d := newNodeDrain(reg, "node-1", drainPlan{evict: []corev1.Pod{web, api}, skipped: []string{"kube-system/agent (DaemonSet)"}}, nodeDrainOptions{timeout: time.Minute})
+
+	act, ok, _ := reg.Get(context.Background(), d.activityID)
+	if !ok || act.Type != runtime.ActivityTypeNodeDrain || act.Status != runtime.ActivityStatusRunning {
+		t.Fatalf("activity: got %+v (found=%v)", act, ok)
+	}
+	if act.Metadata["total"] != "2" || act.Metadata["skipped"] != "1" || act.Metadata["pod:app/web"] != "pending" {
+		t.Fatalf("initial metadata: got %v", act.Metadata)
+	}
+
+	d.setPod(web, "deleted", true, false)
+	d.setPod(api, "failed: boom", false, true)
+	d.finish([]string{"app/api"})
+
+	act, _, _ = reg.Get(context.Background(), d.activityID)
+	if act.Status != runtime.ActivityStatusFailed {
+		t.Fatalf("status: got %q", act.Status)
+	}
+	if act.Metadata["done"] != "1" || act.Metadata["failed"] != "1" || act.Metadata["pod:app/web"] != "deleted" {
+		t.Fatalf("final metadata: got %v", act.Metadata)
+	}
+	if !strings.Contains(act.Metadata["outcome"], "app/api") {
+		t.Fatalf("outcome: got %q", act.Metadata["outcome"])
+	}
+}
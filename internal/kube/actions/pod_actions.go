@@ -2,8 +2,15 @@ package actions
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 )
@@ -16,3 +23,127 @@ func HandlePodDelete(ctx context.Context, c *cluster.Clients, req ActionRequest)
 		},
 	)
 }
+
+// HandlePodEvict evicts a pod through the policy/v1 Eviction subresource, so unlike
+// pod.delete it honors PodDisruptionBudgets. A refused eviction returns an error result
+// naming the blocking budgets.
+func HandlePodEvict(ctx context.Context, c *cluster.Clients, req ActionRequest) (*ActionResult, error) {
+	if err := validateNamespacedTarget(req, "", "pods"); err != nil {
+		return &ActionResult{Status: "error", Message: err.Error()}, nil
+	}
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: req.Name, Namespace: req.Namespace},
+	}
+	if grace, ok, errResult := nonNegativeIntParam(req.Params, "gracePeriodSeconds"); errResult != nil {
+		return errResult, nil
+	} else if ok {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: &grace}
+	}
+
+	err := c.Clientset.PolicyV1().Evictions(req.Namespace).Evict(ctx, eviction)
+	if err == nil {
+		return &ActionResult{
+			Status:  "ok",
+			Message: fmt.Sprintf("Evicted pod %s/%s", req.Namespace, req.Name),
+			Details: map[string]any{
+				"namespace": req.Namespace,
+				"name":      req.Name,
+			},
+		}, nil
+	}
+	if !isDisruptionBudgetRefusal(err) {
+		return nil, err
+	}
+
+	blockers, lookupErr := podDisruptionBlockers(ctx, c, req.Namespace, req.Name)
+	details := map[string]any{
+		"namespace":    req.Namespace,
+		"name":         req.Name,
+		"reason":       "PodDisruptionBudget",
+		"apiMessage":   err.Error(),
+		"blockingPDBs": blockers,
+	}
+	message := fmt.Sprintf("Eviction of pod %s/%s refused: %s", req.Namespace, req.Name, err.Error())
+	if lookupErr != nil {
+		details["lookupError"] = lookupErr.Error()
+	} else if len(blockers) > 0 {
+		message = fmt.Sprintf("Eviction of pod %s/%s blocked by %s", req.Namespace, req.Name, describePDBBlockers(blockers))
+	}
+	return &ActionResult{Status: "error", Message: message, Details: details}, nil
+}
+
+// isDisruptionBudgetRefusal reports whether an Evict error came from PDB enforcement:
+// 429 when no disruption is allowed, or 500 when several budgets select the pod.
+func isDisruptionBudgetRefusal(err error) bool {
+	if apierrors.IsTooManyRequests(err) {
+		return true
+	}
+	return apierrors.IsInternalError(err) && strings.Contains(err.Error(), "PodDisruptionBudget")
+}
+
+// isRetryableEvictionRefusal reports whether a refused eviction can succeed later: a 429
+// clears once the budget allows a disruption, the multiple-budget 500 never does.
+func isRetryableEvictionRefusal(err error) bool {
+	return apierrors.IsTooManyRequests(err)
+}
+
+// PDBBlocker describes a PodDisruptionBudget that prevents evicting a pod.
+type PDBBlocker struct {
+	Name               string `json:"name"`
+	DisruptionsAllowed int32  `json:"disruptionsAllowed"`
+	CurrentHealthy     int32  `json:"currentHealthy"`
+	DesiredHealthy     int32  `json:"desiredHealthy"`
+	ExpectedPods       int32  `json:"expectedPods"`
+}
+
+func podDisruptionBlockers(ctx context.Context, c *cluster.Clients, namespace, name string) ([]PDBBlocker, error) {
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.Clientset.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return blockingPDBs(pod, list.Items), nil
+}
+
+// blockingPDBs returns the budgets selecting pod that refuse a disruption. Eviction also
+// fails when more than one budget selects the pod, so in that case all of them block.
+func blockingPDBs(pod *corev1.Pod, pdbs []policyv1.PodDisruptionBudget) []PDBBlocker {
+	var matching []policyv1.PodDisruptionBudget
+	for _, pdb := range pdbs {
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || !sel.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		matching = append(matching, pdb)
+	}
+	out := []PDBBlocker{}
+	for _, pdb := range matching {
+		if len(matching) == 1 && pdb.Status.DisruptionsAllowed > 0 {
+			continue
+		}
+		out = append(out, PDBBlocker{
+			Name:               pdb.Name,
+			DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+			CurrentHealthy:     pdb.Status.CurrentHealthy,
+			DesiredHealthy:     pdb.Status.DesiredHealthy,
+			ExpectedPods:       pdb.Status.ExpectedPods,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func describePDBBlockers(blockers []PDBBlocker) string {
+	parts := make([]string, 0, len(blockers))
+	for _, b := range blockers {
+		parts = append(parts, fmt.Sprintf("PodDisruptionBudget %s (%d/%d healthy, %d disruptions allowed)",
+			b.Name, b.CurrentHealthy, b.DesiredHealthy, b.DisruptionsAllowed))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,64 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testPDB(name string, matchLabels map[string]string, allowed int32) policyv1.PodDisruptionBudget {
+	return policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: matchLabels}},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed, CurrentHealthy: 2, DesiredHealthy: 2, ExpectedPods: 2},
+	}
+}
+
+func TestBlockingPDBs(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Labels: map[string]string{"app": "web"}}}
+
+	got := blockingPDBs(pod, []policyv1.PodDisruptionBudget{
+		testPDB("web-pdb", map[string]string{"app": "web"}, 0),
+		testPDB("db-pdb", map[string]string{"app": "db"}, 0),
+		{ObjectMeta: metav1.ObjectMeta{Name: "no-selector"}},
+	})
+	if len(got) != 1 || got[0].Name != "web-pdb" || got[0].DisruptionsAllowed != 0 {
+		t.Fatalf("single blocker: got %+v", got)
+	}
+	if msg := describePDBBlockers(got); !strings.Contains(msg, "PodDisruptionBudget web-pdb (2/2 healthy, 0 disruptions allowed)") {
+		t.Fatalf("description: got %q", msg)
+	}
+
+	if got := blockingPDBs(pod, []policyv1.PodDisruptionBudget{testPDB("web-pdb", map[string]string{"app": "web"}, 1)}); len(got) != 0 {
+		t.Fatalf("budget allows disruption: got %+v", got)
+	}
+
+	got = blockingPDBs(pod, []policyv1.PodDisruptionBudget{
+		testPDB("web-b", map[string]string{"app": "web"}, 1),
+		testPDB("web-a", map[string]string{}, 1),
+	})
+	if len(got) != 2 || got[0].Name != "web-a" || got[1].Name != "web-b" {
+		t.Fatalf("overlapping budgets should all block: got %+v", got)
+	}
+}
+
+func TestEvictionRefusalClassification(t *testing.T) {
+	budget := apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+	multiple := apierrors.NewInternalError(errors.New("This pod has more than one PodDisruptionBudget, which the eviction subresource does not support."))
+	other := apierrors.NewInternalError(errors.New("etcdserver: request timed out"))
+
+	if !isDisruptionBudgetRefusal(budget) || !isRetryableEvictionRefusal(budget) {
+		t.Fatal("a 429 budget refusal should be retried")
+	}
+	if !isDisruptionBudgetRefusal(multiple) || isRetryableEvictionRefusal(multiple) {
+		t.Fatal("the multiple-budget refusal is a PDB refusal that must not be retried")
+	}
+	if isDisruptionBudgetRefusal(other) {
+		t.Fatal("unrelated internal errors are not PDB refusals")
+	}
+}
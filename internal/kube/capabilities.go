@@ -9,11 +9,13 @@ import (
 )
 
 // CapabilitiesRequest specifies the resource to check capabilities for.
+// Subresource narrows the check, e.g. "ephemeralcontainers" or "attach" on pods.
 type CapabilitiesRequest struct {
-	Group     string
-	Resource  string
-	Namespace string
-	Name      string
+	Group       string
+	Resource    string
+	Subresource string
+	Namespace   string
+	Name        string
 }
 
 // CapabilitiesResult reports which mutation verbs are allowed.
@@ -44,11 +46,12 @@ func CheckCapabilities(ctx context.Context, c *cluster.Clients, req Capabilities
 			}
 
 			res, err := SelfSubjectAccessReview(ctx, c, AccessReviewRequest{
-				Verb:      v,
-				Resource:  req.Resource,
-				Group:     req.Group,
-				Namespace: ns,
-				Name:      req.Name,
+				Verb:        v,
+				Resource:    req.Resource,
+				Subresource: req.Subresource,
+				Group:       req.Group,
+				Namespace:   ns,
+				Name:        req.Name,
 			})
 			if err != nil {
 				mu.Lock()
@@ -0,0 +1,152 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultDebugImage is used for ephemeral debug containers when the request names no image.
+const DefaultDebugImage = "busybox:1.36"
+
+const debugContainerPrefix = "kview-debug-"
+
+// DebugContainerRequest describes an ephemeral debug container to add to a running pod.
+// TargetContainer, when set, shares that container's process namespace so its processes
+// and filesystem (via /proc/<pid>/root) are visible from the debug shell.
+type DebugContainerRequest struct {
+	Namespace       string `json:"namespace"`
+	Pod             string `json:"pod"`
+	Image           string `json:"image"`
+	TargetContainer string `json:"targetContainer"`
+}
+
+// DebugContainerClient adds ephemeral debug containers through the pods/ephemeralcontainers
+// subresource, as kubectl debug does.
+type DebugContainerClient struct {
+	Clientset kubernetes.Interface
+}
+
+// Add appends an interactive ephemeral container to the pod and returns its name. The
+// container runs the image's default command with stdin and a TTY so it can be attached.
+func (c DebugContainerClient) Add(ctx context.Context, req DebugContainerRequest) (string, error) {
+	ns := strings.TrimSpace(req.Namespace)
+	podName := strings.TrimSpace(req.Pod)
+	if ns == "" || podName == "" {
+		return "", fmt.Errorf("namespace and pod are required")
+	}
+	if c.Clientset == nil {
+		return "", fmt.Errorf("kubernetes client is not configured")
+	}
+
+	pod, err := c.Clientset.CoreV1().Pods(ns).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	target := strings.TrimSpace(req.TargetContainer)
+	if target != "" && !podHasContainer(pod, target) {
+		return "", fmt.Errorf("container %q not found in pod %s/%s", target, ns, podName)
+	}
+	image := strings.TrimSpace(req.Image)
+	if image == "" {
+		image = DefaultDebugImage
+	}
+
+	name := debugContainerName(pod)
+	pod = pod.DeepCopy()
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    image,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: target,
+	})
+	if _, err := c.Clientset.CoreV1().Pods(ns).UpdateEphemeralContainers(ctx, podName, pod, metav1.UpdateOptions{}); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// WaitRunning polls until the named ephemeral container is running. It fails fast when the
+// container terminated or is stuck pulling its image.
+func (c DebugContainerClient) WaitRunning(ctx context.Context, namespace, pod, name string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		p, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, pod, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		done, err := ephemeralContainerReady(p, name)
+		if done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for debug container %s to start", name)
+		case <-ticker.C:
+		}
+	}
+}
+
+func ephemeralContainerReady(pod *corev1.Pod, name string) (bool, error) {
+	for _, st := range pod.Status.EphemeralContainerStatuses {
+		if st.Name != name {
+			continue
+		}
+		switch {
+		case st.State.Running != nil:
+			return true, nil
+		case st.State.Terminated != nil:
+			return false, fmt.Errorf("debug container %s exited: %s", name, st.State.Terminated.Reason)
+		case st.State.Waiting != nil:
+			switch st.State.Waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerError":
+				msg := st.State.Waiting.Reason
+				if st.State.Waiting.Message != "" {
+					msg += ": " + st.State.Waiting.Message
+				}
+				return false, fmt.Errorf("debug container %s cannot start: %s", name, msg)
+			}
+		}
+	}
+	return false, nil
+}
+
+func podHasContainer(pod *corev1.Pod, name string) bool {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func debugContainerName(pod *corev1.Pod) string {
+	used := map[string]bool{}
+	for _, c := range pod.Spec.Containers {
+		used[c.Name] = true
+	}
+	for _, c := range pod.Spec.InitContainers {
+		used[c.Name] = true
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		used[c.Name] = true
+	}
+	for {
+		name := debugContainerPrefix + utilrand.String(5)
+		if !used[name] {
+			return name
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package kube
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestEphemeralContainerReady(t *testing.T) {
+	status := func(state corev1.ContainerState) *corev1.Pod {
+		return &corev1.Pod{Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{
+			{Name: "other", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			{Name: "kview-debug-abcde", State: state},
+		}}}
+	}
+
+	if done, err := ephemeralContainerReady(&corev1.Pod{}, "kview-debug-abcde"); done || err != nil {
+		t.Fatalf("no status yet: got %v, %v", done, err)
+	}
+	if done, err := ephemeralContainerReady(status(corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}), "kview-debug-abcde"); done || err != nil {
+		t.Fatalf("creating: got %v, %v", done, err)
+	}
+	if done, err := ephemeralContainerReady(status(corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}), "kview-debug-abcde"); !done || err != nil {
+		t.Fatalf("running: got %v, %v", done, err)
+	}
+	_, err := ephemeralContainerReady(status(corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "not found"}}), "kview-debug-abcde")
+	if err == nil || !strings.Contains(err.Error(), "ImagePullBackOff: not found") {
+		t.Fatalf("image pull: got %v", err)
+	}
+	if _, err := ephemeralContainerReady(status(corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error"}}), "kview-debug-abcde"); err == nil {
+		t.Fatal("expected error for terminated container")
+	}
+}
+
+func TestDebugContainerNameIsUnique(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		Containers:          []corev1.Container{{Name: "app"}},
+		EphemeralContainers: []corev1.EphemeralContainer{{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "kview-debug-aaaaa"}}},
+	}}
+	name := debugContainerName(pod)
+	if !strings.HasPrefix(name, debugContainerPrefix) || len(name) != len(debugContainerPrefix)+5 || name == "kview-debug-aaaaa" {
+		t.Fatalf("unexpected name %q", name)
+	}
+	if !podHasContainer(pod, "app") || podHasContainer(pod, "kview-debug-aaaaa") {
+		t.Fatal("podHasContainer should only match regular containers")
+	}
+}
@@ -0,0 +1,16 @@
+package kube
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// DeletionState returns the finalizers and deletion timestamp of obj.
+func DeletionState(obj metav1.Object) dto.DeletionStateDTO {
+	out := dto.DeletionStateDTO{Finalizers: obj.GetFinalizers()}
+	if ts := obj.GetDeletionTimestamp(); ts != nil {
+		out.DeletionTimestamp = ts.Unix()
+	}
+	return out
+}
@@ -0,0 +1,27 @@
+package dto
+
+// APIResourceDTO is one discovered API resource. The core group is reported as "".
+type APIResourceDTO struct {
+	Group      string   `json:"group"`
+	Version    string   `json:"version"`
+	Resource   string   `json:"resource"`
+	Kind       string   `json:"kind"`
+	Namespaced bool     `json:"namespaced"`
+	Verbs      []string `json:"verbs"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+// GenericResourceListItemDTO is a list row for any API resource, read through the
+// dynamic client. DeletionTimestamp is set while the object is terminating.
+type GenericResourceListItemDTO struct {
+	Name              string             `json:"name"`
+	Namespace         string             `json:"namespace,omitempty"`
+	Kind              string             `json:"kind"`
+	AgeSec            int64              `json:"ageSec"`
+	CreatedAt         int64              `json:"createdAt"`
+	Labels            map[string]string  `json:"labels,omitempty"`
+	Owner             *OwnerReferenceDTO `json:"owner,omitempty"`
+	Finalizers        int                `json:"finalizers,omitempty"`
+	DeletionTimestamp int64              `json:"deletionTimestamp,omitempty"`
+}
@@ -9,12 +9,19 @@ type ClusterRoleListItemDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// Rules, Labels and AggregationSelectors let reverse RBAC lookups run over the
+	// snapshot. AggregationSelectors are label selector strings of the aggregationRule.
+	Rules                []PolicyRuleDTO   `json:"rules,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	AggregationSelectors []string          `json:"aggregationSelectors,omitempty"`
 }
 
 type ClusterRoleDetailsDTO struct {
-	Summary ClusterRoleSummaryDTO `json:"summary"`
-	Rules   []PolicyRuleDTO       `json:"rules"`
-	YAML    string                `json:"yaml"`
+	Summary  ClusterRoleSummaryDTO `json:"summary"`
+	Rules    []PolicyRuleDTO       `json:"rules"`
+	Deletion DeletionStateDTO      `json:"deletion"`
+	YAML     string                `json:"yaml"`
 }
 
 type ClusterRoleSummaryDTO struct {
@@ -12,11 +12,15 @@ type ClusterRoleBindingListItemDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// Subjects let reverse RBAC lookups resolve who the binding grants.
+	Subjects []SubjectDTO `json:"subjects,omitempty"`
 }
 
 type ClusterRoleBindingDetailsDTO struct {
 	Summary  BindingSummaryDTO `json:"summary"`
 	RoleRef  RoleRefDTO        `json:"roleRef"`
 	Subjects []SubjectDTO      `json:"subjects"`
+	Deletion DeletionStateDTO  `json:"deletion"`
 	YAML     string            `json:"yaml"`
 }
@@ -19,6 +19,7 @@ type ConfigMapDetailsDTO struct {
 	KeyNames []string             `json:"keyNames"`
 	Data     map[string]string    `json:"data,omitempty"`
 	Metadata ConfigMapMetadataDTO `json:"metadata"`
+	Deletion DeletionStateDTO     `json:"deletion"`
 	YAML     string               `json:"yaml"`
 }
 
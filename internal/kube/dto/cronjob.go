@@ -15,16 +15,36 @@ type CronJobDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// References are the ConfigMaps, Secrets, PVCs and ServiceAccount the pod template
+	// uses; they feed the dataplane reverse-reference index and are left out of API responses.
+	References []ResourceReferenceDTO `json:"-"`
 }
 
 type CronJobDetailsDTO struct {
-	Summary       CronJobSummaryDTO  `json:"summary"`
-	Policy        CronJobPolicyDTO   `json:"policy"`
-	AllJobs       []CronJobJobDTO    `json:"allJobs,omitempty"`
-	JobsForbidden bool               `json:"jobsForbidden,omitempty"`
-	Spec          CronJobSpecDTO     `json:"spec"`
-	Metadata      CronJobMetadataDTO `json:"metadata"`
-	YAML          string             `json:"yaml"`
+	Summary         CronJobSummaryDTO         `json:"summary"`
+	Policy          CronJobPolicyDTO          `json:"policy"`
+	SchedulePreview CronJobSchedulePreviewDTO `json:"schedulePreview"`
+	AllJobs         []CronJobJobDTO           `json:"allJobs,omitempty"`
+	JobsForbidden   bool                      `json:"jobsForbidden,omitempty"`
+	Spec            CronJobSpecDTO            `json:"spec"`
+	Metadata        CronJobMetadataDTO        `json:"metadata"`
+	Deletion        DeletionStateDTO          `json:"deletion"`
+	YAML            string                    `json:"yaml"`
+}
+
+// CronJobSchedulePreviewDTO lists upcoming run times (unix seconds) in the CronJob's time
+// zone and the scheduled runs that did not happen since the last schedule time. MissedRuns
+// holds the most recent missed times; MissedCapped is set when counting stopped at 100.
+// Error is set when the schedule or time zone cannot be evaluated.
+type CronJobSchedulePreviewDTO struct {
+	TimeZone                string  `json:"timeZone"`
+	NextRuns                []int64 `json:"nextRuns,omitempty"`
+	MissedSinceLastSchedule int     `json:"missedSinceLastSchedule"`
+	MissedCapped            bool    `json:"missedCapped,omitempty"`
+	LastMissedRun           int64   `json:"lastMissedRun,omitempty"`
+	MissedRuns              []int64 `json:"missedRuns,omitempty"`
+	Error                   string  `json:"error,omitempty"`
 }
 
 type CronJobSummaryDTO struct {
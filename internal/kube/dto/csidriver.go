@@ -0,0 +1,24 @@
+package dto
+
+type CSIDriverDTO struct {
+	Name                 string   `json:"name"`
+	AttachRequired       bool     `json:"attachRequired"`
+	PodInfoOnMount       bool     `json:"podInfoOnMount,omitempty"`
+	StorageCapacity      bool     `json:"storageCapacity,omitempty"`
+	RequiresRepublish    bool     `json:"requiresRepublish,omitempty"`
+	FSGroupPolicy        string   `json:"fsGroupPolicy,omitempty"`
+	VolumeLifecycleModes []string `json:"volumeLifecycleModes,omitempty"`
+	AgeSec               int64    `json:"ageSec"`
+}
+
+type CSIDriverDetailsDTO struct {
+	Summary  CSIDriverDTO         `json:"summary"`
+	Metadata CSIDriverMetadataDTO `json:"metadata"`
+	Deletion DeletionStateDTO     `json:"deletion"`
+	YAML     string               `json:"yaml"`
+}
+
+type CSIDriverMetadataDTO struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
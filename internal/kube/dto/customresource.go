@@ -26,6 +26,7 @@ type CustomResourceAggregationMeta struct {
 type CustomResourceDetailsDTO struct {
 	Summary    CustomResourceSummaryDTO `json:"summary"`
 	Conditions []CRDConditionDTO        `json:"conditions,omitempty"`
+	Deletion   DeletionStateDTO         `json:"deletion"`
 	YAML       string                   `json:"yaml"`
 }
 
@@ -25,6 +25,7 @@ type CRDDetailsDTO struct {
 	Versions   []CRDVersionDTO   `json:"versions"`
 	Conditions []CRDConditionDTO `json:"conditions"`
 	Metadata   CRDMetadataDTO    `json:"metadata"`
+	Deletion   DeletionStateDTO  `json:"deletion"`
 	YAML       string            `json:"yaml"`
 }
 
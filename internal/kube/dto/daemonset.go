@@ -16,6 +16,10 @@ type DaemonSetDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// References are the ConfigMaps, Secrets, PVCs and ServiceAccount the pod template
+	// uses; they feed the dataplane reverse-reference index and are left out of API responses.
+	References []ResourceReferenceDTO `json:"-"`
 }
 
 type DaemonSetDetailsDTO struct {
@@ -24,6 +28,7 @@ type DaemonSetDetailsDTO struct {
 	Pods       []DaemonSetPodDTO       `json:"pods"`
 	Spec       DaemonSetSpecDTO        `json:"spec"`
 	Metadata   DaemonSetMetadataDTO    `json:"metadata"`
+	Deletion   DeletionStateDTO        `json:"deletion"`
 	YAML       string                  `json:"yaml"`
 }
 
@@ -11,6 +11,13 @@ type DeploymentListItemDTO struct {
 	LastRolloutComplete int64          `json:"lastRolloutComplete,omitempty"`
 	LastEvent           *EventBriefDTO `json:"lastEvent,omitempty"`
 	Status              string         `json:"status"`
+	// Replicas and Selector let signal detectors relate the deployment to pods and
+	// PodDisruptionBudgets from snapshots alone.
+	Replicas int32  `json:"replicas"`
+	Selector string `json:"selector,omitempty"`
+	// References are the ConfigMaps, Secrets, PVCs and ServiceAccount the pod template
+	// uses; they feed the dataplane reverse-reference index and are left out of API responses.
+	References []ResourceReferenceDTO `json:"-"`
 	// List enrichment (Stage 5C): derived from snapshot row only.
 	HealthBucket          string `json:"healthBucket,omitempty"` // healthy | progressing | degraded | unknown
 	RolloutNeedsAttention bool   `json:"rolloutNeedsAttention,omitempty"`
@@ -26,6 +33,7 @@ type DeploymentDetailsDTO struct {
 	ReplicaSets []DeploymentReplicaSetDTO `json:"replicaSets"`
 	Pods        []DeploymentPodDTO        `json:"pods"`
 	Spec        DeploymentSpecDTO         `json:"spec"`
+	Deletion    DeletionStateDTO          `json:"deletion"`
 	YAML        string                    `json:"yaml"`
 }
 
@@ -120,3 +128,26 @@ type DeploymentMetadataDTO struct {
 	Labels      map[string]string `json:"labels,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
+
+// DeploymentRevisionDTO is one rollout revision, backed by an owned ReplicaSet. Changes
+// diff its pod template against the previous revision (empty for the oldest one).
+type DeploymentRevisionDTO struct {
+	Revision    int32                         `json:"revision"`
+	ReplicaSet  string                        `json:"replicaSet"`
+	CreatedAt   int64                         `json:"createdAt,omitempty"`
+	ChangeCause string                        `json:"changeCause,omitempty"`
+	Current     bool                          `json:"current"`
+	Containers  []ContainerSummaryDTO         `json:"containers,omitempty"`
+	Changes     []DeploymentTemplateChangeDTO `json:"changes,omitempty"`
+}
+
+// DeploymentTemplateChangeDTO is one pod template difference between two revisions.
+// Field is image, env, container, label, or annotation; Change is added, removed, or changed.
+type DeploymentTemplateChangeDTO struct {
+	Field     string `json:"field"`
+	Change    string `json:"change"`
+	Container string `json:"container,omitempty"`
+	Key       string `json:"key,omitempty"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+}
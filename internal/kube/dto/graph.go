@@ -0,0 +1,39 @@
+package dto
+
+// NamespaceGraphDTO is the resource topology of a namespace, built from cached
+// snapshots.
+type NamespaceGraphDTO struct {
+	Namespace string         `json:"namespace"`
+	Nodes     []GraphNodeDTO `json:"nodes"`
+	Edges     []GraphEdgeDTO `json:"edges"`
+	// Unavailable lists the snapshot kinds that could not be read; their objects and
+	// edges are absent from the graph.
+	Unavailable []string `json:"unavailable,omitempty"`
+}
+
+// GraphNodeDTO is one object in the graph. ID is "Kind/name"; Namespace is empty for
+// cluster-scoped objects such as ClusterRoles. SignalSeverity is the worst dashboard
+// signal on the object (high | medium | low | ok).
+type GraphNodeDTO struct {
+	ID             string `json:"id"`
+	Kind           string `json:"kind"`
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace,omitempty"`
+	SignalSeverity string `json:"signalSeverity"`
+	SignalCount    int    `json:"signalCount,omitempty"`
+	// Missing marks an object that is referenced but absent from its snapshot, such as
+	// a Secret mounted by a pod that does not exist.
+	Missing bool `json:"missing,omitempty"`
+	// External marks an object outside the namespace snapshots, such as a ClusterRole.
+	External bool `json:"external,omitempty"`
+}
+
+// GraphEdgeDTO links two nodes by ID. Type is owns, selects, scales, routes,
+// references, boundBy or roleRef; Detail narrows it down, e.g. "env" or
+// "volume/config" for references.
+type GraphEdgeDTO struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Type   string `json:"type"`
+	Detail string `json:"detail,omitempty"`
+}
@@ -28,6 +28,7 @@ type HorizontalPodAutoscalerDetailsDTO struct {
 	Metrics    []HPAMetricDTO             `json:"metrics,omitempty"`
 	Conditions []HPAConditionDTO          `json:"conditions,omitempty"`
 	Metadata   HPAMetadataDTO             `json:"metadata"`
+	Deletion   DeletionStateDTO           `json:"deletion"`
 	YAML       string                     `json:"yaml"`
 }
 
@@ -15,6 +15,11 @@ type IngressListItemDTO struct {
 	ListStatus          string   `json:"listStatus,omitempty"`
 	ListSignalSeverity  string   `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount     int      `json:"listSignalCount,omitempty"`
+
+	// References are the TLS Secrets of the Ingress; in-process only, left out of API responses.
+	References []ResourceReferenceDTO `json:"-"`
+	// BackendServices are the Services of the default backend and every rule path.
+	BackendServices []string `json:"backendServices,omitempty"`
 }
 
 type IngressDetailsDTO struct {
@@ -23,6 +28,7 @@ type IngressDetailsDTO struct {
 	TLS            []IngressTLSDTO    `json:"tls"`
 	DefaultBackend *IngressBackendDTO `json:"defaultBackend,omitempty"`
 	Warnings       IngressWarningsDTO `json:"warnings"`
+	Deletion       DeletionStateDTO   `json:"deletion"`
 	YAML           string             `json:"yaml"`
 }
 
@@ -14,6 +14,12 @@ type JobDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// References are the ConfigMaps, Secrets, PVCs and ServiceAccount the pod template
+	// uses; they feed the dataplane reverse-reference index and are left out of API responses.
+	References []ResourceReferenceDTO `json:"-"`
+	// Owner is the controlling owner, usually the CronJob that created the Job.
+	Owner *OwnerReferenceDTO `json:"owner,omitempty"`
 }
 
 type JobDetailsDTO struct {
@@ -24,6 +30,7 @@ type JobDetailsDTO struct {
 	Spec       JobSpecDTO        `json:"spec"`
 	Metadata   JobMetadataDTO    `json:"metadata"`
 	Selector   string            `json:"selector,omitempty"`
+	Deletion   DeletionStateDTO  `json:"deletion"`
 	YAML       string            `json:"yaml"`
 }
 
@@ -5,6 +5,8 @@ type NamespaceListItemDTO struct {
 	Phase                  string `json:"phase"`
 	AgeSec                 int64  `json:"ageSec"`
 	HasUnhealthyConditions bool   `json:"hasUnhealthyConditions"`
+	// Labels let NetworkPolicy namespaceSelector peers be evaluated from the snapshot.
+	Labels map[string]string `json:"labels,omitempty"`
 	// Row projection (namespaces list, Stage 5C): compact metrics from dataplane pods+deployments
 	// snapshots per namespace. When RowEnriched is false, counts/signals below are unset (zero/false).
 	RowEnriched        bool    `json:"rowEnriched,omitempty"`
@@ -42,9 +44,50 @@ type NamespaceDetailsDTO struct {
 	Summary    NamespaceSummaryDTO     `json:"summary"`
 	Metadata   NamespaceMetadataDTO    `json:"metadata"`
 	Conditions []NamespaceConditionDTO `json:"conditions"`
+	Deletion   DeletionStateDTO        `json:"deletion"`
 	YAML       string                  `json:"yaml"`
 }
 
+// NamespaceRemainingResourcesDTO lists the namespaced objects still present in a
+// namespace, found through discovery across every listable API resource. It explains
+// what a Terminating namespace is waiting for.
+type NamespaceRemainingResourcesDTO struct {
+	Namespace         string                          `json:"namespace"`
+	Phase             string                          `json:"phase"`
+	Terminating       bool                            `json:"terminating"`
+	DeletionTimestamp int64                           `json:"deletionTimestamp,omitempty"`
+	SpecFinalizers    []string                        `json:"specFinalizers,omitempty"`
+	Conditions        []NamespaceConditionDTO         `json:"conditions,omitempty"`
+	Resources         []NamespaceRemainingResourceDTO `json:"resources"`
+	// Errors holds per-resource list failures (forbidden, unavailable API groups) so a
+	// partial result is not mistaken for a complete one.
+	Errors []NamespaceRemainingErrorDTO `json:"errors,omitempty"`
+}
+
+// NamespaceRemainingResourceDTO is one API resource with objects left in the namespace.
+// Truncated is set when Count exceeds the listed Items.
+type NamespaceRemainingResourceDTO struct {
+	Group     string                      `json:"group,omitempty"`
+	Version   string                      `json:"version"`
+	Resource  string                      `json:"resource"`
+	Kind      string                      `json:"kind"`
+	Count     int                         `json:"count"`
+	Truncated bool                        `json:"truncated,omitempty"`
+	Items     []NamespaceRemainingItemDTO `json:"items"`
+}
+
+type NamespaceRemainingItemDTO struct {
+	Name              string   `json:"name"`
+	Finalizers        []string `json:"finalizers,omitempty"`
+	DeletionTimestamp int64    `json:"deletionTimestamp,omitempty"`
+}
+
+type NamespaceRemainingErrorDTO struct {
+	Group    string `json:"group,omitempty"`
+	Resource string `json:"resource,omitempty"`
+	Error    string `json:"error"`
+}
+
 type NamespaceInsightsDTO struct {
 	Summary         NamespaceSummaryResourcesDTO  `json:"summary"`
 	Signals         []NamespaceInsightSignalDTO   `json:"signals,omitempty"`
@@ -0,0 +1,83 @@
+package dto
+
+type NetworkPolicyDTO struct {
+	Name        string           `json:"name"`
+	Namespace   string           `json:"namespace"`
+	PodSelector LabelSelectorDTO `json:"podSelector"`
+	// PolicyTypes is normalized the way the API server defaults it: Ingress is always
+	// present unless only Egress is listed, Egress when egress rules exist.
+	PolicyTypes  []string               `json:"policyTypes"`
+	IngressRules []NetworkPolicyRuleDTO `json:"ingressRules,omitempty"`
+	EgressRules  []NetworkPolicyRuleDTO `json:"egressRules,omitempty"`
+	AgeSec       int64                  `json:"ageSec"`
+}
+
+// NetworkPolicyRuleDTO is one ingress or egress rule. Empty Peers allows every peer and
+// empty Ports allows every port.
+type NetworkPolicyRuleDTO struct {
+	Peers []NetworkPolicyPeerDTO `json:"peers,omitempty"`
+	Ports []NetworkPolicyPortDTO `json:"ports,omitempty"`
+}
+
+type NetworkPolicyPeerDTO struct {
+	PodSelector       *LabelSelectorDTO        `json:"podSelector,omitempty"`
+	NamespaceSelector *LabelSelectorDTO        `json:"namespaceSelector,omitempty"`
+	IPBlock           *NetworkPolicyIPBlockDTO `json:"ipBlock,omitempty"`
+}
+
+type NetworkPolicyIPBlockDTO struct {
+	CIDR   string   `json:"cidr"`
+	Except []string `json:"except,omitempty"`
+}
+
+// NetworkPolicyPortDTO holds a numeric or named port. An empty Port matches every port
+// of the protocol; EndPort makes Port the start of a range.
+type NetworkPolicyPortDTO struct {
+	Protocol string `json:"protocol,omitempty"`
+	Port     string `json:"port,omitempty"`
+	EndPort  int32  `json:"endPort,omitempty"`
+}
+
+type NetworkPolicyDetailsDTO struct {
+	Summary  NetworkPolicyDTO         `json:"summary"`
+	Metadata NetworkPolicyMetadataDTO `json:"metadata"`
+	Deletion DeletionStateDTO         `json:"deletion"`
+	YAML     string                   `json:"yaml"`
+}
+
+type NetworkPolicyMetadataDTO struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// NetworkReachabilityDTO is the verdict for traffic from one pod to another on a port.
+// Traffic is allowed only when both the source's egress and the destination's ingress
+// allow it.
+type NetworkReachabilityDTO struct {
+	Source      NetworkEndpointDTO              `json:"source"`
+	Destination NetworkEndpointDTO              `json:"destination"`
+	Port        string                          `json:"port"`
+	Protocol    string                          `json:"protocol"`
+	Allowed     bool                            `json:"allowed"`
+	Egress      NetworkReachabilityDirectionDTO `json:"egress"`
+	Ingress     NetworkReachabilityDirectionDTO `json:"ingress"`
+	Notes       []string                        `json:"notes,omitempty"`
+}
+
+type NetworkEndpointDTO struct {
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	IP        string `json:"ip,omitempty"`
+}
+
+// NetworkReachabilityDirectionDTO explains one side of the check. When the pod is not
+// Isolated no policy applies and traffic is allowed. Otherwise AllowingPolicies lists
+// the policies with a matching rule; when it is empty, SelectingPolicies are the ones
+// denying the traffic.
+type NetworkReachabilityDirectionDTO struct {
+	Allowed           bool     `json:"allowed"`
+	Isolated          bool     `json:"isolated"`
+	SelectingPolicies []string `json:"selectingPolicies,omitempty"`
+	AllowingPolicies  []string `json:"allowingPolicies,omitempty"`
+	Reason            string   `json:"reason"`
+}
@@ -31,6 +31,16 @@ type NodeListItemDTO struct {
 	ListStatus         string  `json:"listStatus,omitempty"`
 	ListSignalSeverity string  `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int     `json:"listSignalCount,omitempty"`
+
+	// Scheduling inputs. Requested totals sum the container requests of the
+	// non-terminated pods on the node; RequestsObserved is false when the pod scan
+	// behind them failed.
+	Labels               map[string]string `json:"labels,omitempty"`
+	Taints               []NodeTaintDTO    `json:"taints,omitempty"`
+	Unschedulable        bool              `json:"unschedulable,omitempty"`
+	CPURequestedMilli    int64             `json:"cpuRequestedMilli,omitempty"`
+	MemoryRequestedBytes int64             `json:"memoryRequestedBytes,omitempty"`
+	RequestsObserved     bool              `json:"requestsObserved,omitempty"`
 }
 
 type NodeDetailsDTO struct {
@@ -41,6 +51,7 @@ type NodeDetailsDTO struct {
 	Taints     []NodeTaintDTO     `json:"taints,omitempty"`
 	Pods       []NodePodDTO       `json:"pods"`
 	LinkedPods NodePodsSummaryDTO `json:"linkedPods"`
+	Deletion   DeletionStateDTO   `json:"deletion"`
 	YAML       string             `json:"yaml"`
 	Derived    *DerivedMetaDTO    `json:"derived,omitempty"`
 }
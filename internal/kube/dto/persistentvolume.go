@@ -16,6 +16,9 @@ type PersistentVolumeDTO struct {
 	ListStatus         string   `json:"listStatus,omitempty"`
 	ListSignalSeverity string   `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int      `json:"listSignalCount,omitempty"`
+
+	// NodeAffinity is the required node affinity of the volume, e.g. its zone.
+	NodeAffinity []NodeSelectorTermDTO `json:"nodeAffinity,omitempty"`
 }
 
 type PersistentVolumeDetailsDTO struct {
@@ -23,6 +26,8 @@ type PersistentVolumeDetailsDTO struct {
 	Spec     PersistentVolumeSpecDTO     `json:"spec"`
 	Status   PersistentVolumeStatusDTO   `json:"status"`
 	Metadata PersistentVolumeMetadataDTO `json:"metadata"`
+	Storage  StorageLinksDTO             `json:"storage"`
+	Deletion DeletionStateDTO            `json:"deletion"`
 	YAML     string                      `json:"yaml"`
 }
 
@@ -24,6 +24,8 @@ type PersistentVolumeClaimDetailsDTO struct {
 	Spec     PersistentVolumeClaimSpecDTO     `json:"spec"`
 	Status   PersistentVolumeClaimStatusDTO   `json:"status"`
 	Metadata PersistentVolumeClaimMetadataDTO `json:"metadata"`
+	Storage  StorageLinksDTO                  `json:"storage"`
+	Deletion DeletionStateDTO                 `json:"deletion"`
 	YAML     string                           `json:"yaml"`
 }
 
@@ -9,6 +9,27 @@ type PodListItemDTO struct {
 	Restarts  int32          `json:"restarts"`
 	AgeSec    int64          `json:"ageSec"`
 	LastEvent *EventBriefDTO `json:"lastEvent,omitempty"`
+	// Images are the distinct images of the init and regular containers.
+	Images []string `json:"images,omitempty"`
+
+	// In-process fields below are left out of API responses. The dataplane
+	// persists them beside the snapshot so hydrated rows keep them.
+
+	// Labels and container names let in-process consumers (label selectors,
+	// multi-pod log streams) resolve targets from the snapshot row alone.
+	Labels     map[string]string `json:"-"`
+	Containers []string          `json:"-"`
+	// PodIP and the named container ports let NetworkPolicy reachability checks
+	// evaluate ipBlock peers and named ports without a pod GET.
+	PodIP      string             `json:"-"`
+	NamedPorts []ContainerPortDTO `json:"-"`
+	// Owner is the controlling owner, linking the pod to its ReplicaSet, Job, or
+	// other controller in topology views.
+	Owner *OwnerReferenceDTO `json:"-"`
+	// References are the ConfigMaps, Secrets, PVCs and ServiceAccount the pod uses;
+	// they feed the dataplane reverse-reference index.
+	References []ResourceReferenceDTO `json:"-"`
+
 	// List enrichment (Stage 5C): derived from snapshot row only, no extra kube reads.
 	HealthReason       string `json:"healthReason,omitempty"`
 	RestartSeverity    string `json:"restartSeverity,omitempty"` // none | low | medium | high
@@ -46,6 +67,7 @@ type PodDetailsDTO struct {
 	Containers []PodContainerDTO `json:"containers"`
 	Resources  PodResourcesDTO   `json:"resources"`
 	Metadata   PodMetadataDTO    `json:"metadata"`
+	Deletion   DeletionStateDTO  `json:"deletion"`
 	YAML       string            `json:"yaml"`
 }
 
@@ -0,0 +1,56 @@
+package dto
+
+type PodDisruptionBudgetDTO struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Selector is nil when the PDB has no selector (it matches no pods); an empty
+	// selector matches every pod in the namespace.
+	Selector                   *LabelSelectorDTO `json:"selector,omitempty"`
+	SelectorString             string            `json:"selectorString,omitempty"`
+	MinAvailable               string            `json:"minAvailable,omitempty"`
+	MaxUnavailable             string            `json:"maxUnavailable,omitempty"`
+	UnhealthyPodEvictionPolicy string            `json:"unhealthyPodEvictionPolicy,omitempty"`
+	CurrentHealthy             int32             `json:"currentHealthy"`
+	DesiredHealthy             int32             `json:"desiredHealthy"`
+	ExpectedPods               int32             `json:"expectedPods"`
+	DisruptionsAllowed         int32             `json:"disruptionsAllowed"`
+	AgeSec                     int64             `json:"ageSec"`
+	// List enrichment: derived from the snapshot row only.
+	HealthBucket       string `json:"healthBucket,omitempty"`
+	NeedsAttention     bool   `json:"needsAttention,omitempty"`
+	ListStatus         string `json:"listStatus,omitempty"`
+	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
+	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+}
+
+type PodDisruptionBudgetDetailsDTO struct {
+	Summary    PodDisruptionBudgetDTO            `json:"summary"`
+	Pods       []PodDisruptionBudgetPodDTO       `json:"pods"`
+	Conditions []PodDisruptionBudgetConditionDTO `json:"conditions,omitempty"`
+	Metadata   PodDisruptionBudgetMetadataDTO    `json:"metadata"`
+	Deletion   DeletionStateDTO                  `json:"deletion"`
+	YAML       string                            `json:"yaml"`
+}
+
+// PodDisruptionBudgetPodDTO is a pod matched by the PDB selector. Disrupted is set while
+// an eviction of the pod is being processed.
+type PodDisruptionBudgetPodDTO struct {
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	Ready     string `json:"ready"`
+	Node      string `json:"node,omitempty"`
+	Disrupted bool   `json:"disrupted,omitempty"`
+}
+
+type PodDisruptionBudgetConditionDTO struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime int64  `json:"lastTransitionTime,omitempty"`
+}
+
+type PodDisruptionBudgetMetadataDTO struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+// WhoCanDTO answers which subjects may perform a verb on a resource, built from the
+// cached Role, ClusterRole, RoleBinding and ClusterRoleBinding snapshots. An empty
+// Namespace asks about cluster-wide access.
+type WhoCanDTO struct {
+	Verb         string `json:"verb"`
+	Group        string `json:"group"`
+	Resource     string `json:"resource"`
+	Subresource  string `json:"subresource,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	// Subjects hold every subject granted the access, each with the paths that grant it.
+	Subjects []WhoCanSubjectDTO `json:"subjects"`
+	// Unavailable lists the snapshot kinds that could not be read; the answer may then
+	// miss subjects.
+	Unavailable []string `json:"unavailable,omitempty"`
+}
+
+type WhoCanSubjectDTO struct {
+	Kind      string             `json:"kind"`
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace,omitempty"`
+	Grants    []RBACGrantPathDTO `json:"grants"`
+}
+
+// RBACGrantPathDTO is the binding → role → rule path behind a permission. When the
+// rule comes from a ClusterRole folded into an aggregated ClusterRole, AggregatedFrom
+// names that source role.
+type RBACGrantPathDTO struct {
+	BindingKind      string        `json:"bindingKind"`
+	BindingName      string        `json:"bindingName"`
+	BindingNamespace string        `json:"bindingNamespace,omitempty"`
+	RoleKind         string        `json:"roleKind"`
+	RoleName         string        `json:"roleName"`
+	AggregatedFrom   string        `json:"aggregatedFrom,omitempty"`
+	Rule             PolicyRuleDTO `json:"rule"`
+}
+
+// ServiceAccountPermissionsDTO is the effective permission matrix of a ServiceAccount:
+// what its bindings, and those of its implicit groups, allow per scope.
+type ServiceAccountPermissionsDTO struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Identities are the user and group names bindings were matched against.
+	Identities []string `json:"identities"`
+	// NamespacesChecked lists the namespaces whose RoleBindings were evaluated: the
+	// ServiceAccount's own namespace plus any namespace with cached RoleBindings.
+	NamespacesChecked []string                 `json:"namespacesChecked"`
+	Rows              []PermissionMatrixRowDTO `json:"rows"`
+	Unavailable       []string                 `json:"unavailable,omitempty"`
+}
+
+// PermissionMatrixRowDTO is one resource (or non-resource URL) in one scope. Scope is
+// a namespace, or "*" for cluster-wide grants.
+type PermissionMatrixRowDTO struct {
+	Scope          string             `json:"scope"`
+	APIGroup       string             `json:"apiGroup,omitempty"`
+	Resource       string             `json:"resource,omitempty"`
+	NonResourceURL string             `json:"nonResourceURL,omitempty"`
+	ResourceNames  []string           `json:"resourceNames,omitempty"`
+	Verbs          []string           `json:"verbs"`
+	Grants         []RBACGrantPathDTO `json:"grants"`
+}
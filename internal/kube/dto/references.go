@@ -0,0 +1,25 @@
+package dto
+
+// ResourceReferrersDTO lists the objects in a namespace that reference a ConfigMap,
+// Secret, PersistentVolumeClaim or ServiceAccount, built from cached snapshots.
+type ResourceReferrersDTO struct {
+	Kind      string                `json:"kind"`
+	Namespace string                `json:"namespace"`
+	Name      string                `json:"name"`
+	Referrers []ResourceReferrerDTO `json:"referrers"`
+	// Unavailable lists the snapshot kinds that could not be read. When it is not
+	// empty, an empty Referrers list does not prove the object is unused.
+	Unavailable []string `json:"unavailable,omitempty"`
+}
+
+// ResourceReferrerDTO is one referencing object and every way it uses the target.
+type ResourceReferrerDTO struct {
+	Kind string                    `json:"kind"`
+	Name string                    `json:"name"`
+	Uses []ResourceReferenceUseDTO `json:"uses"`
+}
+
+type ResourceReferenceUseDTO struct {
+	Via    string `json:"via"`
+	Source string `json:"source,omitempty"`
+}
@@ -26,6 +26,7 @@ type ReplicaSetDetailsDTO struct {
 	Pods       []ReplicaSetPodDTO       `json:"pods"`
 	Spec       ReplicaSetSpecDTO        `json:"spec"`
 	LinkedPods ReplicaSetPodsSummaryDTO `json:"linkedPods"`
+	Deletion   DeletionStateDTO         `json:"deletion"`
 	YAML       string                   `json:"yaml"`
 }
 
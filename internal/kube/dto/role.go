@@ -10,12 +10,16 @@ type RoleListItemDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// Rules let reverse RBAC lookups ("who can") run over the snapshot.
+	Rules []PolicyRuleDTO `json:"rules,omitempty"`
 }
 
 type RoleDetailsDTO struct {
-	Summary RoleSummaryDTO  `json:"summary"`
-	Rules   []PolicyRuleDTO `json:"rules"`
-	YAML    string          `json:"yaml"`
+	Summary  RoleSummaryDTO   `json:"summary"`
+	Rules    []PolicyRuleDTO  `json:"rules"`
+	Deletion DeletionStateDTO `json:"deletion"`
+	YAML     string           `json:"yaml"`
 }
 
 type RoleSummaryDTO struct {
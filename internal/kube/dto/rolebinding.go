@@ -13,12 +13,16 @@ type RoleBindingListItemDTO struct {
 	ListStatus         string `json:"listStatus,omitempty"`
 	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+
+	// Subjects let topology views link ServiceAccounts to the binding.
+	Subjects []SubjectDTO `json:"subjects,omitempty"`
 }
 
 type RoleBindingDetailsDTO struct {
 	Summary  BindingSummaryDTO `json:"summary"`
 	RoleRef  RoleRefDTO        `json:"roleRef"`
 	Subjects []SubjectDTO      `json:"subjects"`
+	Deletion DeletionStateDTO  `json:"deletion"`
 	YAML     string            `json:"yaml"`
 }
 
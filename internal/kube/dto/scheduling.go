@@ -0,0 +1,58 @@
+package dto
+
+// PodSchedulingSpecDTO is the part of a pod spec the scheduling explainer evaluates.
+// Requests are the effective pod requests: the larger of the container sum and any
+// init container, plus pod overhead.
+type PodSchedulingSpecDTO struct {
+	Name                 string                        `json:"name"`
+	Namespace            string                        `json:"namespace"`
+	Phase                string                        `json:"phase"`
+	NodeName             string                        `json:"nodeName,omitempty"`
+	Labels               map[string]string             `json:"labels,omitempty"`
+	NodeSelector         map[string]string             `json:"nodeSelector,omitempty"`
+	RequiredNodeAffinity []NodeSelectorTermDTO         `json:"requiredNodeAffinity,omitempty"`
+	Tolerations          []TolerationDTO               `json:"tolerations,omitempty"`
+	TopologySpread       []TopologySpreadConstraintDTO `json:"topologySpread,omitempty"`
+	CPURequestMilli      int64                         `json:"cpuRequestMilli,omitempty"`
+	MemoryRequestBytes   int64                         `json:"memoryRequestBytes,omitempty"`
+	PVCs                 []string                      `json:"pvcs,omitempty"`
+}
+
+// PodSchedulingDTO explains, node by node, where a pod can be scheduled.
+type PodSchedulingDTO struct {
+	Pod                string `json:"pod"`
+	Namespace          string `json:"namespace"`
+	Phase              string `json:"phase"`
+	NodeName           string `json:"nodeName,omitempty"`
+	CPURequestMilli    int64  `json:"cpuRequestMilli,omitempty"`
+	MemoryRequestBytes int64  `json:"memoryRequestBytes,omitempty"`
+	FeasibleNodes      int    `json:"feasibleNodes"`
+	TotalNodes         int    `json:"totalNodes"`
+	// Summary counts the nodes failing each check, like the scheduler's
+	// "0/5 nodes are available" message.
+	Summary []SchedulingCheckCountDTO `json:"summary"`
+	Nodes   []NodeSchedulingFitDTO    `json:"nodes"`
+	// Notes report pod-level problems (a missing PVC) and checks that could not be
+	// evaluated from the snapshots.
+	Notes []string `json:"notes,omitempty"`
+}
+
+type SchedulingCheckCountDTO struct {
+	Check string `json:"check"`
+	Nodes int    `json:"nodes"`
+}
+
+// NodeSchedulingFitDTO is the verdict for one node. Check is one of unschedulable,
+// nodeSelector, nodeAffinity, taints, pods, cpu, memory, topologySpread or volume.
+type NodeSchedulingFitDTO struct {
+	Node            string                `json:"node"`
+	Fits            bool                  `json:"fits"`
+	Reasons         []SchedulingReasonDTO `json:"reasons,omitempty"`
+	CPUFreeMilli    int64                 `json:"cpuFreeMilli"`
+	MemoryFreeBytes int64                 `json:"memoryFreeBytes"`
+}
+
+type SchedulingReasonDTO struct {
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
@@ -20,6 +20,7 @@ type SecretDetailsDTO struct {
 	Keys     []SecretKeyDTO    `json:"keys"`
 	KeyNames []string          `json:"keyNames"`
 	Metadata SecretMetadataDTO `json:"metadata"`
+	Deletion DeletionStateDTO  `json:"deletion"`
 	YAML     string            `json:"yaml"`
 }
 
@@ -5,6 +5,7 @@ type ServiceDetailsDTO struct {
 	Ports     []ServicePortDTO    `json:"ports"`
 	Traffic   ServiceTrafficDTO   `json:"traffic"`
 	Endpoints ServiceEndpointsDTO `json:"endpoints"`
+	Deletion  DeletionStateDTO    `json:"deletion"`
 	YAML      string              `json:"yaml"`
 }
 
@@ -34,10 +35,31 @@ type ServiceTrafficDTO struct {
 	LoadBalancerIngress   []string `json:"loadBalancerIngress,omitempty"`
 }
 
+// ServiceEndpointsDTO is read from the service's EndpointSlices. Ready and NotReady count
+// each backing pod once, even when dual-stack slices list it per address family.
 type ServiceEndpointsDTO struct {
-	Ready    int32                   `json:"ready"`
-	NotReady int32                   `json:"notReady"`
-	Pods     []ServiceEndpointPodDTO `json:"pods,omitempty"`
+	Ready        int32                   `json:"ready"`
+	NotReady     int32                   `json:"notReady"`
+	Slices       int                     `json:"slices"`
+	AddressTypes []string                `json:"addressTypes,omitempty"`
+	Items        []ServiceEndpointDTO    `json:"items,omitempty"`
+	Pods         []ServiceEndpointPodDTO `json:"pods,omitempty"`
+}
+
+// ServiceEndpointDTO is one EndpointSlice endpoint. Ports are the slice ports formatted
+// as "[name ]port/protocol".
+type ServiceEndpointDTO struct {
+	Addresses    []string `json:"addresses"`
+	AddressType  string   `json:"addressType"`
+	Pod          string   `json:"pod,omitempty"`
+	PodNamespace string   `json:"podNamespace,omitempty"`
+	Node         string   `json:"node,omitempty"`
+	Zone         string   `json:"zone,omitempty"`
+	Ready        bool     `json:"ready"`
+	Serving      bool     `json:"serving"`
+	Terminating  bool     `json:"terminating"`
+	Ports        []string `json:"ports,omitempty"`
+	Slice        string   `json:"slice"`
 }
 
 type ServiceEndpointPodDTO struct {
@@ -48,20 +70,22 @@ type ServiceEndpointPodDTO struct {
 }
 
 type ServiceListItemDTO struct {
-	Name                 string   `json:"name"`
-	Namespace            string   `json:"namespace"`
-	Type                 string   `json:"type"`
-	ClusterIPs           []string `json:"clusterIPs"`
-	PortsSummary         string   `json:"portsSummary,omitempty"`
-	EndpointsReady       int32    `json:"endpointsReady"`
-	EndpointsNotReady    int32    `json:"endpointsNotReady"`
-	AgeSec               int64    `json:"ageSec"`
-	EndpointHealthBucket string   `json:"endpointHealthBucket,omitempty"`
-	ExposureHint         string   `json:"exposureHint,omitempty"`
-	NeedsAttention       bool     `json:"needsAttention,omitempty"`
-	ListStatus           string   `json:"listStatus,omitempty"`
-	ListSignalSeverity   string   `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
-	ListSignalCount      int      `json:"listSignalCount,omitempty"`
+	Name              string   `json:"name"`
+	Namespace         string   `json:"namespace"`
+	Type              string   `json:"type"`
+	ClusterIPs        []string `json:"clusterIPs"`
+	PortsSummary      string   `json:"portsSummary,omitempty"`
+	EndpointsReady    int32    `json:"endpointsReady"`
+	EndpointsNotReady int32    `json:"endpointsNotReady"`
+	AgeSec            int64    `json:"ageSec"`
+	// Selector lets signal detectors match the service against cached pods.
+	Selector             map[string]string `json:"selector,omitempty"`
+	EndpointHealthBucket string            `json:"endpointHealthBucket,omitempty"`
+	ExposureHint         string            `json:"exposureHint,omitempty"`
+	NeedsAttention       bool              `json:"needsAttention,omitempty"`
+	ListStatus           string            `json:"listStatus,omitempty"`
+	ListSignalSeverity   string            `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
+	ListSignalCount      int               `json:"listSignalCount,omitempty"`
 }
 
 type ServiceLinkDTO struct {
@@ -13,11 +13,16 @@ type ServiceAccountListItemDTO struct {
 	ListStatus                   string `json:"listStatus,omitempty"`
 	ListSignalSeverity           string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
 	ListSignalCount              int    `json:"listSignalCount,omitempty"`
+
+	// References are the Secrets listed in secrets and imagePullSecrets; in-process only,
+	// left out of API responses.
+	References []ResourceReferenceDTO `json:"-"`
 }
 
 type ServiceAccountDetailsDTO struct {
 	Summary  ServiceAccountSummaryDTO  `json:"summary"`
 	Metadata ServiceAccountMetadataDTO `json:"metadata"`
+	Deletion DeletionStateDTO          `json:"deletion"`
 	YAML     string                    `json:"yaml"`
 }
 
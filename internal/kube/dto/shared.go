@@ -46,3 +46,36 @@ type MissingReferenceDTO struct {
 	Name   string `json:"name"`
 	Source string `json:"source,omitempty"`
 }
+
+// DeletionStateDTO is the deletion state of an object. DeletionTimestamp is the unix
+// time deletion was requested; while it is set, the object stays until every finalizer
+// is removed.
+type DeletionStateDTO struct {
+	Finalizers        []string `json:"finalizers,omitempty"`
+	DeletionTimestamp int64    `json:"deletionTimestamp,omitempty"`
+}
+
+// ResourceReferenceDTO is an outgoing reference from a pod spec, Ingress or
+// ServiceAccount to a ConfigMap, Secret, PersistentVolumeClaim or ServiceAccount in
+// the same namespace. Via is env, envFrom, volume, imagePullSecret, serviceAccount
+// or tls; Source narrows it down, e.g. "container/app" or "volume/config".
+type ResourceReferenceDTO struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Via    string `json:"via"`
+	Source string `json:"source,omitempty"`
+}
+
+// NodeSelectorTermDTO is one term of a required node affinity. A node matches the
+// term when it satisfies every requirement; terms of a selector are ORed. Field
+// requirements (matchFields) use the key "metadata.name".
+type NodeSelectorTermDTO struct {
+	MatchExpressions []NodeSelectorRequirementDTO `json:"matchExpressions,omitempty"`
+	MatchFields      []NodeSelectorRequirementDTO `json:"matchFields,omitempty"`
+}
+
+type NodeSelectorRequirementDTO struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values,omitempty"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+type StorageClassDTO struct {
+	Name                 string `json:"name"`
+	Provisioner          string `json:"provisioner"`
+	ReclaimPolicy        string `json:"reclaimPolicy,omitempty"`
+	VolumeBindingMode    string `json:"volumeBindingMode,omitempty"`
+	AllowVolumeExpansion bool   `json:"allowVolumeExpansion,omitempty"`
+	IsDefault            bool   `json:"isDefault,omitempty"`
+	ParametersCount      int    `json:"parametersCount"`
+	AgeSec               int64  `json:"ageSec"`
+}
+
+type StorageClassDetailsDTO struct {
+	Summary           StorageClassDTO         `json:"summary"`
+	Parameters        map[string]string       `json:"parameters,omitempty"`
+	MountOptions      []string                `json:"mountOptions,omitempty"`
+	AllowedTopologies []string                `json:"allowedTopologies,omitempty"`
+	CSIDriver         *CSIDriverDTO           `json:"csiDriver,omitempty"`
+	Metadata          StorageClassMetadataDTO `json:"metadata"`
+	Deletion          DeletionStateDTO        `json:"deletion"`
+	YAML              string                  `json:"yaml"`
+}
+
+type StorageClassMetadataDTO struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// StorageLinksDTO relates a PersistentVolume or claim to its StorageClass and the
+// VolumeAttachments of its volume. StorageClassMissing is set only when the class is
+// named and the API reports it as not found; unreadable classes leave both fields empty.
+type StorageLinksDTO struct {
+	StorageClass        *StorageClassDTO      `json:"storageClass,omitempty"`
+	StorageClassMissing bool                  `json:"storageClassMissing,omitempty"`
+	Attachments         []VolumeAttachmentDTO `json:"attachments,omitempty"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+type VolumeAttachmentDTO struct {
+	Name                 string `json:"name"`
+	Attacher             string `json:"attacher"`
+	NodeName             string `json:"nodeName"`
+	PersistentVolumeName string `json:"persistentVolumeName,omitempty"`
+	Attached             bool   `json:"attached"`
+	AttachError          string `json:"attachError,omitempty"`
+	DetachError          string `json:"detachError,omitempty"`
+	// DeletionTimestamp is the unix time the attachment was marked for deletion; while
+	// set and Attached is still true, the volume is detaching.
+	DeletionTimestamp  int64  `json:"deletionTimestamp,omitempty"`
+	AgeSec             int64  `json:"ageSec"`
+	HealthBucket       string `json:"healthBucket,omitempty"`
+	NeedsAttention     bool   `json:"needsAttention,omitempty"`
+	ListStatus         string `json:"listStatus,omitempty"`
+	ListSignalSeverity string `json:"listSignalSeverity,omitempty"` // high | medium | low | ok
+	ListSignalCount    int    `json:"listSignalCount,omitempty"`
+}
+
+type VolumeAttachmentDetailsDTO struct {
+	Summary  VolumeAttachmentDTO         `json:"summary"`
+	Metadata VolumeAttachmentMetadataDTO `json:"metadata"`
+	Deletion DeletionStateDTO            `json:"deletion"`
+	YAML     string                      `json:"yaml"`
+}
+
+type VolumeAttachmentMetadataDTO struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Finalizers  []string          `json:"finalizers,omitempty"`
+}
@@ -0,0 +1,143 @@
+package apiresources
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// CoreGroupAlias names the core ("") API group in URL paths, which cannot carry an
+// empty segment.
+const CoreGroupAlias = "core"
+
+// GroupFromPath maps a URL group segment to its API group.
+func GroupFromPath(group string) string {
+	if group == CoreGroupAlias {
+		return ""
+	}
+	return group
+}
+
+// ListAPIResources returns the preferred version of every discovered resource. Groups
+// that fail discovery (for example an unavailable aggregated API) are returned in
+// failedGroups instead of failing the whole catalog.
+func ListAPIResources(c *cluster.Clients) (items []dto.APIResourceDTO, failedGroups []string, err error) {
+	lists, err := c.Discovery.ServerPreferredResources()
+	if err != nil {
+		var groupErr *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &groupErr) {
+			return nil, nil, err
+		}
+		for gv := range groupErr.Groups {
+			failedGroups = append(failedGroups, gv.String())
+		}
+		sort.Strings(failedGroups)
+	}
+	return mapAPIResources(lists), failedGroups, nil
+}
+
+// ResolveAPIResource looks up a single resource of a group version. It returns a
+// NotFound error when the resource is not served.
+func ResolveAPIResource(c *cluster.Clients, group, version, resource string) (dto.APIResourceDTO, error) {
+	gv := schema.GroupVersion{Group: group, Version: version}
+	list, err := c.Discovery.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		return dto.APIResourceDTO{}, err
+	}
+	for _, r := range mapAPIResources([]*metav1.APIResourceList{list}) {
+		if r.Resource == resource {
+			return r, nil
+		}
+	}
+	return dto.APIResourceDTO{}, apierrors.NewNotFound(schema.GroupResource{Group: group, Resource: resource}, "")
+}
+
+// ListResources lists any resource through the dynamic client. An empty namespace
+// lists cluster-scoped resources, or namespaced resources across all namespaces.
+func ListResources(ctx context.Context, c *cluster.Clients, gvr schema.GroupVersionResource, namespace string) ([]dto.GenericResourceListItemDTO, error) {
+	dyn, err := dynamic.NewForConfig(c.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	list, err := dyn.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	out := make([]dto.GenericResourceListItemDTO, 0, len(list.Items))
+	for i := range list.Items {
+		out = append(out, mapGenericResource(&list.Items[i], now))
+	}
+	return out, nil
+}
+
+func mapAPIResources(lists []*metav1.APIResourceList) []dto.APIResourceDTO {
+	var out []dto.APIResourceDTO
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			out = append(out, dto.APIResourceDTO{
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Resource:   r.Name,
+				Kind:       r.Kind,
+				Namespaced: r.Namespaced,
+				Verbs:      append([]string{}, r.Verbs...),
+				ShortNames: r.ShortNames,
+				Categories: r.Categories,
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Group != out[j].Group {
+			return out[i].Group < out[j].Group
+		}
+		return out[i].Resource < out[j].Resource
+	})
+	return out
+}
+
+func mapGenericResource(obj *unstructured.Unstructured, now time.Time) dto.GenericResourceListItemDTO {
+	item := dto.GenericResourceListItemDTO{
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		Kind:       obj.GetKind(),
+		Labels:     obj.GetLabels(),
+		Finalizers: len(obj.GetFinalizers()),
+	}
+	if ts := obj.GetCreationTimestamp(); !ts.IsZero() {
+		item.CreatedAt = ts.Unix()
+		item.AgeSec = int64(now.Sub(ts.Time).Seconds())
+	}
+	if ts := obj.GetDeletionTimestamp(); ts != nil {
+		item.DeletionTimestamp = ts.Unix()
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			item.Owner = &dto.OwnerReferenceDTO{Kind: ref.Kind, Name: ref.Name}
+			break
+		}
+	}
+	return item
+}
@@ -0,0 +1,73 @@
+package apiresources
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMapAPIResources(t *testing.T) {
+	lists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "scheduling.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "priorityclasses", Kind: "PriorityClass", Verbs: metav1.Verbs{"get", "list"}, ShortNames: []string{"pc"}},
+			},
+		},
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"list"}},
+				{Name: "pods/status", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get"}},
+			},
+		},
+	}
+	got := mapAPIResources(lists)
+	if len(got) != 2 {
+		t.Fatalf("expected subresources skipped, got %+v", got)
+	}
+	if got[0].Group != "" || got[0].Resource != "pods" || !got[0].Namespaced {
+		t.Fatalf("expected core pods first, got %+v", got[0])
+	}
+	if got[1].Group != "scheduling.k8s.io" || got[1].Version != "v1" || got[1].Kind != "PriorityClass" || got[1].ShortNames[0] != "pc" {
+		t.Fatalf("priorityclasses: got %+v", got[1])
+	}
+}
+
+func TestMapGenericResource(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	created := metav1.NewTime(now.Add(-time.Hour))
+	deleting := metav1.NewTime(now.Add(-time.Minute))
+	yes := true
+
+	obj := &unstructured.Unstructured{}
+	obj.SetKind("Lease")
+	obj.SetName("leader")
+	obj.SetNamespace("kube-system")
+	obj.SetCreationTimestamp(created)
+	obj.SetDeletionTimestamp(&deleting)
+	obj.SetFinalizers([]string{"example.io/hold"})
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		{Kind: "ConfigMap", Name: "not-controller"},
+		{Kind: "Deployment", Name: "controller", Controller: &yes},
+	})
+
+	got := mapGenericResource(obj, now)
+	if got.Name != "leader" || got.Namespace != "kube-system" || got.Kind != "Lease" {
+		t.Fatalf("identity: got %+v", got)
+	}
+	if got.AgeSec != 3600 || got.DeletionTimestamp != deleting.Unix() || got.Finalizers != 1 {
+		t.Fatalf("lifecycle: got %+v", got)
+	}
+	if got.Owner == nil || got.Owner.Name != "controller" {
+		t.Fatalf("owner: got %+v", got.Owner)
+	}
+}
+
+func TestGroupFromPath(t *testing.T) {
+	if GroupFromPath("core") != "" || GroupFromPath("apps") != "apps" {
+		t.Fatal("unexpected group mapping")
+	}
+}
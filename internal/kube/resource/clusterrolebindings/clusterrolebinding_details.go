@@ -47,6 +47,7 @@ func GetClusterRoleBindingDetails(ctx context.Context, c *cluster.Clients, name
 		Summary:  summary,
 		RoleRef:  roleRef,
 		Subjects: kube.MapRoleBindingSubjects("", rb.Subjects),
+		Deletion: kube.DeletionState(rb),
 		YAML:     string(y),
 	}, nil
 }
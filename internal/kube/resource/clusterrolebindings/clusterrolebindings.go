@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
+	kube "github.com/korex-labs/kview/v5/internal/kube"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 )
 
@@ -30,6 +31,8 @@ func ListClusterRoleBindings(ctx context.Context, c *cluster.Clients) ([]dto.Clu
 			RoleRefName:   rb.RoleRef.Name,
 			SubjectsCount: len(rb.Subjects),
 			AgeSec:        age,
+
+			Subjects: kube.MapRoleBindingSubjects("", rb.Subjects),
 		})
 	}
 
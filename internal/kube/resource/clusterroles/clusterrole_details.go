@@ -39,9 +39,10 @@ func GetClusterRoleDetails(ctx context.Context, c *cluster.Clients, name string)
 	}
 
 	return &dto.ClusterRoleDetailsDTO{
-		Summary: summary,
-		Rules:   kube.MapPolicyRules(role.Rules),
-		YAML:    string(y),
+		Summary:  summary,
+		Rules:    kube.MapPolicyRules(role.Rules),
+		Deletion: kube.DeletionState(role),
+		YAML:     string(y),
 	}, nil
 }
 
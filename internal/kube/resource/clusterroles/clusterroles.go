@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
+	kube "github.com/korex-labs/kview/v5/internal/kube"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 )
 
@@ -28,8 +30,29 @@ func ListClusterRoles(ctx context.Context, c *cluster.Clients) ([]dto.ClusterRol
 			Name:       role.Name,
 			RulesCount: len(role.Rules),
 			AgeSec:     age,
+
+			Rules:                kube.MapPolicyRules(role.Rules),
+			Labels:               role.Labels,
+			AggregationSelectors: aggregationSelectors(role.AggregationRule),
 		})
 	}
 
 	return out, nil
 }
+
+// aggregationSelectors renders the selectors of an aggregated ClusterRole as strings.
+// Selectors that cannot be converted are skipped.
+func aggregationSelectors(rule *rbacv1.AggregationRule) []string {
+	if rule == nil {
+		return nil
+	}
+	var out []string
+	for i := range rule.ClusterRoleSelectors {
+		sel, err := metav1.LabelSelectorAsSelector(&rule.ClusterRoleSelectors[i])
+		if err != nil {
+			continue
+		}
+		out = append(out, sel.String())
+	}
+	return out
+}
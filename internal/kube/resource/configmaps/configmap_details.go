@@ -98,6 +98,7 @@ func GetConfigMapDetails(ctx context.Context, c *cluster.Clients, namespace, nam
 		KeyNames: keyNames,
 		Data:     configMapDataValues(cm.Data),
 		Metadata: metadata,
+		Deletion: kube.DeletionState(cm),
 		YAML:     string(y),
 	}, nil
 }
@@ -0,0 +1,270 @@
+package cronjobs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+const (
+	// DefaultCronJobNextRuns is how many upcoming run times the details endpoint previews.
+	DefaultCronJobNextRuns = 5
+	// MaxCronJobNextRuns caps the nextRuns query parameter.
+	MaxCronJobNextRuns = 50
+
+	// Missed schedules are counted up to this limit, like the CronJob controller's
+	// "too many missed start times" cutoff.
+	maxMissedSchedules = 100
+	maxMissedRunsShown = 10
+	// Schedules that never match (e.g. "0 0 30 2 *") stop the search after this many years.
+	cronSearchYears = 5
+)
+
+// cronSchedule is a parsed standard 5-field cron expression, evaluated with the same
+// rules as the CronJob controller: when both day-of-month and day-of-week are restricted,
+// a day matches if either does.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+	loc                           *time.Location
+}
+
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinuteField = cronField{min: 0, max: 59}
+	cronHourField   = cronField{min: 0, max: 23}
+	cronDomField    = cronField{min: 1, max: 31}
+	cronMonthField  = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDowField = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseCronSchedule parses spec in timeZone (empty means UTC). A legacy "TZ=" or
+// "CRON_TZ=" prefix in the schedule overrides the zone.
+func parseCronSchedule(spec, timeZone string) (*cronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		i := strings.IndexByte(spec, ' ')
+		if i < 0 {
+			return nil, fmt.Errorf("schedule has a time zone but no fields")
+		}
+		timeZone = spec[strings.IndexByte(spec, '=')+1 : i]
+		spec = strings.TrimSpace(spec[i:])
+	}
+	loc := time.UTC
+	if timeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", timeZone)
+		}
+	}
+	if expanded, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+	s := &cronSchedule{loc: loc}
+	var err error
+	if s.minute, _, err = parseCronField(fields[0], cronMinuteField); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	if s.hour, _, err = parseCronField(fields[1], cronHourField); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	if s.dom, s.domStar, err = parseCronField(fields[2], cronDomField); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	if s.month, _, err = parseCronField(fields[3], cronMonthField); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	if s.dow, s.dowStar, err = parseCronField(fields[4], cronDowField); err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+	if s.dow&(1<<7) != 0 {
+		// 7 is an alias for Sunday.
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// parseCronField returns the bitset of allowed values and whether the field is
+// unrestricted (which matters for the day-of-month / day-of-week rule). Like the
+// controller's parser, only a bare "*" or "?" counts: "*/2" is a restriction.
+func parseCronField(field string, f cronField) (uint64, bool, error) {
+	var bits uint64
+	var star bool
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, false, fmt.Errorf("invalid step in %q", part)
+			}
+			rangePart, step = part[:i], n
+		}
+		lo, hi := f.min, f.max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			star = star || step == 1
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = f.value(bounds[0]); err != nil {
+				return 0, false, err
+			}
+			if hi, err = f.value(bounds[1]); err != nil {
+				return 0, false, err
+			}
+		default:
+			v, err := f.value(rangePart)
+			if err != nil {
+				return 0, false, err
+			}
+			lo, hi = v, v
+			if step > 1 {
+				// "5/15" means from 5 to the end of the range.
+				hi = f.max
+			}
+		}
+		if lo > hi {
+			return 0, false, fmt.Errorf("range %q is backwards", part)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, star, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first scheduled time strictly after t, or the zero time if the
+// schedule never matches within the search window.
+func (s *cronSchedule) next(t time.Time) time.Time {
+	t = t.In(s.loc)
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, s.loc).Add(time.Minute)
+	limit := t.Year() + cronSearchYears
+
+	for t.Year() <= limit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// buildSchedulePreview computes the next runs after now and the runs missed since the
+// last schedule (or creation, before the first run), honoring spec.timeZone and
+// startingDeadlineSeconds the way the CronJob controller does.
+func buildSchedulePreview(cj *batchv1.CronJob, now time.Time, nextRuns int) dto.CronJobSchedulePreviewDTO {
+	timeZone := ""
+	if cj.Spec.TimeZone != nil {
+		timeZone = *cj.Spec.TimeZone
+	}
+	preview := dto.CronJobSchedulePreviewDTO{TimeZone: timeZone}
+	if preview.TimeZone == "" {
+		preview.TimeZone = "UTC"
+	}
+	sched, err := parseCronSchedule(cj.Spec.Schedule, timeZone)
+	if err != nil {
+		preview.Error = err.Error()
+		return preview
+	}
+	preview.TimeZone = sched.loc.String()
+
+	for t := now; len(preview.NextRuns) < nextRuns; {
+		if t = sched.next(t); t.IsZero() {
+			break
+		}
+		preview.NextRuns = append(preview.NextRuns, t.Unix())
+	}
+
+	since := cj.CreationTimestamp.Time
+	if cj.Status.LastScheduleTime != nil {
+		since = cj.Status.LastScheduleTime.Time
+	}
+	if cj.Spec.StartingDeadlineSeconds != nil {
+		if earliest := now.Add(-time.Duration(*cj.Spec.StartingDeadlineSeconds) * time.Second); earliest.After(since) {
+			since = earliest
+		}
+	}
+	if since.IsZero() {
+		return preview
+	}
+	var missed []int64
+	for t := sched.next(since); !t.IsZero() && !t.After(now); t = sched.next(t) {
+		if len(missed) == maxMissedSchedules {
+			preview.MissedCapped = true
+			break
+		}
+		missed = append(missed, t.Unix())
+	}
+	preview.MissedSinceLastSchedule = len(missed)
+	if len(missed) > 0 {
+		preview.LastMissedRun = missed[len(missed)-1]
+		start := max(0, len(missed)-maxMissedRunsShown)
+		preview.MissedRuns = missed[start:]
+	}
+	return preview
+}
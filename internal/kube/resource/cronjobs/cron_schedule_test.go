@@ -0,0 +1,108 @@
+package cronjobs
+
+import (
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	from := time.Date(2026, 3, 14, 10, 7, 30, 0, time.UTC) // Saturday
+	tests := []struct {
+		name     string
+		schedule string
+		timeZone string
+		want     string
+	}{
+		{name: "every 15 minutes", schedule: "*/15 * * * *", want: "2026-03-14T10:15:00Z"},
+		{name: "daily macro", schedule: "@daily", want: "2026-03-15T00:00:00Z"},
+		{name: "weekday names", schedule: "0 9 * * mon-fri", want: "2026-03-16T09:00:00Z"},
+		{name: "seven is sunday", schedule: "0 9 * * 7", want: "2026-03-15T09:00:00Z"},
+		{name: "month names", schedule: "0 0 1 jun *", want: "2026-06-01T00:00:00Z"},
+		{name: "step from offset", schedule: "5/20 * * * *", want: "2026-03-14T10:25:00Z"},
+		{name: "dom or dow when both restricted", schedule: "0 0 20 * mon", want: "2026-03-16T00:00:00Z"},
+		{name: "stepped dom is restricted", schedule: "0 0 */2 * 1", want: "2026-03-15T00:00:00Z"},
+		{name: "step of one is unrestricted", schedule: "0 0 */1 * 1", want: "2026-03-16T00:00:00Z"},
+		{name: "spec time zone", schedule: "0 9 * * *", timeZone: "Europe/Berlin", want: "2026-03-15T08:00:00Z"},
+		{name: "legacy TZ prefix", schedule: "CRON_TZ=America/New_York 0 9 * * *", want: "2026-03-14T13:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := parseCronSchedule(tt.schedule, tt.timeZone)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if got := s.next(from).UTC().Format(time.RFC3339); got != tt.want {
+				t.Fatalf("next: got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNext_SkipsNonexistentLocalTime(t *testing.T) {
+	// 02:30 does not exist in Berlin on 2026-03-29 (clocks jump from 02:00 to 03:00).
+	s, err := parseCronSchedule("30 2 * * *", "Europe/Berlin")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	got := s.next(time.Date(2026, 3, 28, 12, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 30, 0, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("next: got %s, want %s", got.UTC(), want)
+	}
+}
+
+func TestParseCronSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{"* * * *", "60 * * * *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		if _, err := parseCronSchedule(spec, ""); err == nil {
+			t.Fatalf("%q: expected error", spec)
+		}
+	}
+	if _, err := parseCronSchedule("* * * * *", "Mars/Olympus"); err == nil {
+		t.Fatal("expected unknown time zone error")
+	}
+}
+
+func TestBuildSchedulePreview(t *testing.T) {
+	now := time.Date(2026, 3, 14, 10, 7, 0, 0, time.UTC)
+	last := metav1.NewTime(now.Add(-3*time.Hour - 7*time.Minute))
+	cj := &batchv1.CronJob{
+		Spec:   batchv1.CronJobSpec{Schedule: "0 * * * *"},
+		Status: batchv1.CronJobStatus{LastScheduleTime: &last},
+	}
+
+	p := buildSchedulePreview(cj, now, 3)
+	if p.Error != "" || p.TimeZone != "UTC" {
+		t.Fatalf("preview: got %+v", p)
+	}
+	if len(p.NextRuns) != 3 || p.NextRuns[0] != time.Date(2026, 3, 14, 11, 0, 0, 0, time.UTC).Unix() {
+		t.Fatalf("next runs: got %v", p.NextRuns)
+	}
+	if p.MissedSinceLastSchedule != 3 || p.LastMissedRun != time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC).Unix() {
+		t.Fatalf("missed: got %d last=%d", p.MissedSinceLastSchedule, p.LastMissedRun)
+	}
+
+	deadline := int64(60 * 60)
+	cj.Spec.StartingDeadlineSeconds = &deadline
+	if p = buildSchedulePreview(cj, now, 0); p.MissedSinceLastSchedule != 1 || len(p.NextRuns) != 0 {
+		t.Fatalf("deadline-bounded missed: got %+v", p)
+	}
+}
+
+func TestBuildSchedulePreview_CapsMissedRuns(t *testing.T) {
+	now := time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC)
+	cj := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-24 * time.Hour))},
+		Spec:       batchv1.CronJobSpec{Schedule: "* * * * *"},
+	}
+	p := buildSchedulePreview(cj, now, 1)
+	if !p.MissedCapped || p.MissedSinceLastSchedule != maxMissedSchedules || len(p.MissedRuns) != maxMissedRunsShown {
+		t.Fatalf("capped: got count=%d capped=%v shown=%d", p.MissedSinceLastSchedule, p.MissedCapped, len(p.MissedRuns))
+	}
+
+	cj.Spec.Schedule = "bogus"
+	if p = buildSchedulePreview(cj, now, 1); p.Error == "" || len(p.NextRuns) != 0 {
+		t.Fatalf("invalid schedule: got %+v", p)
+	}
+}
@@ -20,7 +20,9 @@ import (
 	pods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
-func GetCronJobDetails(ctx context.Context, c *cluster.Clients, namespace, name string) (*dto.CronJobDetailsDTO, error) {
+// GetCronJobDetails reads a CronJob with its jobs. nextRuns sets how many upcoming run
+// times the schedule preview lists.
+func GetCronJobDetails(ctx context.Context, c *cluster.Clients, namespace, name string, nextRuns int) (*dto.CronJobDetailsDTO, error) {
 	cronJob, err := c.Clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -114,13 +116,15 @@ func GetCronJobDetails(ctx context.Context, c *cluster.Clients, namespace, name
 	}
 
 	return &dto.CronJobDetailsDTO{
-		Summary:       summary,
-		Policy:        policy,
-		AllJobs:       allJobs,
-		JobsForbidden: jobsForbidden,
-		Spec:          spec,
-		Metadata:      metadata,
-		YAML:          string(y),
+		Summary:         summary,
+		Policy:          policy,
+		SchedulePreview: buildSchedulePreview(cronJob, now, nextRuns),
+		AllJobs:         allJobs,
+		JobsForbidden:   jobsForbidden,
+		Spec:            spec,
+		Metadata:        metadata,
+		Deletion:        kube.DeletionState(cronJob),
+		YAML:            string(y),
 	}, nil
 }
 
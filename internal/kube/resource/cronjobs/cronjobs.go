@@ -9,6 +9,7 @@ import (
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 	jobs "github.com/korex-labs/kview/v5/internal/kube/resource/jobs"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListCronJobs(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.CronJobDTO, error) {
@@ -40,6 +41,7 @@ func ListCronJobs(ctx context.Context, c *cluster.Clients, namespace string) ([]
 			LastScheduleTime:   jobs.TimeFrom(cj.Status.LastScheduleTime),
 			LastSuccessfulTime: jobs.TimeFrom(cj.Status.LastSuccessfulTime),
 			AgeSec:             age,
+			References:         kubepods.SpecReferences(cj.Spec.JobTemplate.Spec.Template.Spec),
 		})
 	}
 
@@ -0,0 +1,98 @@
+package csidrivers
+
+import (
+	"context"
+	"time"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func ListCSIDrivers(ctx context.Context, c *cluster.Clients) ([]dto.CSIDriverDTO, error) {
+	items, err := c.Clientset.StorageV1().CSIDrivers().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	out := make([]dto.CSIDriverDTO, 0, len(items.Items))
+	for _, d := range items.Items {
+		out = append(out, mapCSIDriver(d, now))
+	}
+	return out, nil
+}
+
+// GetCSIDriver returns the list row for a single CSIDriver.
+func GetCSIDriver(ctx context.Context, c *cluster.Clients, name string) (*dto.CSIDriverDTO, error) {
+	d, err := c.Clientset.StorageV1().CSIDrivers().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := mapCSIDriver(*d, time.Now())
+	return &out, nil
+}
+
+func GetCSIDriverDetails(ctx context.Context, c *cluster.Clients, name string) (*dto.CSIDriverDetailsDTO, error) {
+	d, err := c.Clientset.StorageV1().CSIDrivers().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	dCopy := d.DeepCopy()
+	dCopy.ManagedFields = nil
+	y, err := kube.MarshalObjectYAML(dCopy, "storage.k8s.io/v1", "CSIDriver")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CSIDriverDetailsDTO{
+		Summary: mapCSIDriver(*d, time.Now()),
+		Metadata: dto.CSIDriverMetadataDTO{
+			Labels:      d.Labels,
+			Annotations: d.Annotations,
+		},
+		Deletion: kube.DeletionState(d),
+		YAML:     string(y),
+	}, nil
+}
+
+// mapCSIDriver applies the API defaults for unset spec fields.
+func mapCSIDriver(d storagev1.CSIDriver, now time.Time) dto.CSIDriverDTO {
+	age := int64(0)
+	if !d.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(d.CreationTimestamp.Time).Seconds())
+	}
+
+	out := dto.CSIDriverDTO{
+		Name:           d.Name,
+		AttachRequired: true,
+		FSGroupPolicy:  string(storagev1.ReadWriteOnceWithFSTypeFSGroupPolicy),
+		AgeSec:         age,
+	}
+	if d.Spec.AttachRequired != nil {
+		out.AttachRequired = *d.Spec.AttachRequired
+	}
+	if d.Spec.PodInfoOnMount != nil {
+		out.PodInfoOnMount = *d.Spec.PodInfoOnMount
+	}
+	if d.Spec.StorageCapacity != nil {
+		out.StorageCapacity = *d.Spec.StorageCapacity
+	}
+	if d.Spec.RequiresRepublish != nil {
+		out.RequiresRepublish = *d.Spec.RequiresRepublish
+	}
+	if d.Spec.FSGroupPolicy != nil {
+		out.FSGroupPolicy = string(*d.Spec.FSGroupPolicy)
+	}
+	for _, mode := range d.Spec.VolumeLifecycleModes {
+		out.VolumeLifecycleModes = append(out.VolumeLifecycleModes, string(mode))
+	}
+	if len(out.VolumeLifecycleModes) == 0 {
+		out.VolumeLifecycleModes = []string{string(storagev1.VolumeLifecyclePersistent)}
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package csidrivers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMapCSIDriverDefaults(t *testing.T) {
+	got := mapCSIDriver(storagev1.CSIDriver{ObjectMeta: metav1.ObjectMeta{Name: "ebs.csi.aws.com"}}, time.Now())
+	if !got.AttachRequired || got.FSGroupPolicy != "ReadWriteOnceWithFSType" || !reflect.DeepEqual(got.VolumeLifecycleModes, []string{"Persistent"}) {
+		t.Fatalf("unexpected defaults: %+v", got)
+	}
+
+	attach := false
+	policy := storagev1.FileFSGroupPolicy
+	got = mapCSIDriver(storagev1.CSIDriver{
+		ObjectMeta: metav1.ObjectMeta{Name: "secrets-store.csi.k8s.io"},
+		Spec: storagev1.CSIDriverSpec{
+			AttachRequired:       &attach,
+			FSGroupPolicy:        &policy,
+			VolumeLifecycleModes: []storagev1.VolumeLifecycleMode{storagev1.VolumeLifecycleEphemeral},
+		},
+	}, time.Now())
+	if got.AttachRequired || got.FSGroupPolicy != "File" || !reflect.DeepEqual(got.VolumeLifecycleModes, []string{"Ephemeral"}) {
+		t.Fatalf("unexpected mapping: %+v", got)
+	}
+}
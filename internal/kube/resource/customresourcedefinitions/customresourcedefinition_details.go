@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 )
 
@@ -77,6 +78,7 @@ func GetCustomResourceDefinitionDetails(ctx context.Context, c *cluster.Clients,
 		Versions:   versions,
 		Conditions: conditions,
 		Metadata:   metadata,
+		Deletion:   kube.DeletionState(item),
 		YAML:       string(y),
 	}, nil
 }
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
+	"github.com/korex-labs/kview/v5/internal/kube"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 	"k8s.io/client-go/dynamic"
 )
@@ -58,6 +59,7 @@ func GetCustomResourceDetails(ctx context.Context, dynClient dynamic.Interface,
 	return &dto.CustomResourceDetailsDTO{
 		Summary:    summary,
 		Conditions: extractConditions(item.Object),
+		Deletion:   kube.DeletionState(item),
 		YAML:       string(y),
 	}, nil
 }
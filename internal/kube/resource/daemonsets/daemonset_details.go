@@ -120,6 +120,7 @@ func GetDaemonSetDetails(ctx context.Context, c *cluster.Clients, namespace, nam
 		Pods:       pods,
 		Spec:       spec,
 		Metadata:   metadata,
+		Deletion:   kube.DeletionState(set),
 		YAML:       string(y),
 	}, nil
 }
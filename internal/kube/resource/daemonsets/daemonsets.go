@@ -8,6 +8,7 @@ import (
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListDaemonSets(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.DaemonSetDTO, error) {
@@ -47,6 +48,7 @@ func ListDaemonSets(ctx context.Context, c *cluster.Clients, namespace string) (
 			UpdateStrategy: strategy,
 			Selector:       selector,
 			AgeSec:         age,
+			References:     kubepods.SpecReferences(ds.Spec.Template.Spec),
 		})
 	}
 
@@ -177,6 +177,7 @@ func GetDeploymentDetails(ctx context.Context, c *cluster.Clients, namespace, na
 		ReplicaSets: replicaSets,
 		Pods:        pods,
 		Spec:        spec,
+		Deletion:    kube.DeletionState(dep),
 		YAML:        string(y),
 	}, nil
 }
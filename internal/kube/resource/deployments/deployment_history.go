@@ -0,0 +1,191 @@
+package deployments
+
+import (
+	"context"
+	"sort"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RevisionAnnotation carries the rollout revision on a Deployment and its ReplicaSets.
+	RevisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+// GetDeploymentRolloutHistory returns the deployment's revisions, newest first, each with
+// its pod template diffed against the previous revision.
+func GetDeploymentRolloutHistory(ctx context.Context, c *cluster.Clients, namespace, name string) ([]dto.DeploymentRevisionDTO, error) {
+	dep, err := c.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	listOpts := metav1.ListOptions{}
+	if dep.Spec.Selector != nil {
+		if sel, err := metav1.LabelSelectorAsSelector(dep.Spec.Selector); err == nil {
+			listOpts.LabelSelector = sel.String()
+		}
+	}
+	rss, err := c.Clientset.AppsV1().ReplicaSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	return buildRolloutHistory(dep, rss.Items), nil
+}
+
+// Revision returns the rollout revision annotated on a Deployment or ReplicaSet, or 0.
+func Revision(obj metav1.Object) int32 {
+	return ParseRevision(obj.GetAnnotations()[RevisionAnnotation])
+}
+
+// OwnedRevisions returns the ReplicaSets controlled by dep that carry a revision,
+// oldest revision first.
+func OwnedRevisions(dep *appsv1.Deployment, rss []appsv1.ReplicaSet) []appsv1.ReplicaSet {
+	owned := make([]appsv1.ReplicaSet, 0, len(rss))
+	for i := range rss {
+		if isReplicaSetOwnedBy(&rss[i], dep.UID) && Revision(&rss[i]) > 0 {
+			owned = append(owned, rss[i])
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool { return Revision(&owned[i]) < Revision(&owned[j]) })
+	return owned
+}
+
+// buildRolloutHistory maps the deployment's owned ReplicaSets to revisions.
+func buildRolloutHistory(dep *appsv1.Deployment, rss []appsv1.ReplicaSet) []dto.DeploymentRevisionDTO {
+	owned := OwnedRevisions(dep, rss)
+	current := Revision(dep)
+	out := make([]dto.DeploymentRevisionDTO, 0, len(owned))
+	for i, rs := range owned {
+		rev := Revision(&rs)
+		item := dto.DeploymentRevisionDTO{
+			Revision:    rev,
+			ReplicaSet:  rs.Name,
+			ChangeCause: rs.Annotations[changeCauseAnnotation],
+			Current:     rev == current,
+			Containers:  MapContainerSummaries(rs.Spec.Template.Spec.Containers),
+		}
+		if !rs.CreationTimestamp.IsZero() {
+			item.CreatedAt = rs.CreationTimestamp.Unix()
+		}
+		if i > 0 {
+			item.Changes = diffPodTemplates(owned[i-1].Spec.Template, rs.Spec.Template)
+		}
+		out = append(out, item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Revision > out[j].Revision })
+	return out
+}
+
+// diffPodTemplates lists image, env, container, label, and annotation differences from
+// prev to cur. The controller-managed pod-template-hash label is ignored.
+func diffPodTemplates(prev, cur corev1.PodTemplateSpec) []dto.DeploymentTemplateChangeDTO {
+	var out []dto.DeploymentTemplateChangeDTO
+	out = append(out, diffStringMaps("label", "", withoutTemplateHash(prev.Labels), withoutTemplateHash(cur.Labels))...)
+	out = append(out, diffStringMaps("annotation", "", prev.Annotations, cur.Annotations)...)
+	out = append(out, diffContainers(prev.Spec.InitContainers, cur.Spec.InitContainers)...)
+	out = append(out, diffContainers(prev.Spec.Containers, cur.Spec.Containers)...)
+	return out
+}
+
+func diffContainers(prev, cur []corev1.Container) []dto.DeploymentTemplateChangeDTO {
+	var out []dto.DeploymentTemplateChangeDTO
+	prevByName := make(map[string]corev1.Container, len(prev))
+	for _, c := range prev {
+		prevByName[c.Name] = c
+	}
+	seen := map[string]bool{}
+	for _, c := range cur {
+		seen[c.Name] = true
+		old, ok := prevByName[c.Name]
+		if !ok {
+			out = append(out, dto.DeploymentTemplateChangeDTO{Field: "container", Change: "added", Container: c.Name, To: c.Image})
+			continue
+		}
+		if old.Image != c.Image {
+			out = append(out, dto.DeploymentTemplateChangeDTO{Field: "image", Change: "changed", Container: c.Name, From: old.Image, To: c.Image})
+		}
+		out = append(out, diffStringMaps("env", c.Name, envMap(old.Env), envMap(c.Env))...)
+	}
+	for _, c := range prev {
+		if !seen[c.Name] {
+			out = append(out, dto.DeploymentTemplateChangeDTO{Field: "container", Change: "removed", Container: c.Name, From: c.Image})
+		}
+	}
+	return out
+}
+
+func diffStringMaps(field, container string, prev, cur map[string]string) []dto.DeploymentTemplateChangeDTO {
+	keys := make([]string, 0, len(prev)+len(cur))
+	for k := range prev {
+		keys = append(keys, k)
+	}
+	for k := range cur {
+		if _, ok := prev[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var out []dto.DeploymentTemplateChangeDTO
+	for _, k := range keys {
+		from, hadPrev := prev[k]
+		to, hasCur := cur[k]
+		change := dto.DeploymentTemplateChangeDTO{Field: field, Container: container, Key: k, From: from, To: to}
+		switch {
+		case !hadPrev:
+			change.Change = "added"
+		case !hasCur:
+			change.Change = "removed"
+		case from != to:
+			change.Change = "changed"
+		default:
+			continue
+		}
+		out = append(out, change)
+	}
+	return out
+}
+
+// envMap renders env vars by name; references are shown as their source, never resolved.
+func envMap(env []corev1.EnvVar) map[string]string {
+	out := make(map[string]string, len(env))
+	for _, e := range env {
+		out[e.Name] = envValueString(e)
+	}
+	return out
+}
+
+func envValueString(e corev1.EnvVar) string {
+	src := e.ValueFrom
+	switch {
+	case src == nil:
+		return e.Value
+	case src.SecretKeyRef != nil:
+		return "secret:" + src.SecretKeyRef.Name + "/" + src.SecretKeyRef.Key
+	case src.ConfigMapKeyRef != nil:
+		return "configmap:" + src.ConfigMapKeyRef.Name + "/" + src.ConfigMapKeyRef.Key
+	case src.FieldRef != nil:
+		return "field:" + src.FieldRef.FieldPath
+	case src.ResourceFieldRef != nil:
+		return "resource:" + src.ResourceFieldRef.Resource
+	}
+	return "(valueFrom)"
+}
+
+func withoutTemplateHash(labels map[string]string) map[string]string {
+	if _, ok := labels[appsv1.DefaultDeploymentUniqueLabelKey]; !ok {
+		return labels
+	}
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if k != appsv1.DefaultDeploymentUniqueLabelKey {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package deployments
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func historyReplicaSet(name, revision string, owner types.UID, image string, env ...corev1.EnvVar) appsv1.ReplicaSet {
+	return appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Annotations:     map[string]string{RevisionAnnotation: revision},
+			OwnerReferences: []metav1.OwnerReference{{UID: owner, Controller: boolPtr(true)}},
+		},
+		Spec: appsv1.ReplicaSetSpec{Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", appsv1.DefaultDeploymentUniqueLabelKey: name}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image, Env: env}}},
+		}},
+	}
+}
+
+func TestBuildRolloutHistory_DiffsAgainstPreviousRevision(t *testing.T) {
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		UID:         "dep-uid",
+		Annotations: map[string]string{RevisionAnnotation: "3"},
+	}}
+	v1 := historyReplicaSet("web-1", "1", "dep-uid", "web:1", corev1.EnvVar{Name: "MODE", Value: "a"})
+	v2 := historyReplicaSet("web-2", "2", "dep-uid", "web:2", corev1.EnvVar{Name: "MODE", Value: "b"})
+	v3 := historyReplicaSet("web-3", "3", "dep-uid", "web:2",
+		corev1.EnvVar{Name: "MODE", Value: "b"},
+		corev1.EnvVar{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "token",
+		}}},
+	)
+	v3.Spec.Template.Annotations = map[string]string{"kubectl.kubernetes.io/restartedAt": "now"}
+	foreign := historyReplicaSet("other-1", "9", "other-uid", "other:1")
+
+	items := buildRolloutHistory(dep, []appsv1.ReplicaSet{v3, foreign, v1, v2})
+	if len(items) != 3 || items[0].Revision != 3 || items[2].Revision != 1 {
+		t.Fatalf("revisions: got %+v", items)
+	}
+	if !items[0].Current || items[1].Current {
+		t.Fatalf("current flag: got %+v", items)
+	}
+	if len(items[2].Changes) != 0 {
+		t.Fatalf("oldest revision should have no diff: got %+v", items[2].Changes)
+	}
+
+	rev2 := items[1].Changes
+	if len(rev2) != 2 || rev2[0].Field != "image" || rev2[0].From != "web:1" || rev2[0].To != "web:2" ||
+		rev2[1].Field != "env" || rev2[1].Key != "MODE" || rev2[1].Change != "changed" {
+		t.Fatalf("revision 2 changes (pod-template-hash must be ignored): got %+v", rev2)
+	}
+
+	rev3 := items[0].Changes
+	if len(rev3) != 2 || rev3[0].Field != "annotation" || rev3[0].Change != "added" ||
+		rev3[1].Field != "env" || rev3[1].Key != "TOKEN" || rev3[1].To != "secret:creds/token" {
+		t.Fatalf("revision 3 changes: got %+v", rev3)
+	}
+}
+
+func TestDiffPodTemplates_ContainerAddedAndRemoved(t *testing.T) {
+	prev := corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "a"}, {Name: "old", Image: "o"}}}}
+	cur := corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "a"}, {Name: "new", Image: "n"}}}}
+	changes := diffPodTemplates(prev, cur)
+	if len(changes) != 2 || changes[0].Container != "new" || changes[0].Change != "added" || changes[1].Container != "old" || changes[1].Change != "removed" {
+		t.Fatalf("changes: got %+v", changes)
+	}
+}
@@ -0,0 +1,169 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	kubeactions "github.com/korex-labs/kview/v5/internal/kube/actions"
+)
+
+// HandleDeploymentRollback dispatches the "deployment.rollback" action from the unified
+// ActionRegistry. It restores the pod template of an earlier revision, like kubectl
+// rollout undo. params.revision selects the revision; when omitted (or 0) the revision
+// before the current one is used.
+func HandleDeploymentRollback(ctx context.Context, c *cluster.Clients, req kubeactions.ActionRequest) (*kubeactions.ActionResult, error) {
+	if req.Group != "apps" || req.Resource != "deployments" {
+		return &kubeactions.ActionResult{Status: "error", Message: fmt.Sprintf("unsupported resource %q in group %q, expected apps deployments", req.Resource, req.Group)}, nil
+	}
+	if req.Namespace == "" || req.Name == "" {
+		return &kubeactions.ActionResult{Status: "error", Message: "namespace and name are required"}, nil
+	}
+	revision, errResult := rollbackRevisionParam(req.Params)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	dep, err := c.Clientset.AppsV1().Deployments(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if dep.Spec.Paused {
+		return &kubeactions.ActionResult{Status: "error", Message: fmt.Sprintf("Deployment %s/%s is paused; resume it before rolling back", req.Namespace, req.Name)}, nil
+	}
+	listOpts := metav1.ListOptions{}
+	if dep.Spec.Selector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(dep.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		listOpts.LabelSelector = sel.String()
+	}
+	rss, err := c.Clientset.AppsV1().ReplicaSets(req.Namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	target, errResult := rollbackTarget(dep, rss.Items, revision)
+	if errResult != nil {
+		return errResult, nil
+	}
+	targetRevision := target.Annotations[RevisionAnnotation]
+
+	tmpl := target.Spec.Template.DeepCopy()
+	delete(tmpl.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	if _, err := c.Clientset.AppsV1().Deployments(req.Namespace).Patch(
+		ctx, req.Name, types.JSONPatchType, rollbackPatch(dep.ResourceVersion, tmpl, rollbackAnnotations(dep, target)), metav1.PatchOptions{},
+	); err != nil {
+		if kubeactions.IsResourceVersionConflict(err) {
+			return &kubeactions.ActionResult{
+				Status:  "error",
+				Message: fmt.Sprintf("Deployment %s/%s changed while rolling back; reload and retry", req.Namespace, req.Name),
+				Details: map[string]any{"namespace": req.Namespace, "name": req.Name, "reason": "Conflict"},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &kubeactions.ActionResult{
+		Status:  "ok",
+		Message: fmt.Sprintf("Rolled back %s/%s to revision %s", req.Namespace, req.Name, targetRevision),
+		Details: map[string]any{
+			"namespace":  req.Namespace,
+			"name":       req.Name,
+			"revision":   targetRevision,
+			"replicaSet": target.Name,
+		},
+	}, nil
+}
+
+// rollbackRevisionParam reads the optional params.revision; 0 means the previous revision.
+func rollbackRevisionParam(params map[string]any) (int32, *kubeactions.ActionResult) {
+	raw, ok := params["revision"]
+	if !ok {
+		return 0, nil
+	}
+	f, isNumber := raw.(float64)
+	if !isNumber || f < 0 || f > math.MaxInt32 || f != math.Trunc(f) {
+		return 0, &kubeactions.ActionResult{Status: "error", Message: "params.revision must be an integer >= 0"}
+	}
+	return int32(f), nil
+}
+
+// rollbackSkippedAnnotations are the Deployment annotations a rollback keeps instead of
+// copying them from the target ReplicaSet, as kubectl rollout undo does.
+var rollbackSkippedAnnotations = map[string]bool{
+	corev1.LastAppliedConfigAnnotation:          true,
+	RevisionAnnotation:                          true,
+	"deployment.kubernetes.io/revision-history": true,
+	"deployment.kubernetes.io/desired-replicas": true,
+	"deployment.kubernetes.io/max-replicas":     true,
+	"deprecated.deployment.rollback.to":         true,
+}
+
+// rollbackAnnotations returns the Deployment annotations after a rollback to target: the
+// skipped keys from the Deployment, everything else (such as the change-cause) from target.
+func rollbackAnnotations(dep *appsv1.Deployment, target *appsv1.ReplicaSet) map[string]string {
+	out := map[string]string{}
+	for k, v := range dep.Annotations {
+		if rollbackSkippedAnnotations[k] {
+			out[k] = v
+		}
+	}
+	for k, v := range target.Annotations {
+		if !rollbackSkippedAnnotations[k] {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// rollbackPatch restores tmpl and annotations, guarded by the resourceVersion the target
+// revision was chosen from: if the deployment changed since, the test op fails and
+// nothing is applied.
+func rollbackPatch(resourceVersion string, tmpl *corev1.PodTemplateSpec, annotations map[string]string) []byte {
+	patch, _ := json.Marshal([]map[string]any{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": resourceVersion},
+		{"op": "replace", "path": "/spec/template", "value": tmpl},
+		{"op": "add", "path": "/metadata/annotations", "value": annotations},
+	})
+	return patch
+}
+
+// rollbackTarget picks the owned ReplicaSet for revision, or the newest revision older
+// than the current one when revision is 0.
+func rollbackTarget(dep *appsv1.Deployment, rss []appsv1.ReplicaSet, revision int32) (*appsv1.ReplicaSet, *kubeactions.ActionResult) {
+	current := Revision(dep)
+	var target *appsv1.ReplicaSet
+	var targetRev int32
+	owned := OwnedRevisions(dep, rss)
+	for i := range owned {
+		rs := &owned[i]
+		rev := Revision(rs)
+		if revision > 0 {
+			if rev == revision {
+				target, targetRev = rs, rev
+			}
+			continue
+		}
+		if rev < current && rev > targetRev {
+			target, targetRev = rs, rev
+		}
+	}
+	switch {
+	case target == nil && revision > 0:
+		return nil, &kubeactions.ActionResult{Status: "error", Message: fmt.Sprintf("revision %d not found", revision)}
+	case target == nil:
+		return nil, &kubeactions.ActionResult{Status: "error", Message: "no previous revision to roll back to"}
+	case targetRev == current:
+		return nil, &kubeactions.ActionResult{Status: "error", Message: fmt.Sprintf("revision %d is already the current revision", targetRev)}
+	}
+	return target, nil
+}
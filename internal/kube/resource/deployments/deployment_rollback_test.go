@@ -0,0 +1,94 @@
+package deployments
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRollbackTarget(t *testing.T) {
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		UID:         "dep",
+		Annotations: map[string]string{RevisionAnnotation: "4"},
+	}}
+	rss := []appsv1.ReplicaSet{
+		historyReplicaSet("web-1", "1", "dep", "app:v1"),
+		historyReplicaSet("web-3", "3", "dep", "app:v3"),
+		historyReplicaSet("web-4", "4", "dep", "app:v4"),
+		historyReplicaSet("other-2", "2", "other", "app:v2"),
+	}
+
+	if rs, result := rollbackTarget(dep, rss, 0); result != nil || rs.Name != "web-3" {
+		t.Fatalf("previous revision: got %v, %#v", rs, result)
+	}
+	if rs, result := rollbackTarget(dep, rss, 1); result != nil || rs.Name != "web-1" {
+		t.Fatalf("explicit revision: got %v, %#v", rs, result)
+	}
+	if _, result := rollbackTarget(dep, rss, 2); result == nil || result.Message != "revision 2 not found" {
+		t.Fatalf("foreign revision: got %#v", result)
+	}
+	if _, result := rollbackTarget(dep, rss, 4); result == nil || result.Status != "error" {
+		t.Fatalf("current revision: got %#v", result)
+	}
+	if _, result := rollbackTarget(dep, rss[2:3], 0); result == nil || result.Message != "no previous revision to roll back to" {
+		t.Fatalf("no previous: got %#v", result)
+	}
+}
+
+func TestRollbackRevisionParam(t *testing.T) {
+	if rev, result := rollbackRevisionParam(nil); rev != 0 || result != nil {
+		t.Fatalf("absent: got %d %#v", rev, result)
+	}
+	if rev, result := rollbackRevisionParam(map[string]any{"revision": float64(3)}); rev != 3 || result != nil {
+		t.Fatalf("valid: got %d %#v", rev, result)
+	}
+	for _, bad := range []any{"3", float64(-1), float64(1.5)} {
+		if _, result := rollbackRevisionParam(map[string]any{"revision": bad}); result == nil {
+			t.Fatalf("expected %v to be rejected", bad)
+		}
+	}
+}
+
+func TestRollbackPatchIsGuardedByResourceVersion(t *testing.T) {
+	var ops []map[string]any
+	if err := json.Unmarshal(rollbackPatch("42", &corev1.PodTemplateSpec{}, map[string]string{}), &ops); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(ops) != 3 || ops[0]["op"] != "test" || ops[0]["path"] != "/metadata/resourceVersion" || ops[0]["value"] != "42" {
+		t.Fatalf("expected a leading resourceVersion test op, got %v", ops)
+	}
+	if ops[1]["op"] != "replace" || ops[1]["path"] != "/spec/template" {
+		t.Fatalf("unexpected template op: %v", ops[1])
+	}
+	if ops[2]["op"] != "add" || ops[2]["path"] != "/metadata/annotations" {
+		t.Fatalf("unexpected annotations op: %v", ops[2])
+	}
+}
+
+func TestRollbackAnnotationsCopyChangeCause(t *testing.T) {
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		RevisionAnnotation:                          "4",
+		"deployment.kubernetes.io/desired-replicas": "3",
+		changeCauseAnnotation:                       "bump to v4",
+		"team":                                      "web",
+	}}}
+	target := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		RevisionAnnotation:                          "1",
+		"deployment.kubernetes.io/desired-replicas": "1",
+		changeCauseAnnotation:                       "initial v1",
+	}}}
+
+	got := rollbackAnnotations(dep, target)
+	want := map[string]string{
+		RevisionAnnotation:                          "4",
+		"deployment.kubernetes.io/desired-replicas": "3",
+		changeCauseAnnotation:                       "initial v1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("annotations = %v, want %v", got, want)
+	}
+}
@@ -14,44 +14,64 @@ import (
 )
 
 func ListDeployments(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.DeploymentListItemDTO, error) {
+	items, _, err := ListDeploymentsWithResourceVersion(ctx, c, namespace)
+	return items, err
+}
+
+// ListDeploymentsWithResourceVersion is ListDeployments plus the list resourceVersion,
+// so a watch can start exactly where the list ended.
+func ListDeploymentsWithResourceVersion(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.DeploymentListItemDTO, string, error) {
 	deps, err := c.Clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	now := time.Now()
 	out := make([]dto.DeploymentListItemDTO, 0, len(deps.Items))
 	for _, d := range deps.Items {
-		desired := int32(0)
-		if d.Spec.Replicas != nil {
-			desired = *d.Spec.Replicas
-		}
+		out = append(out, DeploymentListItem(d, now))
+	}
+	return out, deps.ResourceVersion, nil
+}
 
-		age := int64(0)
-		if !d.CreationTimestamp.IsZero() {
-			age = int64(now.Sub(d.CreationTimestamp.Time).Seconds())
-		}
+// DeploymentListItem converts a single deployment into its list row.
+func DeploymentListItem(d appsv1.Deployment, now time.Time) dto.DeploymentListItemDTO {
+	desired := int32(0)
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
 
-		strategy := string(d.Spec.Strategy.Type)
-		if strategy == "" {
-			strategy = "RollingUpdate"
-		}
+	age := int64(0)
+	if !d.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(d.CreationTimestamp.Time).Seconds())
+	}
+
+	strategy := string(d.Spec.Strategy.Type)
+	if strategy == "" {
+		strategy = "RollingUpdate"
+	}
 
-		status := DeploymentStatus(d, desired)
+	selector := ""
+	if d.Spec.Selector != nil {
+		if sel, err := metav1.LabelSelectorAsSelector(d.Spec.Selector); err == nil {
+			selector = sel.String()
+		}
+	}
 
-		out = append(out, dto.DeploymentListItemDTO{
-			Name:                d.Name,
-			Namespace:           d.Namespace,
-			Ready:               pods.FmtReady(int(d.Status.AvailableReplicas), int(desired)),
-			UpToDate:            d.Status.UpdatedReplicas,
-			Available:           d.Status.AvailableReplicas,
-			Strategy:            strategy,
-			AgeSec:              age,
-			LastRolloutComplete: deploymentLastRolloutComplete(d),
-			Status:              status,
-		})
+	return dto.DeploymentListItemDTO{
+		Name:                d.Name,
+		Namespace:           d.Namespace,
+		Ready:               pods.FmtReady(int(d.Status.AvailableReplicas), int(desired)),
+		UpToDate:            d.Status.UpdatedReplicas,
+		Available:           d.Status.AvailableReplicas,
+		Strategy:            strategy,
+		AgeSec:              age,
+		LastRolloutComplete: deploymentLastRolloutComplete(d),
+		Status:              DeploymentStatus(d, desired),
+		Replicas:            desired,
+		Selector:            selector,
+		References:          pods.SpecReferences(d.Spec.Template.Spec),
 	}
-	return out, nil
 }
 
 func deploymentLastRolloutComplete(d appsv1.Deployment) int64 {
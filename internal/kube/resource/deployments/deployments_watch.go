@@ -0,0 +1,31 @@
+package deployments
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// WatchDeployments opens a deployment watch starting after resourceVersion with bookmarks enabled.
+func WatchDeployments(ctx context.Context, c *cluster.Clients, namespace, resourceVersion string) (watch.Interface, error) {
+	return c.Clientset.AppsV1().Deployments(namespace).Watch(ctx, metav1.ListOptions{
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+}
+
+// DeploymentListItemFromObject converts a watch event object into a deployment list row.
+func DeploymentListItemFromObject(obj runtime.Object, now time.Time) (dto.DeploymentListItemDTO, bool) {
+	d, ok := obj.(*appsv1.Deployment)
+	if !ok || d == nil {
+		return dto.DeploymentListItemDTO{}, false
+	}
+	return DeploymentListItem(*d, now), true
+}
@@ -61,7 +61,8 @@ func GetHorizontalPodAutoscalerDetails(ctx context.Context, c *cluster.Clients,
 			Labels:      hpa.Labels,
 			Annotations: hpa.Annotations,
 		},
-		YAML: string(y),
+		Deletion: kube.DeletionState(hpa),
+		YAML:     string(y),
 	}, nil
 }
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListIngresses(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.IngressListItemDTO, error) {
@@ -45,6 +46,8 @@ func ListIngresses(ctx context.Context, c *cluster.Clients, namespace string) ([
 			TLSCount:         int32(len(ing.Spec.TLS)),
 			Addresses:        addresses,
 			AgeSec:           age,
+			References:       ingressReferences(&ing),
+			BackendServices:  ingressBackendServices(&ing),
 		})
 	}
 
@@ -143,3 +146,50 @@ func defaultIngressClassName(ctx context.Context, c *cluster.Clients) string {
 	}
 	return "default"
 }
+
+// ingressReferences returns the TLS Secrets of an Ingress.
+func ingressReferences(ing *networkingv1.Ingress) []dto.ResourceReferenceDTO {
+	var out []dto.ResourceReferenceDTO
+	seen := map[string]struct{}{}
+	for _, tls := range ing.Spec.TLS {
+		if tls.SecretName == "" {
+			continue
+		}
+		if _, ok := seen[tls.SecretName]; ok {
+			continue
+		}
+		seen[tls.SecretName] = struct{}{}
+		out = append(out, dto.ResourceReferenceDTO{Kind: kubepods.RefKindSecret, Name: tls.SecretName, Via: kubepods.RefViaTLS})
+	}
+	return out
+}
+
+// ingressBackendServices returns the sorted, distinct Services an Ingress routes to.
+func ingressBackendServices(ing *networkingv1.Ingress) []string {
+	seen := map[string]struct{}{}
+	add := func(backend networkingv1.IngressBackend) {
+		if name, _ := ingressBackendService(backend); name != "" {
+			seen[name] = struct{}{}
+		}
+	}
+	if ing.Spec.DefaultBackend != nil {
+		add(*ing.Spec.DefaultBackend)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			add(p.Backend)
+		}
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
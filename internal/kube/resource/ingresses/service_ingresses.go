@@ -45,6 +45,8 @@ func ListIngressesForService(ctx context.Context, c *cluster.Clients, namespace,
 			TLSCount:         int32(len(ing.Spec.TLS)),
 			Addresses:        mapIngressLoadBalancerIngress(ing.Status.LoadBalancer.Ingress),
 			AgeSec:           age,
+			References:       ingressReferences(&ing),
+			BackendServices:  ingressBackendServices(&ing),
 		})
 	}
 
@@ -115,6 +115,7 @@ func GetJobDetails(ctx context.Context, c *cluster.Clients, namespace, name stri
 		Spec:       spec,
 		Metadata:   metadata,
 		Selector:   selector,
+		Deletion:   kube.DeletionState(job),
 		YAML:       string(y),
 	}, nil
 }
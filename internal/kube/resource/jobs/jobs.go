@@ -10,6 +10,7 @@ import (
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListJobs(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.JobDTO, error) {
@@ -35,6 +36,8 @@ func ListJobs(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.
 			DurationSec: JobDurationSec(&job),
 			AgeSec:      age,
 			Status:      JobStatus(&job),
+			References:  kubepods.SpecReferences(job.Spec.Template.Spec),
+			Owner:       kubepods.ControllerOwner(&job),
 		})
 	}
 
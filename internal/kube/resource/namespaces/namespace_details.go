@@ -49,6 +49,7 @@ func GetNamespaceDetails(ctx context.Context, c *cluster.Clients, name string) (
 			Annotations: ns.Annotations,
 		},
 		Conditions: conditions,
+		Deletion:   kube.DeletionState(ns),
 		YAML:       string(y),
 	}, nil
 }
@@ -0,0 +1,181 @@
+package namespaces
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+const (
+	maxConcurrentRemainingLists = 5
+	// remainingItemsPerResource caps the objects listed per resource; the count still
+	// reflects the full total when the API server reports it.
+	remainingItemsPerResource = 50
+)
+
+type remainingTarget struct {
+	gvr  schema.GroupVersionResource
+	kind string
+}
+
+// ListRemainingResources reports the objects still present in a namespace with the
+// finalizers holding each one. Resources are only enumerated while the namespace is
+// Terminating; API groups that fail discovery or listing are reported in Errors.
+func ListRemainingResources(ctx context.Context, c *cluster.Clients, name string) (*dto.NamespaceRemainingResourcesDTO, error) {
+	ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.NamespaceRemainingResourcesDTO{
+		Namespace:   ns.Name,
+		Phase:       string(ns.Status.Phase),
+		Terminating: ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating,
+		Conditions:  mapNamespaceConditions(ns.Status.Conditions),
+		Resources:   []dto.NamespaceRemainingResourceDTO{},
+	}
+	if ns.DeletionTimestamp != nil {
+		out.DeletionTimestamp = ns.DeletionTimestamp.Unix()
+	}
+	for _, f := range ns.Spec.Finalizers {
+		out.SpecFinalizers = append(out.SpecFinalizers, string(f))
+	}
+	if !out.Terminating {
+		return out, nil
+	}
+
+	lists, err := c.Discovery.ServerPreferredNamespacedResources()
+	if err != nil {
+		var groupErr *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &groupErr) {
+			return nil, err
+		}
+		for gv, gerr := range groupErr.Groups {
+			out.Errors = append(out.Errors, dto.NamespaceRemainingErrorDTO{Group: gv.String(), Error: gerr.Error()})
+		}
+	}
+
+	dyn, err := dynamic.NewForConfig(c.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := listableNamespacedResources(lists)
+	results := make([]remainingResult, len(targets))
+	sem := make(chan struct{}, maxConcurrentRemainingLists)
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		i, t := i, t
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = listRemaining(ctx, dyn, t, name)
+		}()
+	}
+	wg.Wait()
+
+	for i, r := range results {
+		if r.err != nil {
+			out.Errors = append(out.Errors, dto.NamespaceRemainingErrorDTO{
+				Group:    targets[i].gvr.Group,
+				Resource: targets[i].gvr.Resource,
+				Error:    r.err.Error(),
+			})
+			continue
+		}
+		if r.resource.Count > 0 {
+			out.Resources = append(out.Resources, r.resource)
+		}
+	}
+	sort.Slice(out.Errors, func(i, j int) bool {
+		if out.Errors[i].Group != out.Errors[j].Group {
+			return out.Errors[i].Group < out.Errors[j].Group
+		}
+		return out.Errors[i].Resource < out.Errors[j].Resource
+	})
+	return out, nil
+}
+
+type remainingResult struct {
+	resource dto.NamespaceRemainingResourceDTO
+	err      error
+}
+
+func listRemaining(ctx context.Context, dyn dynamic.Interface, t remainingTarget, namespace string) remainingResult {
+	list, err := dyn.Resource(t.gvr).Namespace(namespace).List(ctx, metav1.ListOptions{Limit: remainingItemsPerResource})
+	if err != nil {
+		return remainingResult{err: err}
+	}
+	res := dto.NamespaceRemainingResourceDTO{
+		Group:    t.gvr.Group,
+		Version:  t.gvr.Version,
+		Resource: t.gvr.Resource,
+		Kind:     t.kind,
+		Count:    len(list.Items),
+		Items:    make([]dto.NamespaceRemainingItemDTO, 0, len(list.Items)),
+	}
+	if list.GetContinue() != "" {
+		res.Truncated = true
+		if rest := list.GetRemainingItemCount(); rest != nil {
+			res.Count += int(*rest)
+		}
+	}
+	for _, obj := range list.Items {
+		item := dto.NamespaceRemainingItemDTO{Name: obj.GetName(), Finalizers: obj.GetFinalizers()}
+		if ts := obj.GetDeletionTimestamp(); ts != nil {
+			item.DeletionTimestamp = ts.Unix()
+		}
+		res.Items = append(res.Items, item)
+	}
+	return remainingResult{resource: res}
+}
+
+// listableNamespacedResources returns the resources that can be listed, skipping
+// subresources and ordered by group then resource.
+func listableNamespacedResources(lists []*metav1.APIResourceList) []remainingTarget {
+	var out []remainingTarget
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") || !r.Namespaced || !hasVerb(r.Verbs, "list") {
+				continue
+			}
+			out = append(out, remainingTarget{gvr: gv.WithResource(r.Name), kind: r.Kind})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].gvr.Group != out[j].gvr.Group {
+			return out[i].gvr.Group < out[j].gvr.Group
+		}
+		return out[i].gvr.Resource < out[j].gvr.Resource
+	})
+	return out
+}
+
+func hasVerb(verbs metav1.Verbs, verb string) bool {
+	for _, v := range verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package namespaces
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListableNamespacedResources(t *testing.T) {
+	lists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "delete"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get"}},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: metav1.Verbs{"create"}},
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: metav1.Verbs{"list"}},
+			},
+		},
+		nil,
+		{
+			GroupVersion: "example.io/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Kind: "Widget", Namespaced: true, Verbs: metav1.Verbs{"list"}},
+			},
+		},
+	}
+	got := listableNamespacedResources(lists)
+	want := []string{"/configmaps", "/pods", "example.io/widgets"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d targets, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if key := got[i].gvr.Group + "/" + got[i].gvr.Resource; key != w {
+			t.Fatalf("target %d: got %q, want %q", i, key, w)
+		}
+	}
+	if got[2].gvr.Version != "v1alpha1" || got[2].kind != "Widget" {
+		t.Fatalf("widget target: got %+v", got[2])
+	}
+}
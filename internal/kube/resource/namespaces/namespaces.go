@@ -11,36 +11,29 @@ import (
 )
 
 func ListNamespaces(ctx context.Context, c *cluster.Clients) ([]dto.NamespaceListItemDTO, error) {
+	items, _, err := ListNamespacesWithResourceVersion(ctx, c, "")
+	return items, err
+}
+
+// ListNamespacesWithResourceVersion is ListNamespaces plus the list resourceVersion,
+// so a watch can start exactly where the list ended. The namespace argument is
+// ignored; it keeps the signature aligned with the namespaced list helpers.
+func ListNamespacesWithResourceVersion(ctx context.Context, c *cluster.Clients, _ string) ([]dto.NamespaceListItemDTO, string, error) {
 	nsList, err := c.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	now := time.Now()
 	out := make([]dto.NamespaceListItemDTO, 0, len(nsList.Items))
 	for _, ns := range nsList.Items {
-		age := int64(0)
-		if !ns.CreationTimestamp.IsZero() {
-			age = int64(now.Sub(ns.CreationTimestamp.Time).Seconds())
-		}
-		out = append(out, dto.NamespaceListItemDTO{
-			Name:                   ns.Name,
-			Phase:                  string(ns.Status.Phase),
-			AgeSec:                 age,
-			HasUnhealthyConditions: hasUnhealthyNamespaceConditions(ns.Status.Conditions),
-		})
+		out = append(out, NamespaceListItem(ns, now))
 	}
-	return out, nil
+	return out, nsList.ResourceVersion, nil
 }
 
-// GetNamespaceListFields performs a single-namespace GET for progressive list enrichment (stage 2).
-// It avoids YAML serialization from GetNamespaceDetails.
-func GetNamespaceListFields(ctx context.Context, c *cluster.Clients, name string) (dto.NamespaceListItemDTO, error) {
-	ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		return dto.NamespaceListItemDTO{}, err
-	}
-	now := time.Now()
+// NamespaceListItem converts a single namespace into its list row.
+func NamespaceListItem(ns corev1.Namespace, now time.Time) dto.NamespaceListItemDTO {
 	age := int64(0)
 	if !ns.CreationTimestamp.IsZero() {
 		age = int64(now.Sub(ns.CreationTimestamp.Time).Seconds())
@@ -50,7 +43,18 @@ func GetNamespaceListFields(ctx context.Context, c *cluster.Clients, name string
 		Phase:                  string(ns.Status.Phase),
 		AgeSec:                 age,
 		HasUnhealthyConditions: hasUnhealthyNamespaceConditions(ns.Status.Conditions),
-	}, nil
+		Labels:                 ns.Labels,
+	}
+}
+
+// GetNamespaceListFields performs a single-namespace GET for progressive list enrichment (stage 2).
+// It avoids YAML serialization from GetNamespaceDetails.
+func GetNamespaceListFields(ctx context.Context, c *cluster.Clients, name string) (dto.NamespaceListItemDTO, error) {
+	ns, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return dto.NamespaceListItemDTO{}, err
+	}
+	return NamespaceListItem(*ns, time.Now()), nil
 }
 
 func ListNamespacesFallback(ctx context.Context, c *cluster.Clients) ([]dto.NamespaceListItemDTO, error) {
@@ -0,0 +1,31 @@
+package namespaces
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// WatchNamespaces opens a namespace watch starting after resourceVersion with bookmarks enabled.
+func WatchNamespaces(ctx context.Context, c *cluster.Clients, _ string, resourceVersion string) (watch.Interface, error) {
+	return c.Clientset.CoreV1().Namespaces().Watch(ctx, metav1.ListOptions{
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+}
+
+// NamespaceListItemFromObject converts a watch event object into a namespace list row.
+func NamespaceListItemFromObject(obj runtime.Object, now time.Time) (dto.NamespaceListItemDTO, bool) {
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok || ns == nil {
+		return dto.NamespaceListItemDTO{}, false
+	}
+	return NamespaceListItem(*ns, now), true
+}
@@ -0,0 +1,140 @@
+package networkpolicies
+
+import (
+	"context"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func ListNetworkPolicies(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.NetworkPolicyDTO, error) {
+	items, err := c.Clientset.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	out := make([]dto.NetworkPolicyDTO, 0, len(items.Items))
+	for _, np := range items.Items {
+		out = append(out, mapNetworkPolicy(np, now))
+	}
+	return out, nil
+}
+
+func GetNetworkPolicyDetails(ctx context.Context, c *cluster.Clients, namespace, name string) (*dto.NetworkPolicyDetailsDTO, error) {
+	np, err := c.Clientset.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	npCopy := np.DeepCopy()
+	npCopy.ManagedFields = nil
+	y, err := kube.MarshalObjectYAML(npCopy, "networking.k8s.io/v1", "NetworkPolicy")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.NetworkPolicyDetailsDTO{
+		Summary: mapNetworkPolicy(*np, time.Now()),
+		Metadata: dto.NetworkPolicyMetadataDTO{
+			Labels:      np.Labels,
+			Annotations: np.Annotations,
+		},
+		Deletion: kube.DeletionState(np),
+		YAML:     string(y),
+	}, nil
+}
+
+func mapNetworkPolicy(np networkingv1.NetworkPolicy, now time.Time) dto.NetworkPolicyDTO {
+	age := int64(0)
+	if !np.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(np.CreationTimestamp.Time).Seconds())
+	}
+
+	out := dto.NetworkPolicyDTO{
+		Name:        np.Name,
+		Namespace:   np.Namespace,
+		PodSelector: mapLabelSelector(np.Spec.PodSelector),
+		PolicyTypes: policyTypes(np.Spec),
+		AgeSec:      age,
+	}
+	for _, r := range np.Spec.Ingress {
+		out.IngressRules = append(out.IngressRules, mapRule(r.From, r.Ports))
+	}
+	for _, r := range np.Spec.Egress {
+		out.EgressRules = append(out.EgressRules, mapRule(r.To, r.Ports))
+	}
+	return out
+}
+
+// policyTypes applies the API server defaulting for policies created without
+// spec.policyTypes.
+func policyTypes(spec networkingv1.NetworkPolicySpec) []string {
+	if len(spec.PolicyTypes) > 0 {
+		out := make([]string, 0, len(spec.PolicyTypes))
+		for _, t := range spec.PolicyTypes {
+			out = append(out, string(t))
+		}
+		return out
+	}
+	out := []string{string(networkingv1.PolicyTypeIngress)}
+	if len(spec.Egress) > 0 {
+		out = append(out, string(networkingv1.PolicyTypeEgress))
+	}
+	return out
+}
+
+func mapRule(peers []networkingv1.NetworkPolicyPeer, ports []networkingv1.NetworkPolicyPort) dto.NetworkPolicyRuleDTO {
+	var out dto.NetworkPolicyRuleDTO
+	for _, p := range peers {
+		peer := dto.NetworkPolicyPeerDTO{}
+		if p.PodSelector != nil {
+			sel := mapLabelSelector(*p.PodSelector)
+			peer.PodSelector = &sel
+		}
+		if p.NamespaceSelector != nil {
+			sel := mapLabelSelector(*p.NamespaceSelector)
+			peer.NamespaceSelector = &sel
+		}
+		if p.IPBlock != nil {
+			peer.IPBlock = &dto.NetworkPolicyIPBlockDTO{CIDR: p.IPBlock.CIDR, Except: p.IPBlock.Except}
+		}
+		out.Peers = append(out.Peers, peer)
+	}
+	for _, p := range ports {
+		port := dto.NetworkPolicyPortDTO{Protocol: "TCP"}
+		if p.Protocol != nil {
+			port.Protocol = string(*p.Protocol)
+		}
+		if p.Port != nil {
+			port.Port = p.Port.String()
+		}
+		if p.EndPort != nil {
+			port.EndPort = *p.EndPort
+		}
+		out.Ports = append(out.Ports, port)
+	}
+	return out
+}
+
+// mapLabelSelector keeps empty selectors as empty values: in a NetworkPolicy an empty
+// selector means "everything", which differs from an absent one.
+func mapLabelSelector(sel metav1.LabelSelector) dto.LabelSelectorDTO {
+	out := dto.LabelSelectorDTO{}
+	if len(sel.MatchLabels) > 0 {
+		out.MatchLabels = sel.MatchLabels
+	}
+	for _, expr := range sel.MatchExpressions {
+		out.MatchExpressions = append(out.MatchExpressions, dto.LabelSelectorExpression{
+			Key:      expr.Key,
+			Operator: string(expr.Operator),
+			Values:   append([]string{}, expr.Values...),
+		})
+	}
+	return out
+}
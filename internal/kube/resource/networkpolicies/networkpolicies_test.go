@@ -0,0 +1,49 @@
+package networkpolicies
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestMapNetworkPolicy_DefaultsPolicyTypesAndPorts(t *testing.T) {
+	udp := corev1.ProtocolUDP
+	port := intstr.FromString("dns")
+	end := int32(9100)
+	start := intstr.FromInt32(9000)
+	np := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "app"},
+		Spec: networkingv1.NetworkPolicySpec{
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From:  []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &port}, {Port: &start, EndPort: &end}},
+			}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{}},
+		},
+	}
+
+	got := mapNetworkPolicy(np, time.Now())
+	if len(got.PolicyTypes) != 2 || got.PolicyTypes[0] != "Ingress" || got.PolicyTypes[1] != "Egress" {
+		t.Fatalf("policy types: got %v", got.PolicyTypes)
+	}
+	rule := got.IngressRules[0]
+	if rule.Peers[0].NamespaceSelector == nil || rule.Peers[0].PodSelector != nil {
+		t.Fatalf("peer: got %+v", rule.Peers[0])
+	}
+	if rule.Ports[0].Protocol != "UDP" || rule.Ports[0].Port != "dns" || rule.Ports[1].Protocol != "TCP" || rule.Ports[1].Port != "9000" || rule.Ports[1].EndPort != 9100 {
+		t.Fatalf("ports: got %+v", rule.Ports)
+	}
+	if len(got.EgressRules) != 1 || len(got.EgressRules[0].Peers) != 0 {
+		t.Fatalf("egress rules: got %+v", got.EgressRules)
+	}
+
+	np.Spec.Egress = nil
+	np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}
+	if got := mapNetworkPolicy(np, time.Now()); len(got.PolicyTypes) != 1 || got.PolicyTypes[0] != "Egress" {
+		t.Fatalf("explicit policy types: got %v", got.PolicyTypes)
+	}
+}
@@ -60,14 +60,7 @@ func GetNodeDetails(ctx context.Context, c *cluster.Clients, name string) (*dto.
 		PodsAllocatable:   kubepods.QuantityString(node.Status.Allocatable[corev1.ResourcePods]),
 	}
 
-	taints := make([]dto.NodeTaintDTO, 0, len(node.Spec.Taints))
-	for _, t := range node.Spec.Taints {
-		taints = append(taints, dto.NodeTaintDTO{
-			Key:    t.Key,
-			Value:  t.Value,
-			Effect: string(t.Effect),
-		})
-	}
+	taints := mapNodeTaints(node.Spec.Taints)
 
 	pods := []dto.NodePodDTO{}
 	if podList, err := c.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
@@ -87,6 +80,7 @@ func GetNodeDetails(ctx context.Context, c *cluster.Clients, name string) (*dto.
 		Taints:     taints,
 		Pods:       pods,
 		LinkedPods: dto.NodePodsSummaryDTO{Total: len(pods)},
+		Deletion:   kube.DeletionState(node),
 		YAML:       string(y),
 	}, nil
 }
@@ -22,14 +22,10 @@ func ListNodes(ctx context.Context, c *cluster.Clients) ([]dto.NodeListItemDTO,
 		return nil, err
 	}
 
-	podCounts := map[string]int{}
-	if pods, err := listAllPodsForNodeCounts(ctx, c); err == nil {
-		for _, p := range pods.Items {
-			if p.Spec.NodeName == "" {
-				continue
-			}
-			podCounts[p.Spec.NodeName]++
-		}
+	var requested map[string]nodeRequests
+	pods, podsErr := listAllPodsForNodeCounts(ctx, c)
+	if podsErr == nil {
+		requested = sumNodeRequests(pods.Items)
 	}
 
 	now := time.Now()
@@ -47,14 +43,58 @@ func ListNodes(ctx context.Context, c *cluster.Clients) ([]dto.NodeListItemDTO,
 			CPUAllocatable:    kubepods.QuantityString(n.Status.Allocatable[corev1.ResourceCPU]),
 			MemoryAllocatable: kubepods.QuantityString(n.Status.Allocatable[corev1.ResourceMemory]),
 			PodsAllocatable:   kubepods.QuantityString(n.Status.Allocatable[corev1.ResourcePods]),
-			PodsCount:         podCounts[n.Name],
+			PodsCount:         requested[n.Name].pods,
 			KubeletVersion:    n.Status.NodeInfo.KubeletVersion,
 			AgeSec:            age,
+
+			Labels:               n.Labels,
+			Taints:               mapNodeTaints(n.Spec.Taints),
+			Unschedulable:        n.Spec.Unschedulable,
+			CPURequestedMilli:    requested[n.Name].cpuMilli,
+			MemoryRequestedBytes: requested[n.Name].memBytes,
+			RequestsObserved:     podsErr == nil,
 		})
 	}
 	return out, nil
 }
 
+// nodeRequests counts the pods placed on a node and sums their requests.
+type nodeRequests struct {
+	pods     int
+	cpuMilli int64
+	memBytes int64
+}
+
+// sumNodeRequests counts the pods on each node and sums their requests. Terminated pods
+// hold neither a pod slot nor resources, so they are skipped.
+func sumNodeRequests(pods []corev1.Pod) map[string]nodeRequests {
+	out := map[string]nodeRequests{}
+	for _, p := range pods {
+		if p.Spec.NodeName == "" || p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		cpu, mem := kubepods.EffectiveRequests(p.Spec)
+		r := out[p.Spec.NodeName]
+		r.pods++
+		r.cpuMilli += cpu
+		r.memBytes += mem
+		out[p.Spec.NodeName] = r
+	}
+	return out
+}
+
+func mapNodeTaints(taints []corev1.Taint) []dto.NodeTaintDTO {
+	out := make([]dto.NodeTaintDTO, 0, len(taints))
+	for _, t := range taints {
+		out = append(out, dto.NodeTaintDTO{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: string(t.Effect),
+		})
+	}
+	return out
+}
+
 func listAllNodes(ctx context.Context, c *cluster.Clients) (*corev1.NodeList, error) {
 	return listAllNodePages(ctx, c.Clientset.CoreV1().Nodes().List)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,3 +41,28 @@ func TestListNodesFollowsContinueTokens(t *testing.T) {
 		t.Fatalf("unexpected items: %+v", items)
 	}
 }
+
+func TestSumNodeRequestsSkipsTerminatedPods(t *testing.T) {
+	pod := func(node string, phase corev1.PodPhase, cpu string) corev1.Pod {
+		return corev1.Pod{
+			Spec: corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	got := sumNodeRequests([]corev1.Pod{
+		pod("node-a", corev1.PodRunning, "250m"),
+		pod("node-a", corev1.PodPending, "100m"),
+		pod("node-a", corev1.PodSucceeded, "1"),
+		pod("node-a", corev1.PodFailed, "1"),
+		pod("", corev1.PodPending, "1"),
+	})
+
+	if r := got["node-a"]; r.pods != 2 || r.cpuMilli != 350 {
+		t.Fatalf("node-a = %+v, want 2 pods and 350m", r)
+	}
+	if len(got) != 1 {
+		t.Fatalf("unexpected nodes: %+v", got)
+	}
+}
@@ -89,6 +89,8 @@ func GetPersistentVolumeClaimDetails(ctx context.Context, c *cluster.Clients, na
 		Spec:     spec,
 		Status:   status,
 		Metadata: metadata,
+		Storage:  StorageLinks(ctx, c, summary.StorageClassName, pvc.Spec.VolumeName),
+		Deletion: kube.DeletionState(pvc),
 		YAML:     string(y),
 	}, nil
 }
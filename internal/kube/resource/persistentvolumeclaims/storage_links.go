@@ -0,0 +1,34 @@
+package persistentvolumeclaims
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	storageclasses "github.com/korex-labs/kview/v5/internal/kube/resource/storageclasses"
+	volumeattachments "github.com/korex-labs/kview/v5/internal/kube/resource/volumeattachments"
+)
+
+// StorageLinks resolves the StorageClass and VolumeAttachments related to a claim or
+// volume. Links are best-effort detail context: read errors other than a missing class
+// leave the corresponding field empty.
+func StorageLinks(ctx context.Context, c *cluster.Clients, className, pvName string) dto.StorageLinksDTO {
+	var out dto.StorageLinksDTO
+	if className != "" {
+		sc, err := storageclasses.GetStorageClass(ctx, c, className)
+		switch {
+		case err == nil:
+			out.StorageClass = sc
+		case apierrors.IsNotFound(err):
+			out.StorageClassMissing = true
+		}
+	}
+	if pvName != "" {
+		if items, err := volumeattachments.ListVolumeAttachmentsForPersistentVolume(ctx, c, pvName); err == nil {
+			out.Attachments = items
+		}
+	}
+	return out
+}
@@ -71,6 +71,8 @@ func GetPersistentVolumeDetails(ctx context.Context, c *cluster.Clients, name st
 		Spec:     spec,
 		Status:   status,
 		Metadata: metadata,
+		Storage:  pvcs.StorageLinks(ctx, c, pv.Spec.StorageClassName, pv.Name),
+		Deletion: kube.DeletionState(pv),
 		YAML:     string(y),
 	}, nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 	pvcs "github.com/korex-labs/kview/v5/internal/kube/resource/persistentvolumeclaims"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListPersistentVolumes(ctx context.Context, c *cluster.Clients) ([]dto.PersistentVolumeDTO, error) {
@@ -37,12 +38,21 @@ func ListPersistentVolumes(ctx context.Context, c *cluster.Clients) ([]dto.Persi
 			VolumeMode:       pvcs.VolumeModeString(pv.Spec.VolumeMode),
 			ClaimRef:         pvClaimRefString(pv.Spec.ClaimRef),
 			AgeSec:           age,
+
+			NodeAffinity: pvNodeAffinity(&pv),
 		})
 	}
 
 	return out, nil
 }
 
+func pvNodeAffinity(pv *corev1.PersistentVolume) []dto.NodeSelectorTermDTO {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return nil
+	}
+	return kubepods.MapNodeSelectorTerms(pv.Spec.NodeAffinity.Required.NodeSelectorTerms)
+}
+
 func pvCapacityString(pv *corev1.PersistentVolume) string {
 	if pv == nil {
 		return ""
@@ -0,0 +1,149 @@
+package poddisruptionbudgets
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	pods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
+)
+
+func ListPodDisruptionBudgets(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.PodDisruptionBudgetDTO, error) {
+	items, err := c.Clientset.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	out := make([]dto.PodDisruptionBudgetDTO, 0, len(items.Items))
+	for _, pdb := range items.Items {
+		out = append(out, mapPodDisruptionBudget(pdb, now))
+	}
+	return out, nil
+}
+
+// GetPodDisruptionBudgetDetails returns the PDB with the pods its selector currently matches.
+func GetPodDisruptionBudgetDetails(ctx context.Context, c *cluster.Clients, namespace, name string) (*dto.PodDisruptionBudgetDetailsDTO, error) {
+	pdb, err := c.Clientset.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pdbCopy := pdb.DeepCopy()
+	pdbCopy.ManagedFields = nil
+	y, err := kube.MarshalObjectYAML(pdbCopy, "policy/v1", "PodDisruptionBudget")
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []dto.PodDisruptionBudgetPodDTO{}
+	if pdb.Spec.Selector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		podList, err := c.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+		if err != nil {
+			return nil, err
+		}
+		matched = matchedPods(podList.Items, sel, pdb.Status.DisruptedPods)
+	}
+
+	det := &dto.PodDisruptionBudgetDetailsDTO{
+		Summary: mapPodDisruptionBudget(*pdb, time.Now()),
+		Pods:    matched,
+		Metadata: dto.PodDisruptionBudgetMetadataDTO{
+			Labels:      pdb.Labels,
+			Annotations: pdb.Annotations,
+		},
+		Deletion: kube.DeletionState(pdb),
+		YAML:     string(y),
+	}
+	for _, cond := range pdb.Status.Conditions {
+		item := dto.PodDisruptionBudgetConditionDTO{
+			Type:    cond.Type,
+			Status:  string(cond.Status),
+			Reason:  cond.Reason,
+			Message: cond.Message,
+		}
+		if !cond.LastTransitionTime.IsZero() {
+			item.LastTransitionTime = cond.LastTransitionTime.Unix()
+		}
+		det.Conditions = append(det.Conditions, item)
+	}
+	return det, nil
+}
+
+func mapPodDisruptionBudget(pdb policyv1.PodDisruptionBudget, now time.Time) dto.PodDisruptionBudgetDTO {
+	age := int64(0)
+	if !pdb.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(pdb.CreationTimestamp.Time).Seconds())
+	}
+
+	out := dto.PodDisruptionBudgetDTO{
+		Name:               pdb.Name,
+		Namespace:          pdb.Namespace,
+		CurrentHealthy:     pdb.Status.CurrentHealthy,
+		DesiredHealthy:     pdb.Status.DesiredHealthy,
+		ExpectedPods:       pdb.Status.ExpectedPods,
+		DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+		AgeSec:             age,
+	}
+	if pdb.Spec.MinAvailable != nil {
+		out.MinAvailable = pdb.Spec.MinAvailable.String()
+	}
+	if pdb.Spec.MaxUnavailable != nil {
+		out.MaxUnavailable = pdb.Spec.MaxUnavailable.String()
+	}
+	if pdb.Spec.UnhealthyPodEvictionPolicy != nil {
+		out.UnhealthyPodEvictionPolicy = string(*pdb.Spec.UnhealthyPodEvictionPolicy)
+	}
+	if sel := pdb.Spec.Selector; sel != nil {
+		out.Selector = &dto.LabelSelectorDTO{MatchLabels: sel.MatchLabels}
+		for _, expr := range sel.MatchExpressions {
+			out.Selector.MatchExpressions = append(out.Selector.MatchExpressions, dto.LabelSelectorExpression{
+				Key:      expr.Key,
+				Operator: string(expr.Operator),
+				Values:   append([]string{}, expr.Values...),
+			})
+		}
+		if s, err := metav1.LabelSelectorAsSelector(sel); err == nil {
+			out.SelectorString = s.String()
+		}
+	}
+	return out
+}
+
+func matchedPods(items []corev1.Pod, sel labels.Selector, disrupted map[string]metav1.Time) []dto.PodDisruptionBudgetPodDTO {
+	out := make([]dto.PodDisruptionBudgetPodDTO, 0, len(items))
+	for _, p := range items {
+		if !sel.Matches(labels.Set(p.Labels)) {
+			continue
+		}
+		ready, total := 0, 0
+		for _, cs := range p.Status.ContainerStatuses {
+			total++
+			if cs.Ready {
+				ready++
+			}
+		}
+		_, isDisrupted := disrupted[p.Name]
+		out = append(out, dto.PodDisruptionBudgetPodDTO{
+			Name:      p.Name,
+			Phase:     string(p.Status.Phase),
+			Ready:     pods.FmtReady(ready, total),
+			Node:      p.Spec.NodeName,
+			Disrupted: isDisrupted,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
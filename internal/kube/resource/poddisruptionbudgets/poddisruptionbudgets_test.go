@@ -0,0 +1,51 @@
+package poddisruptionbudgets
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestMapPodDisruptionBudget(t *testing.T) {
+	minAvailable := intstr.FromString("50%")
+	pdb := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "app"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{CurrentHealthy: 2, DesiredHealthy: 2, ExpectedPods: 3},
+	}
+
+	got := mapPodDisruptionBudget(pdb, time.Now())
+	if got.MinAvailable != "50%" || got.MaxUnavailable != "" || got.SelectorString != "app=api" || got.DisruptionsAllowed != 0 || got.ExpectedPods != 3 {
+		t.Fatalf("unexpected mapping: %+v", got)
+	}
+
+	pdb.Spec.Selector = nil
+	if got := mapPodDisruptionBudget(pdb, time.Now()); got.Selector != nil || got.SelectorString != "" {
+		t.Fatalf("expected nil selector, got %+v", got)
+	}
+}
+
+func TestMatchedPods(t *testing.T) {
+	items := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "api-b", Labels: map[string]string{"app": "api"}}, Status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{{Ready: true}}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{"app": "web"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "api-a", Labels: map[string]string{"app": "api"}}, Status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{{Ready: false}}}},
+	}
+	sel := labels.SelectorFromSet(labels.Set{"app": "api"})
+
+	got := matchedPods(items, sel, map[string]metav1.Time{"api-a": metav1.Now()})
+	if len(got) != 2 || got[0].Name != "api-a" || got[1].Name != "api-b" {
+		t.Fatalf("unexpected pods: %+v", got)
+	}
+	if !got[0].Disrupted || got[1].Disrupted || got[0].Ready != "0/1" || got[1].Ready != "1/1" {
+		t.Fatalf("unexpected pod state: %+v", got)
+	}
+}
@@ -2,7 +2,10 @@ package pods
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
 )
 
 // IsPodOwnedBy reports whether pod has an owner reference with the given kind that
@@ -18,3 +21,12 @@ func IsPodOwnedBy(pod *corev1.Pod, kind string, uid types.UID, name string) bool
 	}
 	return false
 }
+
+// ControllerOwner returns the controlling owner reference of obj, or nil.
+func ControllerOwner(obj metav1.Object) *dto.OwnerReferenceDTO {
+	ref := metav1.GetControllerOfNoCopy(obj)
+	if ref == nil {
+		return nil
+	}
+	return &dto.OwnerReferenceDTO{Kind: ref.Kind, Name: ref.Name}
+}
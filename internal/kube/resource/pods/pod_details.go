@@ -160,7 +160,8 @@ func GetPodDetails(ctx context.Context, c *cluster.Clients, namespace, name stri
 			Labels:      pod.Labels,
 			Annotations: pod.Annotations,
 		},
-		YAML: string(y),
+		Deletion: kube.DeletionState(pod),
+		YAML:     string(y),
 	}, nil
 }
 
@@ -0,0 +1,116 @@
+package pods
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// Reference kinds and via values of dto.ResourceReferenceDTO.
+const (
+	RefKindConfigMap      = "ConfigMap"
+	RefKindSecret         = "Secret"
+	RefKindPVC            = "PersistentVolumeClaim"
+	RefKindServiceAccount = "ServiceAccount"
+
+	RefViaEnv             = "env"
+	RefViaEnvFrom         = "envFrom"
+	RefViaVolume          = "volume"
+	RefViaImagePullSecret = "imagePullSecret"
+	RefViaServiceAccount  = "serviceAccount"
+	RefViaTLS             = "tls"
+)
+
+// SpecReferences returns the ConfigMaps, Secrets, PVCs and ServiceAccount a pod spec
+// (or pod template) references, deduplicated. An empty serviceAccountName resolves to
+// "default", which is what the API server assigns to the pods.
+func SpecReferences(spec corev1.PodSpec) []dto.ResourceReferenceDTO {
+	refs := referenceSet{}
+	for _, c := range spec.InitContainers {
+		refs.addContainer(c.Name, c.Env, c.EnvFrom)
+	}
+	for _, c := range spec.Containers {
+		refs.addContainer(c.Name, c.Env, c.EnvFrom)
+	}
+	for _, c := range spec.EphemeralContainers {
+		refs.addContainer(c.Name, c.Env, c.EnvFrom)
+	}
+	for _, v := range spec.Volumes {
+		refs.addVolume(v)
+	}
+	for _, s := range spec.ImagePullSecrets {
+		refs.add(RefKindSecret, s.Name, RefViaImagePullSecret, "")
+	}
+	sa := spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	refs.add(RefKindServiceAccount, sa, RefViaServiceAccount, "")
+	return refs.items
+}
+
+type referenceSet struct {
+	items []dto.ResourceReferenceDTO
+	seen  map[dto.ResourceReferenceDTO]struct{}
+}
+
+func (s *referenceSet) add(kind, name, via, source string) {
+	if name == "" {
+		return
+	}
+	ref := dto.ResourceReferenceDTO{Kind: kind, Name: name, Via: via, Source: source}
+	if s.seen == nil {
+		s.seen = map[dto.ResourceReferenceDTO]struct{}{}
+	}
+	if _, ok := s.seen[ref]; ok {
+		return
+	}
+	s.seen[ref] = struct{}{}
+	s.items = append(s.items, ref)
+}
+
+func (s *referenceSet) addContainer(name string, env []corev1.EnvVar, envFrom []corev1.EnvFromSource) {
+	source := "container/" + name
+	for _, e := range env {
+		if e.ValueFrom == nil {
+			continue
+		}
+		if ref := e.ValueFrom.ConfigMapKeyRef; ref != nil {
+			s.add(RefKindConfigMap, ref.Name, RefViaEnv, source)
+		}
+		if ref := e.ValueFrom.SecretKeyRef; ref != nil {
+			s.add(RefKindSecret, ref.Name, RefViaEnv, source)
+		}
+	}
+	for _, e := range envFrom {
+		if e.ConfigMapRef != nil {
+			s.add(RefKindConfigMap, e.ConfigMapRef.Name, RefViaEnvFrom, source)
+		}
+		if e.SecretRef != nil {
+			s.add(RefKindSecret, e.SecretRef.Name, RefViaEnvFrom, source)
+		}
+	}
+}
+
+func (s *referenceSet) addVolume(v corev1.Volume) {
+	source := "volume/" + v.Name
+	switch {
+	case v.ConfigMap != nil:
+		s.add(RefKindConfigMap, v.ConfigMap.Name, RefViaVolume, source)
+	case v.Secret != nil:
+		s.add(RefKindSecret, v.Secret.SecretName, RefViaVolume, source)
+	case v.PersistentVolumeClaim != nil:
+		s.add(RefKindPVC, v.PersistentVolumeClaim.ClaimName, RefViaVolume, source)
+	case v.Projected != nil:
+		for _, p := range v.Projected.Sources {
+			if p.ConfigMap != nil {
+				s.add(RefKindConfigMap, p.ConfigMap.Name, RefViaVolume, source)
+			}
+			if p.Secret != nil {
+				s.add(RefKindSecret, p.Secret.Name, RefViaVolume, source)
+			}
+		}
+	case v.CSI != nil && v.CSI.NodePublishSecretRef != nil:
+		s.add(RefKindSecret, v.CSI.NodePublishSecretRef.Name, RefViaVolume, source)
+	}
+}
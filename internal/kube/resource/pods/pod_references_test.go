@@ -0,0 +1,57 @@
+package pods
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func TestSpecReferences(t *testing.T) {
+	spec := corev1.PodSpec{
+		ServiceAccountName: "runner",
+		InitContainers: []corev1.Container{{
+			Name:    "init",
+			EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "init-creds"}}}},
+		}},
+		Containers: []corev1.Container{{
+			Name: "app",
+			Env: []corev1.EnvVar{
+				{Name: "A", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "a"}}},
+				{Name: "B", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "b"}}},
+				{Name: "PLAIN", Value: "x"},
+			},
+		}},
+		Volumes: []corev1.Volume{
+			{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-0"}}},
+			{Name: "bundle", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+				{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}}},
+				{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "tls"}}},
+			}}}},
+		},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+	}
+
+	want := []dto.ResourceReferenceDTO{
+		{Kind: RefKindSecret, Name: "init-creds", Via: RefViaEnvFrom, Source: "container/init"},
+		{Kind: RefKindConfigMap, Name: "settings", Via: RefViaEnv, Source: "container/app"},
+		{Kind: RefKindPVC, Name: "data-0", Via: RefViaVolume, Source: "volume/data"},
+		{Kind: RefKindConfigMap, Name: "ca", Via: RefViaVolume, Source: "volume/bundle"},
+		{Kind: RefKindSecret, Name: "tls", Via: RefViaVolume, Source: "volume/bundle"},
+		{Kind: RefKindSecret, Name: "registry", Via: RefViaImagePullSecret},
+		{Kind: RefKindServiceAccount, Name: "runner", Via: RefViaServiceAccount},
+	}
+	if got := SpecReferences(spec); !reflect.DeepEqual(got, want) {
+		t.Fatalf("SpecReferences() =\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestSpecReferencesDefaultServiceAccount(t *testing.T) {
+	got := SpecReferences(corev1.PodSpec{})
+	want := []dto.ResourceReferenceDTO{{Kind: RefKindServiceAccount, Name: "default", Via: RefViaServiceAccount}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("SpecReferences(empty) = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,108 @@
+package pods
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// GetPodSchedulingSpec reads a pod live and returns its scheduling inputs.
+func GetPodSchedulingSpec(ctx context.Context, c *cluster.Clients, namespace, name string) (dto.PodSchedulingSpecDTO, error) {
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return dto.PodSchedulingSpecDTO{}, err
+	}
+	return SchedulingSpec(pod), nil
+}
+
+// SchedulingSpec extracts the scheduling inputs of a pod for the scheduling explainer.
+func SchedulingSpec(p *corev1.Pod) dto.PodSchedulingSpecDTO {
+	cpu, mem := EffectiveRequests(p.Spec)
+	spec := dto.PodSchedulingSpecDTO{
+		Name:               p.Name,
+		Namespace:          p.Namespace,
+		Phase:              string(p.Status.Phase),
+		NodeName:           p.Spec.NodeName,
+		Labels:             p.Labels,
+		NodeSelector:       p.Spec.NodeSelector,
+		Tolerations:        MapTolerations(p.Spec.Tolerations),
+		TopologySpread:     MapTopologySpread(p.Spec.TopologySpreadConstraints),
+		CPURequestMilli:    cpu,
+		MemoryRequestBytes: mem,
+	}
+	if a := p.Spec.Affinity; a != nil && a.NodeAffinity != nil && a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		spec.RequiredNodeAffinity = MapNodeSelectorTerms(a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	}
+	for _, v := range p.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName != "" {
+			spec.PVCs = append(spec.PVCs, v.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	return spec
+}
+
+// EffectiveRequests returns the CPU (milli) and memory (bytes) the scheduler reserves
+// for a pod, plus the pod overhead. Sidecar init containers (restartPolicy Always) keep
+// running, so they add to the container sum and to every init container started after
+// them; the result is the larger of that sum and the peak of the init sequence.
+func EffectiveRequests(spec corev1.PodSpec) (cpuMilli, memBytes int64) {
+	cpuMilli, _, memBytes, _ = sumContainerResources(spec.Containers)
+	var sidecarCPU, sidecarMem, initCPU, initMem int64
+	for _, c := range spec.InitContainers {
+		cpu, _, mem, _ := sumContainerResources([]corev1.Container{c})
+		if c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			cpuMilli += cpu
+			memBytes += mem
+			sidecarCPU += cpu
+			sidecarMem += mem
+			cpu, mem = sidecarCPU, sidecarMem
+		} else {
+			cpu += sidecarCPU
+			mem += sidecarMem
+		}
+		initCPU = max(initCPU, cpu)
+		initMem = max(initMem, mem)
+	}
+	cpuMilli = max(cpuMilli, initCPU)
+	memBytes = max(memBytes, initMem)
+	if q, ok := spec.Overhead[corev1.ResourceCPU]; ok {
+		cpuMilli += q.MilliValue()
+	}
+	if q, ok := spec.Overhead[corev1.ResourceMemory]; ok {
+		memBytes += q.Value()
+	}
+	return cpuMilli, memBytes
+}
+
+func MapNodeSelectorTerms(terms []corev1.NodeSelectorTerm) []dto.NodeSelectorTermDTO {
+	if len(terms) == 0 {
+		return nil
+	}
+	out := make([]dto.NodeSelectorTermDTO, 0, len(terms))
+	for _, t := range terms {
+		out = append(out, dto.NodeSelectorTermDTO{
+			MatchExpressions: mapNodeSelectorRequirements(t.MatchExpressions),
+			MatchFields:      mapNodeSelectorRequirements(t.MatchFields),
+		})
+	}
+	return out
+}
+
+func mapNodeSelectorRequirements(reqs []corev1.NodeSelectorRequirement) []dto.NodeSelectorRequirementDTO {
+	if len(reqs) == 0 {
+		return nil
+	}
+	out := make([]dto.NodeSelectorRequirementDTO, 0, len(reqs))
+	for _, r := range reqs {
+		out = append(out, dto.NodeSelectorRequirementDTO{
+			Key:      r.Key,
+			Operator: string(r.Operator),
+			Values:   r.Values,
+		})
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package pods
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestEffectiveRequests(t *testing.T) {
+	requests := func(cpu, mem string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(mem),
+		}}
+	}
+	spec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "migrate", Resources: requests("1", "64Mi")}},
+		Containers: []corev1.Container{
+			{Name: "app", Resources: requests("250m", "256Mi")},
+			{Name: "sidecar", Resources: requests("100m", "64Mi")},
+		},
+		Overhead: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+	}
+
+	cpu, mem := EffectiveRequests(spec)
+	if cpu != 1050 {
+		t.Fatalf("expected the init container cpu plus overhead (1050m), got %dm", cpu)
+	}
+	if mem != 320<<20 {
+		t.Fatalf("expected the container memory sum (320Mi), got %d", mem)
+	}
+}
+
+func TestEffectiveRequests_SidecarInitContainers(t *testing.T) {
+	requests := func(cpu, mem string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(mem),
+		}}
+	}
+	always := corev1.ContainerRestartPolicyAlways
+	spec := corev1.PodSpec{
+		InitContainers: []corev1.Container{
+			{Name: "setup", Resources: requests("300m", "32Mi")},
+			{Name: "proxy", RestartPolicy: &always, Resources: requests("200m", "128Mi")},
+			{Name: "migrate", Resources: requests("500m", "64Mi")},
+		},
+		Containers: []corev1.Container{{Name: "app", Resources: requests("250m", "256Mi")}},
+	}
+
+	cpu, mem := EffectiveRequests(spec)
+	if cpu != 700 {
+		t.Fatalf("expected the init started after the sidecar plus the sidecar (700m), got %dm", cpu)
+	}
+	if mem != 384<<20 {
+		t.Fatalf("expected the containers plus the sidecar (384Mi), got %d", mem)
+	}
+}
@@ -14,9 +14,16 @@ import (
 )
 
 func ListPods(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.PodListItemDTO, error) {
+	items, _, err := ListPodsWithResourceVersion(ctx, c, namespace)
+	return items, err
+}
+
+// ListPodsWithResourceVersion is ListPods plus the list resourceVersion, so a watch
+// can start exactly where the list ended.
+func ListPodsWithResourceVersion(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.PodListItemDTO, string, error) {
 	pods, err := c.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	latestEvents, _ := kubeevents.LatestEventsByObject(ctx, c, namespace, "Pod")
@@ -24,45 +31,84 @@ func ListPods(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.
 	now := time.Now()
 	out := make([]dto.PodListItemDTO, 0, len(pods.Items))
 	for _, p := range pods.Items {
-		var lastEvent *dto.EventBriefDTO
+		item := PodListItem(p, now)
 		if ev, ok := latestEvents[p.Name]; ok {
 			evCopy := ev
-			lastEvent = &evCopy
+			item.LastEvent = &evCopy
 		}
-		var readyCount, totalCount int
-		var restarts int32
+		out = append(out, item)
+	}
+	return out, pods.ResourceVersion, nil
+}
 
-		for _, cs := range p.Status.ContainerStatuses {
-			totalCount++
-			if cs.Ready {
-				readyCount++
-			}
-			restarts += cs.RestartCount
+// PodListItem converts a single pod into its list row. LastEvent is left empty;
+// callers that need it join events separately (see ListPods).
+func PodListItem(p corev1.Pod, now time.Time) dto.PodListItemDTO {
+	var readyCount, totalCount int
+	var restarts int32
+
+	for _, cs := range p.Status.ContainerStatuses {
+		totalCount++
+		if cs.Ready {
+			readyCount++
 		}
+		restarts += cs.RestartCount
+	}
+
+	age := int64(0)
+	if !p.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(p.CreationTimestamp.Time).Seconds())
+	}
 
-		age := int64(0)
-		if !p.CreationTimestamp.IsZero() {
-			age = int64(now.Sub(p.CreationTimestamp.Time).Seconds())
+	containers := make([]string, 0, len(p.Spec.Containers))
+	var namedPorts []dto.ContainerPortDTO
+	for _, c := range p.Spec.Containers {
+		containers = append(containers, c.Name)
+		for _, port := range c.Ports {
+			if port.Name != "" {
+				namedPorts = append(namedPorts, dto.ContainerPortDTO{Name: port.Name, ContainerPort: port.ContainerPort, Protocol: string(port.Protocol)})
+			}
 		}
+	}
+
+	cpuReq, cpuLim, memReq, memLim := sumContainerResources(p.Spec.Containers)
+	return dto.PodListItemDTO{
+		Name:               p.Name,
+		Namespace:          p.Namespace,
+		Node:               p.Spec.NodeName,
+		Phase:              string(p.Status.Phase),
+		Ready:              FmtReady(readyCount, totalCount),
+		Restarts:           restarts,
+		AgeSec:             age,
+		Images:             podImages(p.Spec),
+		Labels:             p.Labels,
+		Containers:         containers,
+		PodIP:              p.Status.PodIP,
+		NamedPorts:         namedPorts,
+		Owner:              ControllerOwner(&p),
+		HealthReason:       podHealthReason(p.Status.Conditions),
+		CPURequestMilli:    cpuReq,
+		CPULimitMilli:      cpuLim,
+		MemoryRequestBytes: memReq,
+		MemoryLimitBytes:   memLim,
+		References:         SpecReferences(p.Spec),
+	}
+}
 
-		cpuReq, cpuLim, memReq, memLim := sumContainerResources(p.Spec.Containers)
-		out = append(out, dto.PodListItemDTO{
-			Name:               p.Name,
-			Namespace:          p.Namespace,
-			Node:               p.Spec.NodeName,
-			Phase:              string(p.Status.Phase),
-			Ready:              FmtReady(readyCount, totalCount),
-			Restarts:           restarts,
-			AgeSec:             age,
-			LastEvent:          lastEvent,
-			HealthReason:       podHealthReason(p.Status.Conditions),
-			CPURequestMilli:    cpuReq,
-			CPULimitMilli:      cpuLim,
-			MemoryRequestBytes: memReq,
-			MemoryLimitBytes:   memLim,
-		})
+// podImages returns the distinct images of the init and regular containers in spec
+// order.
+func podImages(spec corev1.PodSpec) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for _, c := range containers {
+			if c.Image != "" && !seen[c.Image] {
+				seen[c.Image] = true
+				out = append(out, c.Image)
+			}
+		}
 	}
-	return out, nil
+	return out
 }
 
 func podHealthReason(conditions []corev1.PodCondition) string {
@@ -354,3 +354,17 @@ func TestMapContainerResources(t *testing.T) {
 		})
 	}
 }
+
+func TestPodListItemImages(t *testing.T) {
+	p := corev1.Pod{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "init", Image: "busybox:1.36"}},
+		Containers: []corev1.Container{
+			{Name: "app", Image: "ghcr.io/acme/api:2.1"},
+			{Name: "sidecar", Image: "busybox:1.36"},
+		},
+	}}
+	got := PodListItem(p, time.Now()).Images
+	if len(got) != 2 || got[0] != "busybox:1.36" || got[1] != "ghcr.io/acme/api:2.1" {
+		t.Fatalf("images = %v, want init and app images once each", got)
+	}
+}
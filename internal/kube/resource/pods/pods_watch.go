@@ -0,0 +1,31 @@
+package pods
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// WatchPods opens a pod watch starting after resourceVersion with bookmarks enabled.
+func WatchPods(ctx context.Context, c *cluster.Clients, namespace, resourceVersion string) (watch.Interface, error) {
+	return c.Clientset.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+}
+
+// PodListItemFromObject converts a watch event object into a pod list row.
+func PodListItemFromObject(obj runtime.Object, now time.Time) (dto.PodListItemDTO, bool) {
+	p, ok := obj.(*corev1.Pod)
+	if !ok || p == nil {
+		return dto.PodListItemDTO{}, false
+	}
+	return PodListItem(*p, now), true
+}
@@ -106,6 +106,7 @@ func GetReplicaSetDetails(ctx context.Context, c *cluster.Clients, namespace, na
 		Pods:       pods,
 		Spec:       spec,
 		LinkedPods: linked,
+		Deletion:   kube.DeletionState(rs),
 		YAML:       string(y),
 	}, nil
 }
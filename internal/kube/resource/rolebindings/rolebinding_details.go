@@ -48,6 +48,7 @@ func GetRoleBindingDetails(ctx context.Context, c *cluster.Clients, namespace, n
 		Summary:  summary,
 		RoleRef:  roleRef,
 		Subjects: kube.MapRoleBindingSubjects(rb.Namespace, rb.Subjects),
+		Deletion: kube.DeletionState(rb),
 		YAML:     string(y),
 	}, nil
 }
@@ -40,9 +40,10 @@ func GetRoleDetails(ctx context.Context, c *cluster.Clients, namespace, name str
 	}
 
 	return &dto.RoleDetailsDTO{
-		Summary: summary,
-		Rules:   kube.MapPolicyRules(role.Rules),
-		YAML:    string(y),
+		Summary:  summary,
+		Rules:    kube.MapPolicyRules(role.Rules),
+		Deletion: kube.DeletionState(role),
+		YAML:     string(y),
 	}, nil
 }
 
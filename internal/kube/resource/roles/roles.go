@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
+	kube "github.com/korex-labs/kview/v5/internal/kube"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 )
 
@@ -29,6 +30,8 @@ func ListRoles(ctx context.Context, c *cluster.Clients, namespace string) ([]dto
 			Namespace:  role.Namespace,
 			RulesCount: len(role.Rules),
 			AgeSec:     age,
+
+			Rules: kube.MapPolicyRules(role.Rules),
 		})
 	}
 
@@ -69,6 +69,7 @@ func GetSecretDetails(ctx context.Context, c *cluster.Clients, namespace, name s
 		Keys:     keys,
 		KeyNames: keyNames,
 		Metadata: metadata,
+		Deletion: kube.DeletionState(sec),
 		YAML:     string(y),
 	}, nil
 }
@@ -50,6 +50,7 @@ func GetServiceAccountDetails(ctx context.Context, c *cluster.Clients, namespace
 	return &dto.ServiceAccountDetailsDTO{
 		Summary:  summary,
 		Metadata: metadata,
+		Deletion: kube.DeletionState(sa),
 		YAML:     string(y),
 	}, nil
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListServiceAccounts(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.ServiceAccountListItemDTO, error) {
@@ -31,8 +33,26 @@ func ListServiceAccounts(ctx context.Context, c *cluster.Clients, namespace stri
 			SecretsCount:                 len(sa.Secrets),
 			AutomountServiceAccountToken: sa.AutomountServiceAccountToken,
 			AgeSec:                       age,
+			References:                   serviceAccountReferences(sa),
 		})
 	}
 
 	return out, nil
 }
+
+// serviceAccountReferences returns the Secrets a ServiceAccount lists. Token secrets
+// are reported with via "serviceAccount", pull secrets with via "imagePullSecret".
+func serviceAccountReferences(sa corev1.ServiceAccount) []dto.ResourceReferenceDTO {
+	var out []dto.ResourceReferenceDTO
+	for _, s := range sa.Secrets {
+		if s.Name != "" {
+			out = append(out, dto.ResourceReferenceDTO{Kind: kubepods.RefKindSecret, Name: s.Name, Via: kubepods.RefViaServiceAccount})
+		}
+	}
+	for _, s := range sa.ImagePullSecrets {
+		if s.Name != "" {
+			out = append(out, dto.ResourceReferenceDTO{Kind: kubepods.RefKindSecret, Name: s.Name, Via: kubepods.RefViaImagePullSecret})
+		}
+	}
+	return out
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+// ListEndpointSlicesByService lists the namespace's EndpointSlices grouped by the
+// service they belong to. Slices without the service-name label are skipped.
+func ListEndpointSlicesByService(ctx context.Context, c *cluster.Clients, namespace string) (map[string][]discoveryv1.EndpointSlice, error) {
+	list, err := c.Clientset.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := map[string][]discoveryv1.EndpointSlice{}
+	for _, slice := range list.Items {
+		name := slice.Labels[discoveryv1.LabelServiceName]
+		if name == "" {
+			continue
+		}
+		out[name] = append(out[name], slice)
+	}
+	return out, nil
+}
+
+func listServiceEndpointSlices(ctx context.Context, c *cluster.Clients, namespace, name string) ([]discoveryv1.EndpointSlice, error) {
+	list, err := c.Clientset.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// EndpointSliceCounts counts ready and not-ready endpoints across a service's slices.
+// Dual-stack services publish one slice per address family, so an endpoint backed by a
+// pod is counted once and is ready when any of its addresses is ready.
+func EndpointSliceCounts(slices []discoveryv1.EndpointSlice) (int, int) {
+	ready := map[string]bool{}
+	for _, slice := range slices {
+		for _, ep := range slice.Endpoints {
+			key := endpointKey(slice, ep)
+			ready[key] = ready[key] || endpointReady(ep.Conditions)
+		}
+	}
+	readyCount, notReadyCount := 0, 0
+	for _, ok := range ready {
+		if ok {
+			readyCount++
+		} else {
+			notReadyCount++
+		}
+	}
+	return readyCount, notReadyCount
+}
+
+// mapServiceEndpoints returns one row per endpoint and address family, ordered by pod
+// then address, plus the distinct endpoint pods.
+func mapServiceEndpoints(slices []discoveryv1.EndpointSlice, defaultNS string) ([]dto.ServiceEndpointDTO, []dto.ServiceEndpointPodDTO) {
+	rows := []dto.ServiceEndpointDTO{}
+	pods := map[string]*dto.ServiceEndpointPodDTO{}
+	for _, slice := range slices {
+		ports := formatEndpointPorts(slice.Ports)
+		for _, ep := range slice.Endpoints {
+			row := dto.ServiceEndpointDTO{
+				Addresses:   append([]string{}, ep.Addresses...),
+				AddressType: string(slice.AddressType),
+				Ready:       endpointReady(ep.Conditions),
+				Serving:     endpointServing(ep.Conditions),
+				Terminating: ep.Conditions.Terminating != nil && *ep.Conditions.Terminating,
+				Ports:       ports,
+				Slice:       slice.Name,
+			}
+			if ep.NodeName != nil {
+				row.Node = *ep.NodeName
+			}
+			if ep.Zone != nil {
+				row.Zone = *ep.Zone
+			}
+			if ref := ep.TargetRef; ref != nil && ref.Kind == "Pod" && ref.Name != "" {
+				row.Pod = ref.Name
+				row.PodNamespace = ref.Namespace
+				if row.PodNamespace == "" {
+					row.PodNamespace = defaultNS
+				}
+				key := row.PodNamespace + "/" + row.Pod
+				if p, ok := pods[key]; ok {
+					p.Ready = p.Ready || row.Ready
+					if p.Node == "" {
+						p.Node = row.Node
+					}
+				} else {
+					pods[key] = &dto.ServiceEndpointPodDTO{Name: row.Pod, Namespace: row.PodNamespace, Node: row.Node, Ready: row.Ready}
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Pod != rows[j].Pod {
+			return rows[i].Pod < rows[j].Pod
+		}
+		return strings.Join(rows[i].Addresses, ",") < strings.Join(rows[j].Addresses, ",")
+	})
+
+	podList := make([]dto.ServiceEndpointPodDTO, 0, len(pods))
+	for _, p := range pods {
+		podList = append(podList, *p)
+	}
+	sort.Slice(podList, func(i, j int) bool {
+		if podList[i].Namespace == podList[j].Namespace {
+			return podList[i].Name < podList[j].Name
+		}
+		return podList[i].Namespace < podList[j].Namespace
+	})
+	return rows, podList
+}
+
+func endpointKey(slice discoveryv1.EndpointSlice, ep discoveryv1.Endpoint) string {
+	if ref := ep.TargetRef; ref != nil && ref.Name != "" {
+		return ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+	}
+	if len(ep.Addresses) > 0 {
+		return string(slice.AddressType) + "/" + ep.Addresses[0]
+	}
+	return slice.Name
+}
+
+// endpointReady treats an unknown ready condition as ready, as the API recommends.
+func endpointReady(cond discoveryv1.EndpointConditions) bool {
+	return cond.Ready == nil || *cond.Ready
+}
+
+func endpointServing(cond discoveryv1.EndpointConditions) bool {
+	if cond.Serving != nil {
+		return *cond.Serving
+	}
+	return endpointReady(cond)
+}
+
+func formatEndpointPorts(ports []discoveryv1.EndpointPort) []string {
+	if len(ports) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(ports))
+	for _, p := range ports {
+		entry := ""
+		if p.Port != nil {
+			entry = fmt.Sprintf("%d", *p.Port)
+		}
+		proto := "TCP"
+		if p.Protocol != nil && *p.Protocol != "" {
+			proto = string(*p.Protocol)
+		}
+		entry += "/" + proto
+		if p.Name != nil && *p.Name != "" {
+			entry = *p.Name + " " + entry
+		}
+		out = append(out, entry)
+	}
+	return out
+}
@@ -33,14 +33,6 @@ func GetServiceDetails(ctx context.Context, c *cluster.Clients, namespace, name
 		age = int64(now.Sub(svc.CreationTimestamp.Time).Seconds())
 	}
 
-	selector := map[string]string{}
-	for k, v := range svc.Spec.Selector {
-		selector[k] = v
-	}
-	if len(selector) == 0 {
-		selector = nil
-	}
-
 	ports := make([]dto.ServicePortDTO, 0, len(svc.Spec.Ports))
 	for _, p := range svc.Spec.Ports {
 		ports = append(ports, dto.ServicePortDTO{
@@ -57,7 +49,7 @@ func GetServiceDetails(ctx context.Context, c *cluster.Clients, namespace, name
 		LoadBalancerIngress:   mapLoadBalancerIngress(svc.Status.LoadBalancer.Ingress),
 	}
 
-	ready, notReady, endpointPods, err := listServiceEndpointPods(ctx, c, svc)
+	endpoints, err := serviceEndpoints(ctx, c, svc)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +60,7 @@ func GetServiceDetails(ctx context.Context, c *cluster.Clients, namespace, name
 		Type:            ServiceType(svc.Spec.Type),
 		ClusterIPs:      serviceClusterIPs(svc.Spec),
 		ExternalName:    svc.Spec.ExternalName,
-		Selector:        selector,
+		Selector:        serviceSelector(svc.Spec.Selector),
 		SessionAffinity: string(svc.Spec.SessionAffinity),
 		AgeSec:          age,
 		Labels:          svc.Labels,
@@ -76,15 +68,12 @@ func GetServiceDetails(ctx context.Context, c *cluster.Clients, namespace, name
 	}
 
 	return &dto.ServiceDetailsDTO{
-		Summary: summary,
-		Ports:   ports,
-		Traffic: traffic,
-		Endpoints: dto.ServiceEndpointsDTO{
-			Ready:    int32(ready),
-			NotReady: int32(notReady),
-			Pods:     endpointPods,
-		},
-		YAML: string(y),
+		Summary:   summary,
+		Ports:     ports,
+		Traffic:   traffic,
+		Endpoints: endpoints,
+		Deletion:  kube.DeletionState(svc),
+		YAML:      string(y),
 	}, nil
 }
 
@@ -103,68 +92,33 @@ func mapLoadBalancerIngress(items []corev1.LoadBalancerIngress) []string {
 	return out
 }
 
-func listServiceEndpointPods(ctx context.Context, c *cluster.Clients, svc *corev1.Service) (int, int, []dto.ServiceEndpointPodDTO, error) {
-	ep, err := c.Clientset.CoreV1().Endpoints(svc.Namespace).Get(ctx, svc.Name, metav1.GetOptions{})
+func serviceEndpoints(ctx context.Context, c *cluster.Clients, svc *corev1.Service) (dto.ServiceEndpointsDTO, error) {
+	slices, err := listServiceEndpointSlices(ctx, c, svc.Namespace, svc.Name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return 0, 0, nil, nil
-		}
-		return 0, 0, nil, err
-	}
-
-	readyCount, notReadyCount := EndpointsCounts(ep)
-	podRefs := map[string]corev1.ObjectReference{}
-
-	for _, subset := range ep.Subsets {
-		for _, addr := range subset.Addresses {
-			addPodRef(podRefs, addr.TargetRef, svc.Namespace)
-		}
-		for _, addr := range subset.NotReadyAddresses {
-			addPodRef(podRefs, addr.TargetRef, svc.Namespace)
-		}
-	}
-
-	pods := make([]dto.ServiceEndpointPodDTO, 0, len(podRefs))
-	for _, ref := range podRefs {
-		if ref.Kind != "Pod" || ref.Name == "" {
-			continue
-		}
-		ns := ref.Namespace
-		if ns == "" {
-			ns = svc.Namespace
-		}
-		pod, err := c.Clientset.CoreV1().Pods(ns).Get(ctx, ref.Name, metav1.GetOptions{})
-		if err != nil {
-			continue
+			return dto.ServiceEndpointsDTO{}, nil
 		}
-		pods = append(pods, dto.ServiceEndpointPodDTO{
-			Name:      pod.Name,
-			Namespace: pod.Namespace,
-			Node:      pod.Spec.NodeName,
-			Ready:     IsPodReady(pod),
-		})
-	}
-
-	sort.Slice(pods, func(i, j int) bool {
-		if pods[i].Namespace == pods[j].Namespace {
-			return pods[i].Name < pods[j].Name
+		return dto.ServiceEndpointsDTO{}, err
+	}
+
+	ready, notReady := EndpointSliceCounts(slices)
+	items, pods := mapServiceEndpoints(slices, svc.Namespace)
+	out := dto.ServiceEndpointsDTO{
+		Ready:    int32(ready),
+		NotReady: int32(notReady),
+		Slices:   len(slices),
+		Items:    items,
+		Pods:     pods,
+	}
+	seen := map[string]bool{}
+	for _, slice := range slices {
+		if t := string(slice.AddressType); !seen[t] {
+			seen[t] = true
+			out.AddressTypes = append(out.AddressTypes, t)
 		}
-		return pods[i].Namespace < pods[j].Namespace
-	})
-
-	return readyCount, notReadyCount, pods, nil
-}
-
-func addPodRef(target map[string]corev1.ObjectReference, ref *corev1.ObjectReference, defaultNS string) {
-	if ref == nil {
-		return
-	}
-	ns := ref.Namespace
-	if ns == "" {
-		ns = defaultNS
 	}
-	key := ns + "/" + ref.Name
-	target[key] = *ref
+	sort.Strings(out.AddressTypes)
+	return out, nil
 }
 
 func IsPodReady(pod *corev1.Pod) bool {
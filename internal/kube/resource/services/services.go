@@ -20,16 +20,8 @@ func ListServices(ctx context.Context, c *cluster.Clients, namespace string) ([]
 		return nil, err
 	}
 
-	//nolint:staticcheck // Deferred migration to EndpointSlice; keep legacy Endpoints rollup behavior for now.
-	endpointsByName := map[string]*corev1.Endpoints{}
-	if endpoints, err := c.Clientset.CoreV1().Endpoints(namespace).List(ctx, metav1.ListOptions{}); err == nil {
-		//nolint:staticcheck // Deferred migration to EndpointSlice; keep legacy Endpoints rollup behavior for now.
-		endpointsByName = make(map[string]*corev1.Endpoints, len(endpoints.Items))
-		for i := range endpoints.Items {
-			ep := endpoints.Items[i]
-			endpointsByName[ep.Name] = &ep
-		}
-	}
+	// Endpoint counts are best-effort; a denied EndpointSlice list leaves them at zero.
+	slicesByService, _ := ListEndpointSlicesByService(ctx, c, namespace)
 
 	now := time.Now()
 	out := make([]dto.ServiceListItemDTO, 0, len(services.Items))
@@ -39,7 +31,7 @@ func ListServices(ctx context.Context, c *cluster.Clients, namespace string) ([]
 			age = int64(now.Sub(svc.CreationTimestamp.Time).Seconds())
 		}
 
-		ready, notReady := EndpointsCounts(endpointsByName[svc.Name])
+		ready, notReady := EndpointSliceCounts(slicesByService[svc.Name])
 
 		out = append(out, dto.ServiceListItemDTO{
 			Name:              svc.Name,
@@ -47,6 +39,7 @@ func ListServices(ctx context.Context, c *cluster.Clients, namespace string) ([]
 			Type:              ServiceType(svc.Spec.Type),
 			ClusterIPs:        serviceClusterIPs(svc.Spec),
 			PortsSummary:      FormatServicePortsSummary(svc.Spec.Ports),
+			Selector:          serviceSelector(svc.Spec.Selector),
 			EndpointsReady:    int32(ready),
 			EndpointsNotReady: int32(notReady),
 			AgeSec:            age,
@@ -80,18 +73,16 @@ func FormatServicePortsSummary(ports []corev1.ServicePort) string {
 	return strings.Join(parts, ", ")
 }
 
-//nolint:staticcheck // Deferred migration to EndpointSlice; callers still pass Endpoints.
-func EndpointsCounts(ep *corev1.Endpoints) (int, int) {
-	if ep == nil {
-		return 0, 0
+// serviceSelector copies the selector, returning nil when it is empty.
+func serviceSelector(sel map[string]string) map[string]string {
+	if len(sel) == 0 {
+		return nil
 	}
-	ready := 0
-	notReady := 0
-	for _, subset := range ep.Subsets {
-		ready += len(subset.Addresses)
-		notReady += len(subset.NotReadyAddresses)
+	out := make(map[string]string, len(sel))
+	for k, v := range sel {
+		out[k] = v
 	}
-	return ready, notReady
+	return out
 }
 
 func serviceClusterIPs(spec corev1.ServiceSpec) []string {
@@ -124,23 +115,18 @@ func serviceIntOrString(v intstr.IntOrString) string {
 // ResolveServiceTargetPod returns a Pod name backing the Service.
 // It prefers ready endpoint addresses and falls back to not-ready ones.
 func ResolveServiceTargetPod(ctx context.Context, c *cluster.Clients, namespace, serviceName string) (string, error) {
-	ep, err := c.Clientset.CoreV1().Endpoints(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	slices, err := listServiceEndpointSlices(ctx, c, namespace, serviceName)
 	if err != nil {
 		return "", err
 	}
-	for _, subset := range ep.Subsets {
-		for _, addr := range subset.Addresses {
-			if addr.TargetRef != nil && addr.TargetRef.Kind == "Pod" && addr.TargetRef.Name != "" {
-				return addr.TargetRef.Name, nil
-			}
+	_, pods := mapServiceEndpoints(slices, namespace)
+	for _, p := range pods {
+		if p.Ready {
+			return p.Name, nil
 		}
 	}
-	for _, subset := range ep.Subsets {
-		for _, addr := range subset.NotReadyAddresses {
-			if addr.TargetRef != nil && addr.TargetRef.Kind == "Pod" && addr.TargetRef.Name != "" {
-				return addr.TargetRef.Name, nil
-			}
-		}
+	if len(pods) > 0 {
+		return pods[0].Name, nil
 	}
 	return "", fmt.Errorf("service has no endpoint pods")
 }
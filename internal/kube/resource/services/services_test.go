@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -110,87 +111,108 @@ func TestFormatServicePortsSummary(t *testing.T) {
 	}
 }
 
-//nolint:staticcheck // Deferred migration to EndpointSlice; test matches current behavior.
-func TestEndpointsCounts(t *testing.T) {
-	addr := func(ip string) corev1.EndpointAddress { return corev1.EndpointAddress{IP: ip} }
+func TestEndpointSliceCounts(t *testing.T) {
+	ready := func(v bool) discoveryv1.EndpointConditions { return discoveryv1.EndpointConditions{Ready: &v} }
+	pod := func(name string) *corev1.ObjectReference { return &corev1.ObjectReference{Kind: "Pod", Name: name} }
+	slice := func(addrType discoveryv1.AddressType, eps ...discoveryv1.Endpoint) discoveryv1.EndpointSlice {
+		return discoveryv1.EndpointSlice{AddressType: addrType, Endpoints: eps}
+	}
 
 	cases := []struct {
 		name         string
-		ep           *corev1.Endpoints
+		slices       []discoveryv1.EndpointSlice
 		wantReady    int
 		wantNotReady int
 	}{
 		{
-			name:         "nil endpoint",
-			ep:           nil,
+			name:         "no slices",
+			slices:       nil,
 			wantReady:    0,
 			wantNotReady: 0,
 		},
 		{
-			name:         "empty subsets",
-			ep:           &corev1.Endpoints{},
-			wantReady:    0,
-			wantNotReady: 0,
-		},
-		{
-			name: "ready addresses only",
-			ep: &corev1.Endpoints{
-				Subsets: []corev1.EndpointSubset{
-					{Addresses: []corev1.EndpointAddress{addr("10.0.0.1"), addr("10.0.0.2")}},
-				},
+			name: "unknown ready condition counts as ready",
+			slices: []discoveryv1.EndpointSlice{
+				slice(discoveryv1.AddressTypeIPv4, discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}}),
 			},
-			wantReady:    2,
+			wantReady:    1,
 			wantNotReady: 0,
 		},
 		{
-			name: "not-ready addresses only",
-			ep: &corev1.Endpoints{
-				Subsets: []corev1.EndpointSubset{
-					{NotReadyAddresses: []corev1.EndpointAddress{addr("10.0.0.3")}},
-				},
-			},
-			wantReady:    0,
-			wantNotReady: 1,
-		},
-		{
-			name: "mixed ready and not-ready",
-			ep: &corev1.Endpoints{
-				Subsets: []corev1.EndpointSubset{
-					{
-						Addresses:         []corev1.EndpointAddress{addr("10.0.0.1")},
-						NotReadyAddresses: []corev1.EndpointAddress{addr("10.0.0.2"), addr("10.0.0.3")},
-					},
-				},
+			name: "mixed ready and not-ready across slices",
+			slices: []discoveryv1.EndpointSlice{
+				slice(discoveryv1.AddressTypeIPv4,
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: ready(true), TargetRef: pod("a")},
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}, Conditions: ready(false), TargetRef: pod("b")},
+				),
+				slice(discoveryv1.AddressTypeIPv4,
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.3"}, Conditions: ready(false), TargetRef: pod("c")},
+				),
 			},
 			wantReady:    1,
 			wantNotReady: 2,
 		},
 		{
-			name: "multiple subsets summed",
-			ep: &corev1.Endpoints{
-				Subsets: []corev1.EndpointSubset{
-					{Addresses: []corev1.EndpointAddress{addr("10.0.0.1")}},
-					{
-						Addresses:         []corev1.EndpointAddress{addr("10.0.0.2")},
-						NotReadyAddresses: []corev1.EndpointAddress{addr("10.0.0.3")},
-					},
-				},
+			name: "dual-stack pods counted once",
+			slices: []discoveryv1.EndpointSlice{
+				slice(discoveryv1.AddressTypeIPv4,
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: ready(true), TargetRef: pod("a")},
+					discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}, Conditions: ready(false), TargetRef: pod("b")},
+				),
+				slice(discoveryv1.AddressTypeIPv6,
+					discoveryv1.Endpoint{Addresses: []string{"fd00::1"}, Conditions: ready(true), TargetRef: pod("a")},
+					discoveryv1.Endpoint{Addresses: []string{"fd00::2"}, Conditions: ready(false), TargetRef: pod("b")},
+				),
 			},
-			wantReady:    2,
+			wantReady:    1,
 			wantNotReady: 1,
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			ready, notReady := EndpointsCounts(tc.ep)
+			ready, notReady := EndpointSliceCounts(tc.slices)
 			if ready != tc.wantReady || notReady != tc.wantNotReady {
-				t.Fatalf("EndpointsCounts() = (%d, %d), want (%d, %d)",
+				t.Fatalf("EndpointSliceCounts() = (%d, %d), want (%d, %d)",
 					ready, notReady, tc.wantReady, tc.wantNotReady)
 			}
 		})
 	}
 }
 
+func TestMapServiceEndpoints(t *testing.T) {
+	node, zone := "node-a", "eu-1a"
+	notReady, terminating := false, true
+	port, name := int32(8080), "http"
+	slices := []discoveryv1.EndpointSlice{{
+		ObjectMeta:  metav1.ObjectMeta{Name: "api-abc12"},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Ports:       []discoveryv1.EndpointPort{{Name: &name, Port: &port}},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &notReady, Terminating: &terminating},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "api-2"},
+				NodeName:   &node,
+				Zone:       &zone,
+			},
+			{Addresses: []string{"10.0.0.1"}, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "api-1", Namespace: "app"}},
+		},
+	}}
+
+	rows, pods := mapServiceEndpoints(slices, "app")
+	if len(rows) != 2 || rows[0].Pod != "api-1" || !rows[0].Ready || !rows[0].Serving {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+	got := rows[1]
+	if got.Ready || got.Serving || !got.Terminating || got.Node != "node-a" || got.Zone != "eu-1a" || got.PodNamespace != "app" ||
+		got.Slice != "api-abc12" || len(got.Ports) != 1 || got.Ports[0] != "http 8080/TCP" {
+		t.Fatalf("unexpected row: %+v", got)
+	}
+	if len(pods) != 2 || pods[0].Name != "api-1" || pods[1].Ready || pods[1].Node != "node-a" {
+		t.Fatalf("unexpected pods: %+v", pods)
+	}
+}
+
 func TestIsPodReady(t *testing.T) {
 	cases := []struct {
 		name string
@@ -132,6 +132,7 @@ func GetStatefulSetDetails(ctx context.Context, c *cluster.Clients, namespace, n
 		Pods:       pods,
 		Spec:       spec,
 		Metadata:   metadata,
+		Deletion:   kube.DeletionState(set),
 		YAML:       string(y),
 	}, nil
 }
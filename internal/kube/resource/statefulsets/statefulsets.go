@@ -8,6 +8,7 @@ import (
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 )
 
 func ListStatefulSets(ctx context.Context, c *cluster.Clients, namespace string) ([]dto.StatefulSetDTO, error) {
@@ -52,6 +53,7 @@ func ListStatefulSets(ctx context.Context, c *cluster.Clients, namespace string)
 			UpdateStrategy: strategy,
 			Selector:       selector,
 			AgeSec:         age,
+			References:     kubepods.SpecReferences(ss.Spec.Template.Spec),
 		})
 	}
 
@@ -0,0 +1,135 @@
+package storageclasses
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	csidrivers "github.com/korex-labs/kview/v5/internal/kube/resource/csidrivers"
+)
+
+const (
+	defaultClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+func ListStorageClasses(ctx context.Context, c *cluster.Clients) ([]dto.StorageClassDTO, error) {
+	items, err := c.Clientset.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	out := make([]dto.StorageClassDTO, 0, len(items.Items))
+	for _, sc := range items.Items {
+		out = append(out, mapStorageClass(sc, now))
+	}
+	return out, nil
+}
+
+// GetStorageClass returns the list row for a single StorageClass.
+func GetStorageClass(ctx context.Context, c *cluster.Clients, name string) (*dto.StorageClassDTO, error) {
+	sc, err := c.Clientset.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := mapStorageClass(*sc, time.Now())
+	return &out, nil
+}
+
+// GetStorageClassDetails returns the StorageClass with the CSIDriver object registered for
+// its provisioner, when one exists and is readable.
+func GetStorageClassDetails(ctx context.Context, c *cluster.Clients, name string) (*dto.StorageClassDetailsDTO, error) {
+	sc, err := c.Clientset.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	scCopy := sc.DeepCopy()
+	scCopy.ManagedFields = nil
+	y, err := kube.MarshalObjectYAML(scCopy, "storage.k8s.io/v1", "StorageClass")
+	if err != nil {
+		return nil, err
+	}
+
+	det := &dto.StorageClassDetailsDTO{
+		Summary:           mapStorageClass(*sc, time.Now()),
+		Parameters:        sc.Parameters,
+		MountOptions:      sc.MountOptions,
+		AllowedTopologies: formatAllowedTopologies(sc.AllowedTopologies),
+		Metadata: dto.StorageClassMetadataDTO{
+			Labels:      sc.Labels,
+			Annotations: sc.Annotations,
+		},
+		Deletion: kube.DeletionState(sc),
+		YAML:     string(y),
+	}
+	if driver, err := csidrivers.GetCSIDriver(ctx, c, sc.Provisioner); err == nil {
+		det.CSIDriver = driver
+	}
+	return det, nil
+}
+
+func mapStorageClass(sc storagev1.StorageClass, now time.Time) dto.StorageClassDTO {
+	age := int64(0)
+	if !sc.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(sc.CreationTimestamp.Time).Seconds())
+	}
+
+	out := dto.StorageClassDTO{
+		Name:            sc.Name,
+		Provisioner:     sc.Provisioner,
+		IsDefault:       isDefaultClass(sc.Annotations),
+		ParametersCount: len(sc.Parameters),
+		AgeSec:          age,
+	}
+	if sc.ReclaimPolicy != nil {
+		out.ReclaimPolicy = string(*sc.ReclaimPolicy)
+	}
+	if sc.VolumeBindingMode != nil {
+		out.VolumeBindingMode = string(*sc.VolumeBindingMode)
+	} else {
+		out.VolumeBindingMode = string(storagev1.VolumeBindingImmediate)
+	}
+	if sc.AllowVolumeExpansion != nil {
+		out.AllowVolumeExpansion = *sc.AllowVolumeExpansion
+	}
+	return out
+}
+
+func isDefaultClass(annotations map[string]string) bool {
+	for _, key := range []string{defaultClassAnnotation, betaDefaultClassAnnotation} {
+		if strings.EqualFold(strings.TrimSpace(annotations[key]), "true") {
+			return true
+		}
+	}
+	return false
+}
+
+// formatAllowedTopologies renders each topology term as "key in (v1, v2)" clauses joined by " and ".
+func formatAllowedTopologies(terms []corev1.TopologySelectorTerm) []string {
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		clauses := make([]string, 0, len(term.MatchLabelExpressions))
+		for _, expr := range term.MatchLabelExpressions {
+			values := append([]string{}, expr.Values...)
+			sort.Strings(values)
+			clauses = append(clauses, expr.Key+" in ("+strings.Join(values, ", ")+")")
+		}
+		if len(clauses) > 0 {
+			out = append(out, strings.Join(clauses, " and "))
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,47 @@
+package storageclasses
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMapStorageClass(t *testing.T) {
+	sc := storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "gp3", Annotations: map[string]string{betaDefaultClassAnnotation: "true"}},
+		Provisioner: "ebs.csi.aws.com",
+		Parameters:  map[string]string{"type": "gp3", "fsType": "ext4"},
+	}
+	got := mapStorageClass(sc, time.Now())
+	if !got.IsDefault || got.VolumeBindingMode != "Immediate" || got.ParametersCount != 2 || got.AllowVolumeExpansion {
+		t.Fatalf("unexpected mapping: %+v", got)
+	}
+
+	mode := storagev1.VolumeBindingWaitForFirstConsumer
+	sc.VolumeBindingMode = &mode
+	sc.Annotations = map[string]string{defaultClassAnnotation: "false"}
+	if got := mapStorageClass(sc, time.Now()); got.IsDefault || got.VolumeBindingMode != "WaitForFirstConsumer" {
+		t.Fatalf("unexpected mapping: %+v", got)
+	}
+}
+
+func TestFormatAllowedTopologies(t *testing.T) {
+	got := formatAllowedTopologies([]corev1.TopologySelectorTerm{
+		{MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{
+			{Key: "topology.kubernetes.io/zone", Values: []string{"eu-1b", "eu-1a"}},
+			{Key: "kubernetes.io/arch", Values: []string{"amd64"}},
+		}},
+		{},
+	})
+	want := []string{"topology.kubernetes.io/zone in (eu-1a, eu-1b) and kubernetes.io/arch in (amd64)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got := formatAllowedTopologies(nil); got != nil {
+		t.Fatalf("expected nil, got %v", got)
+	}
+}
@@ -0,0 +1,102 @@
+package volumeattachments
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func ListVolumeAttachments(ctx context.Context, c *cluster.Clients) ([]dto.VolumeAttachmentDTO, error) {
+	items, err := c.Clientset.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	out := make([]dto.VolumeAttachmentDTO, 0, len(items.Items))
+	for _, va := range items.Items {
+		out = append(out, mapVolumeAttachment(va, now))
+	}
+	return out, nil
+}
+
+// ListVolumeAttachmentsForPersistentVolume returns the attachments whose source is the
+// named PersistentVolume, sorted by node.
+func ListVolumeAttachmentsForPersistentVolume(ctx context.Context, c *cluster.Clients, pvName string) ([]dto.VolumeAttachmentDTO, error) {
+	items, err := ListVolumeAttachments(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return attachmentsForPersistentVolume(items, pvName), nil
+}
+
+func GetVolumeAttachmentDetails(ctx context.Context, c *cluster.Clients, name string) (*dto.VolumeAttachmentDetailsDTO, error) {
+	va, err := c.Clientset.StorageV1().VolumeAttachments().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	vaCopy := va.DeepCopy()
+	vaCopy.ManagedFields = nil
+	y, err := kube.MarshalObjectYAML(vaCopy, "storage.k8s.io/v1", "VolumeAttachment")
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.VolumeAttachmentDetailsDTO{
+		Summary: mapVolumeAttachment(*va, time.Now()),
+		Metadata: dto.VolumeAttachmentMetadataDTO{
+			Labels:      va.Labels,
+			Annotations: va.Annotations,
+			Finalizers:  va.Finalizers,
+		},
+		Deletion: kube.DeletionState(va),
+		YAML:     string(y),
+	}, nil
+}
+
+func mapVolumeAttachment(va storagev1.VolumeAttachment, now time.Time) dto.VolumeAttachmentDTO {
+	age := int64(0)
+	if !va.CreationTimestamp.IsZero() {
+		age = int64(now.Sub(va.CreationTimestamp.Time).Seconds())
+	}
+
+	out := dto.VolumeAttachmentDTO{
+		Name:     va.Name,
+		Attacher: va.Spec.Attacher,
+		NodeName: va.Spec.NodeName,
+		Attached: va.Status.Attached,
+		AgeSec:   age,
+	}
+	if va.Spec.Source.PersistentVolumeName != nil {
+		out.PersistentVolumeName = *va.Spec.Source.PersistentVolumeName
+	}
+	if va.Status.AttachError != nil {
+		out.AttachError = va.Status.AttachError.Message
+	}
+	if va.Status.DetachError != nil {
+		out.DetachError = va.Status.DetachError.Message
+	}
+	if va.DeletionTimestamp != nil {
+		out.DeletionTimestamp = va.DeletionTimestamp.Unix()
+	}
+	return out
+}
+
+func attachmentsForPersistentVolume(items []dto.VolumeAttachmentDTO, pvName string) []dto.VolumeAttachmentDTO {
+	var out []dto.VolumeAttachmentDTO
+	for _, va := range items {
+		if va.PersistentVolumeName == pvName {
+			out = append(out, va)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].NodeName < out[j].NodeName })
+	return out
+}
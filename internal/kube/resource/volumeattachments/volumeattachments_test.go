@@ -0,0 +1,42 @@
+package volumeattachments
+
+import (
+	"testing"
+	"time"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func TestMapVolumeAttachment(t *testing.T) {
+	pv := "pv-1"
+	deleted := metav1.NewTime(time.Now().Add(-time.Minute))
+	got := mapVolumeAttachment(storagev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "csi-abc", DeletionTimestamp: &deleted},
+		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: "ebs.csi.aws.com",
+			NodeName: "node-a",
+			Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pv},
+		},
+		Status: storagev1.VolumeAttachmentStatus{
+			Attached:    true,
+			DetachError: &storagev1.VolumeError{Message: "volume is busy"},
+		},
+	}, time.Now())
+	if got.PersistentVolumeName != "pv-1" || !got.Attached || got.DetachError != "volume is busy" || got.DeletionTimestamp != deleted.Unix() {
+		t.Fatalf("unexpected mapping: %+v", got)
+	}
+}
+
+func TestAttachmentsForPersistentVolume(t *testing.T) {
+	got := attachmentsForPersistentVolume([]dto.VolumeAttachmentDTO{
+		{Name: "a", PersistentVolumeName: "pv-1", NodeName: "node-b"},
+		{Name: "b", PersistentVolumeName: "pv-2", NodeName: "node-a"},
+		{Name: "c", PersistentVolumeName: "pv-1", NodeName: "node-a"},
+	}, "pv-1")
+	if len(got) != 2 || got[0].Name != "c" || got[1].Name != "a" {
+		t.Fatalf("unexpected attachments: %+v", got)
+	}
+}
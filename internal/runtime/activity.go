@@ -20,6 +20,7 @@ const (
 	ActivityTypeConnectivity        ActivityType = "connectivity"
 	ActivityTypeNamespaceListEnrich ActivityType = "namespace-list-enrich"
 	ActivityTypeDataplaneSnapshot   ActivityType = "dataplane-snapshot"
+	ActivityTypeNodeDrain           ActivityType = "node-drain"
 )
 
 const (
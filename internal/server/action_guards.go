@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/korex-labs/kview/v5/internal/kube"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	kubepods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
+)
+
+// dependentsGuardedActions are the deletes that are refused while the reverse-reference
+// index reports live dependents, keyed by action to the kind of the deleted object.
+var dependentsGuardedActions = map[string]string{
+	"configmap.delete": kubepods.RefKindConfigMap,
+	"secret.delete":    kubepods.RefKindSecret,
+}
+
+// dependentsGuard returns a blocking result when a guarded delete targets an object that
+// cached pods, workloads, Ingresses or ServiceAccounts still reference. params.ignoreDependents
+// skips the check. The guard is advisory: it reads snapshots, and an index that cannot be
+// built never blocks the action.
+func (s *Server) dependentsGuard(ctx context.Context, ctxName string, req kube.ActionRequest) *kube.ActionResult {
+	kind, ok := dependentsGuardedActions[req.Action]
+	if !ok || s.dp == nil || req.Namespace == "" || req.Name == "" {
+		return nil
+	}
+	if ignore, _ := req.Params["ignoreDependents"].(bool); ignore {
+		return nil
+	}
+	res, err := s.dp.ResourceReferrers(ctx, ctxName, req.Namespace, kind, req.Name)
+	if err != nil {
+		return nil
+	}
+	return dependentsGuardResult(res)
+}
+
+func dependentsGuardResult(res dto.ResourceReferrersDTO) *kube.ActionResult {
+	if len(res.Referrers) == 0 {
+		return nil
+	}
+	first := res.Referrers[0]
+	msg := fmt.Sprintf("%s %s/%s is still referenced by %s %s", res.Kind, res.Namespace, res.Name, first.Kind, first.Name)
+	if n := len(res.Referrers) - 1; n > 0 {
+		msg += fmt.Sprintf(" and %d other object(s)", n)
+	}
+	msg += "; set params.ignoreDependents to delete anyway"
+	return &kube.ActionResult{
+		Status:  "error",
+		Message: msg,
+		Details: map[string]any{"dependents": res.Referrers},
+	}
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/korex-labs/kview/v5/internal/dataplane"
+)
+
+// contextsParam selects a cross-context list: "contexts=a,b,c" or "contexts=all".
+// Rows of every context are merged into one list, each tagged with its "context".
+const contextsParam = "contexts"
+
+// crossContextListMeta describes one context's contribution to a cross-context list.
+// State is the usual coarse list state, or unreachable when the context could not be
+// reached at all; a failed context is reported here instead of failing the request.
+type crossContextListMeta struct {
+	Context      string `json:"context"`
+	State        string `json:"state"`
+	ErrorClass   string `json:"errorClass,omitempty"`
+	Items        int    `json:"items"`
+	Revision     string `json:"revision,omitempty"`
+	Observed     string `json:"observed,omitempty"`
+	Freshness    string `json:"freshness,omitempty"`
+	Coverage     string `json:"coverage,omitempty"`
+	Degradation  string `json:"degradation,omitempty"`
+	Completeness string `json:"completeness,omitempty"`
+}
+
+// requestedContexts returns the contexts named by the contexts query parameter, each
+// scoped by the request's impersonation. ok is false when the parameter is absent.
+func (s *Server) requestedContexts(r *http.Request) (names []string, ok bool, err error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(contextsParam))
+	if raw == "" {
+		return nil, false, nil
+	}
+	if raw == "all" {
+		for _, c := range s.mgr.ListContexts() {
+			if c.Name != "" {
+				names = append(names, c.Name)
+			}
+		}
+		sort.Strings(names)
+	} else {
+		seen := map[string]bool{}
+		for _, name := range strings.Split(raw, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" || seen[name] {
+				continue
+			}
+			if _, known := s.mgr.ContextInfo(name); !known {
+				return nil, true, fmt.Errorf("unknown context: %s", name)
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, true, errors.New("no contexts selected")
+	}
+	for i, name := range names {
+		names[i] = s.scopeContextName(r, name)
+	}
+	return names, true, nil
+}
+
+// serveCrossContextList fetches the same list from every context in parallel. Each
+// fetch goes through that context's dataplane plane and scheduler, so cached snapshots
+// are reused and live reads queue like any other list read. Observers are not started
+// for the extra contexts.
+func serveCrossContextList[I any](
+	s *Server,
+	w http.ResponseWriter,
+	r *http.Request,
+	contexts []string,
+	fetch func(context.Context, string) (dataplane.Snapshot[I], error),
+	transform func(string, []I) any,
+) {
+	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+	defer cancel()
+
+	metas := make([]crossContextListMeta, len(contexts))
+	rows := make([][]map[string]any, len(contexts))
+	var wg sync.WaitGroup
+	for i, name := range contexts {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			snap, err := fetch(ctx, name)
+			metas[i] = crossContextMeta(name, snap, err)
+			if err != nil && len(snap.Items) == 0 {
+				return
+			}
+			items := any(snap.Items)
+			if transform != nil {
+				items = transform(name, snap.Items)
+			}
+			rows[i] = tagRowsWithContext(name, items)
+			// A transform may filter rows; items counts the rows the context contributed.
+			metas[i].Items = len(rows[i])
+		}(i, name)
+	}
+	wg.Wait()
+
+	merged := make([]map[string]any, 0)
+	for _, part := range rows {
+		merged = append(merged, part...)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"active":   s.readContextName(r),
+		"contexts": metas,
+		"items":    merged,
+	})
+}
+
+func crossContextMeta[I any](name string, snap dataplane.Snapshot[I], err error) crossContextListMeta {
+	out := crossContextListMeta{Context: name, Items: len(snap.Items)}
+	nerr := snap.Err
+	if nerr == nil && err != nil {
+		normalized := dataplane.NormalizeError(err)
+		nerr = &normalized
+	}
+	out.State = dataplane.CoarseState(nerr, len(snap.Items))
+	if nerr != nil {
+		out.ErrorClass = string(nerr.Class)
+		if len(snap.Items) == 0 && isUnreachableErrorClass(nerr.Class) {
+			out.State = "unreachable"
+		}
+	}
+	if !snap.Meta.ObservedAt.IsZero() {
+		out.Revision = strconv.FormatUint(snap.Meta.Revision, 10)
+		out.Observed = snap.Meta.ObservedAt.UTC().Format(time.RFC3339Nano)
+		out.Freshness = string(snap.Meta.Freshness)
+		out.Coverage = string(snap.Meta.Coverage)
+		out.Degradation = string(snap.Meta.Degradation)
+		out.Completeness = string(snap.Meta.Completeness)
+	}
+	return out
+}
+
+func isUnreachableErrorClass(class dataplane.NormalizedErrorClass) bool {
+	switch class {
+	case dataplane.NormalizedErrorClassConnectivity, dataplane.NormalizedErrorClassProxyFailure,
+		dataplane.NormalizedErrorClassTimeout, dataplane.NormalizedErrorClassCanceled:
+		return true
+	}
+	return false
+}
+
+// tagRowsWithContext flattens typed list rows into JSON objects carrying a "context"
+// field, so rows of different contexts can share one table.
+func tagRowsWithContext(contextName string, items any) []map[string]any {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var rows []map[string]any
+	if err := dec.Decode(&rows); err != nil {
+		return nil
+	}
+	for _, row := range rows {
+		row["context"] = contextName
+	}
+	return rows
+}
+
+// crossContextTransform adapts a single-context list transform to serveCrossContextList.
+func crossContextTransform[I any](transform func([]I) any) func(string, []I) any {
+	if transform == nil {
+		return nil
+	}
+	return func(_ string, items []I) any { return transform(items) }
+}
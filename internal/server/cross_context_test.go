@@ -0,0 +1,106 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/korex-labs/kview/v5/internal/dataplane"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func TestRequestedContexts(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	if _, ok, _ := s.requestedContexts(httptest.NewRequest(http.MethodGet, "/api/clusterroles", nil)); ok {
+		t.Fatal("no contexts parameter should keep the single-context path")
+	}
+	names, ok, err := s.requestedContexts(httptest.NewRequest(http.MethodGet, "/api/clusterroles?contexts=all", nil))
+	if !ok || err != nil || len(names) != 1 || names[0] != "test-context" {
+		t.Fatalf("all: got %v ok=%v err=%v", names, ok, err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/clusterroles?contexts=test-context,test-context", nil)
+	req.Header.Set(impersonateHeader, "group:auditors")
+	names, _, err = s.requestedContexts(req)
+	if err != nil || len(names) != 1 || names[0] != "test-context|as=group:auditors" {
+		t.Fatalf("explicit list: got %v err=%v", names, err)
+	}
+	if _, ok, err := s.requestedContexts(httptest.NewRequest(http.MethodGet, "/api/clusterroles?contexts=nope", nil)); !ok || err == nil {
+		t.Fatal("unknown context should be rejected")
+	}
+}
+
+func TestGetClusterList_UnknownContext(t *testing.T) {
+	_, h := newTestServer(t)
+	rec := doReq(t, h, http.MethodGet, "/api/clusterroles?contexts=test-context,nope", testToken, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d, want 400 (body=%s)", rec.Code, rec.Body.String())
+	}
+}
+
+func TestCrossContextMeta(t *testing.T) {
+	observed := time.Now()
+	ok := crossContextMeta("a", dataplane.PodsSnapshot{
+		Items: []dto.PodListItemDTO{{Name: "web-0"}},
+		Meta:  dataplane.SnapshotMetadata{ObservedAt: observed, Revision: 3, Freshness: dataplane.FreshnessClassHot},
+	}, nil)
+	if ok.State != "ok" || ok.Items != 1 || ok.Revision != "3" || ok.Freshness != "hot" {
+		t.Fatalf("ok: got %+v", ok)
+	}
+
+	down := crossContextMeta("b", dataplane.PodsSnapshot{
+		Err: &dataplane.NormalizedError{Class: dataplane.NormalizedErrorClassConnectivity},
+	}, errors.New("dial tcp: connection refused"))
+	if down.State != "unreachable" || down.ErrorClass != "connectivity" || down.Observed != "" {
+		t.Fatalf("unreachable: got %+v", down)
+	}
+
+	denied := crossContextMeta("c", dataplane.PodsSnapshot{
+		Err: &dataplane.NormalizedError{Class: dataplane.NormalizedErrorClassAccessDenied},
+	}, errors.New("forbidden"))
+	if denied.State != "denied" {
+		t.Fatalf("denied: got %+v", denied)
+	}
+}
+
+func TestTagRowsWithContext(t *testing.T) {
+	rows := tagRowsWithContext("prod", []dto.HelmReleaseDTO{{Name: "ingress-nginx", Namespace: "ingress", Revision: 7}})
+	if len(rows) != 1 || rows[0]["context"] != "prod" || rows[0]["name"] != "ingress-nginx" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+	if rev, _ := rows[0]["revision"].(interface{ String() string }); rev == nil || rev.String() != "7" {
+		t.Fatalf("numbers should round-trip unchanged, got %v", rows[0]["revision"])
+	}
+}
+
+func TestGetPods_ImageFilter(t *testing.T) {
+	s, h := newTestServer(t)
+	stub := s.dp.(*stubDataplane)
+	// Metrics warmups would reach the unimplemented PodMetricsSnapshot.
+	policy := stub.policy
+	policy.Metrics.Enabled = false
+	stub.effective["test-context"] = policy
+	stub.pods = map[string]dataplane.PodsSnapshot{"test-context": {
+		Items: []dto.PodListItemDTO{
+			{Name: "web-0", Namespace: "prod", Images: []string{"nginx:1.27", "busybox:1.36"}},
+			{Name: "api-0", Namespace: "prod", Images: []string{"ghcr.io/acme/api:2.1"}},
+		},
+		Meta: dataplane.SnapshotMetadata{ObservedAt: time.Now()},
+	}}
+
+	for _, path := range []string{
+		"/api/namespaces/prod/pods?image=nginx",
+		"/api/namespaces/prod/pods?image=nginx&contexts=all",
+	} {
+		rec := doReq(t, h, http.MethodGet, path, testToken, nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: status %d (body=%s)", path, rec.Code, rec.Body.String())
+		}
+		items, _ := mustDecodeJSON(t, rec.Body.Bytes())["items"].([]any)
+		if len(items) != 1 || items[0].(map[string]any)["name"] != "web-0" {
+			t.Fatalf("%s: expected only web-0, got %v", path, items)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/korex-labs/kview/v5/internal/dataplane"
+)
+
+const (
+	dataplaneEventsBuffer    = 256
+	dataplaneEventsHeartbeat = 20 * time.Second
+	dataplaneEventsRetryMS   = 3000
+)
+
+// dataplaneEventFilter narrows the shared event stream to what one client asked for.
+// Kind filters apply to revision events only; signal events match on context and namespace.
+type dataplaneEventFilter struct {
+	allContexts bool
+	context     string
+	kinds       map[dataplane.ResourceKind]bool
+	namespace   string
+}
+
+func (f dataplaneEventFilter) matches(ev dataplane.DataplaneEvent) bool {
+	if !f.allContexts && ev.Context != f.context {
+		return false
+	}
+	if f.namespace != "" && ev.Namespace != "" && ev.Namespace != f.namespace {
+		return false
+	}
+	if ev.Type == dataplane.DataplaneEventRevision && len(f.kinds) > 0 && !f.kinds[ev.Kind] {
+		return false
+	}
+	return true
+}
+
+// serveDataplaneEvents streams dataplane revision and signal changes as Server-Sent Events.
+// Clients that fall behind get a "resync" event and are disconnected; they should refetch
+// list snapshots and reconnect.
+func (s *Server) serveDataplaneEvents(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := dataplaneEventFilter{namespace: strings.TrimSpace(q.Get("namespace"))}
+	switch ctxName := strings.TrimSpace(q.Get("context")); ctxName {
+	case "all":
+		filter.allContexts = true
+	case "":
+		filter.context = s.readContextName(r)
+	default:
+		filter.context = ctxName
+	}
+	if raw := strings.TrimSpace(q.Get("kind")); raw != "" {
+		filter.kinds = map[dataplane.ResourceKind]bool{}
+		for _, part := range strings.Split(raw, ",") {
+			kind, ok := dataplane.ParseListRevisionResourceKind(part)
+			if !ok {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": "unknown kind query parameter: " + strings.TrimSpace(part)})
+				return
+			}
+			filter.kinds[kind] = true
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	events, cancel := s.dp.SubscribeEvents(dataplaneEventsBuffer)
+	defer cancel()
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", dataplaneEventsRetryMS); err != nil {
+		return
+	}
+	if err := writeSSEEvent(w, "ready", 0, map[string]any{"context": filter.context, "allContexts": filter.allContexts}); err != nil {
+		return
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(dataplaneEventsHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case ev, ok := <-events:
+			if !ok {
+				_ = writeSSEEvent(w, "resync", 0, map[string]any{"reason": "lagged"})
+				_ = rc.Flush()
+				return
+			}
+			if !filter.matches(ev) {
+				continue
+			}
+			if err := writeSSEEvent(w, ev.Type, ev.ID, ev); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeSSEEvent(w http.ResponseWriter, name string, id uint64, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	b.WriteString("event: ")
+	b.WriteString(name)
+	b.WriteByte('\n')
+	if id > 0 {
+		fmt.Fprintf(&b, "id: %d\n", id)
+	}
+	b.WriteString("data: ")
+	b.Write(data)
+	b.WriteString("\n\n")
+	_, err = w.Write([]byte(b.String()))
+	return err
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+	"github.com/korex-labs/kview/v5/internal/kube/resource/apiresources"
+	crs "github.com/korex-labs/kview/v5/internal/kube/resource/customresources"
+	kubeevents "github.com/korex-labs/kview/v5/internal/kube/resource/events"
+)
+
+// registerAPIResourceRoutes serves a discovery-driven browser for any API resource,
+// including kinds without a dedicated endpoint. The core group is spelled "core" in paths.
+func (s *Server) registerAPIResourceRoutes(api chi.Router) {
+	api.Get("/resources", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		items, failedGroups, err := apiresources.ListAPIResources(clients)
+		if err != nil {
+			writeGenericResourceError(w, active, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "items": items, "failedGroups": failedGroups})
+	})
+
+	api.Get("/resources/{group}/{version}/{resource}", func(w http.ResponseWriter, r *http.Request) {
+		gvr := genericResourceGVR(r)
+		namespace := strings.TrimSpace(r.URL.Query().Get("namespace"))
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		// Resolve before touching the dataplane: every listed GVR gets its own cached
+		// store, so unknown resources must not reach it.
+		_, active, res, ok := s.discoverGenericResource(ctx, w, r)
+		if !ok {
+			return
+		}
+		if !res.Namespaced && namespace != "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": gvr.Resource + " is cluster-scoped; omit the namespace query parameter", "active": active})
+			return
+		}
+		if s.dp != nil {
+			s.dp.EnsureObservers(ctx, active)
+		}
+		snap, err := s.dp.GenericResourcesSnapshot(ctx, active, gvr, namespace)
+		if err != nil && len(snap.Items) == 0 {
+			if apierrors.IsNotFound(err) {
+				writeGenericResourceError(w, active, err)
+				return
+			}
+			writeDataplaneListError(w, active, err)
+			return
+		}
+		writeDataplaneListResponse(w, active, snap.Items, snap.Meta, snap.Err)
+	})
+
+	api.Get("/resources/{group}/{version}/{resource}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutDetail)
+		defer cancel()
+
+		clients, active, res, ok := s.resolveGenericResource(ctx, w, r)
+		if !ok {
+			return
+		}
+		det, err := getGenericResourceDetails(ctx, clients, res, r)
+		if err != nil {
+			writeGenericResourceError(w, active, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "resource": res, "item": det})
+	})
+
+	api.Get("/resources/{group}/{version}/{resource}/{name}/yaml", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutDetail)
+		defer cancel()
+
+		clients, active, res, ok := s.resolveGenericResource(ctx, w, r)
+		if !ok {
+			return
+		}
+		det, err := getGenericResourceDetails(ctx, clients, res, r)
+		if err != nil {
+			writeGenericResourceError(w, active, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "yaml": det.YAML})
+	})
+
+	api.Get("/resources/{group}/{version}/{resource}/{name}/events", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, res, ok := s.resolveGenericResource(ctx, w, r)
+		if !ok {
+			return
+		}
+		namespace := strings.TrimSpace(r.URL.Query().Get("namespace"))
+		result, err := kubeevents.ListEventsForObjectPage(ctx, clients, namespace, res.Kind, chi.URLParam(r, "name"), readEventListOptions(r))
+		if err != nil {
+			writeGenericResourceError(w, active, err)
+			return
+		}
+		writeEventListResponse(w, active, result)
+	})
+}
+
+func genericResourceGVR(r *http.Request) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    apiresources.GroupFromPath(chi.URLParam(r, "group")),
+		Version:  chi.URLParam(r, "version"),
+		Resource: chi.URLParam(r, "resource"),
+	}
+}
+
+// discoverGenericResource looks the resource of the request path up through discovery.
+// It writes the error response itself; unknown resources are a 404.
+func (s *Server) discoverGenericResource(ctx context.Context, w http.ResponseWriter, r *http.Request) (*cluster.Clients, string, dto.APIResourceDTO, bool) {
+	gvr := genericResourceGVR(r)
+	clients, active, err := s.clientsForRequest(ctx, r)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+		return nil, active, dto.APIResourceDTO{}, false
+	}
+	res, err := apiresources.ResolveAPIResource(clients, gvr.Group, gvr.Version, gvr.Resource)
+	if err != nil {
+		writeGenericResourceError(w, active, err)
+		return nil, active, dto.APIResourceDTO{}, false
+	}
+	return clients, active, res, true
+}
+
+// resolveGenericResource looks the resource up through discovery and checks that the
+// namespace query parameter matches its scope. It writes the error response itself.
+func (s *Server) resolveGenericResource(ctx context.Context, w http.ResponseWriter, r *http.Request) (*cluster.Clients, string, dto.APIResourceDTO, bool) {
+	gvr := genericResourceGVR(r)
+	namespace := strings.TrimSpace(r.URL.Query().Get("namespace"))
+
+	clients, active, res, ok := s.discoverGenericResource(ctx, w, r)
+	if !ok {
+		return nil, active, res, false
+	}
+	switch {
+	case res.Namespaced && namespace == "":
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "namespace query parameter is required for " + gvr.Resource, "active": active})
+		return nil, active, res, false
+	case !res.Namespaced && namespace != "":
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": gvr.Resource + " is cluster-scoped; omit the namespace query parameter", "active": active})
+		return nil, active, res, false
+	}
+	return clients, active, res, true
+}
+
+func getGenericResourceDetails(ctx context.Context, c *cluster.Clients, res dto.APIResourceDTO, r *http.Request) (*dto.CustomResourceDetailsDTO, error) {
+	dynClient, err := dynamic.NewForConfig(c.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+	namespace := strings.TrimSpace(r.URL.Query().Get("namespace"))
+	return crs.GetCustomResourceDetails(ctx, dynClient, res.Group, res.Version, res.Resource, namespace, chi.URLParam(r, "name"))
+}
+
+func writeGenericResourceError(w http.ResponseWriter, active string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case apierrors.IsForbidden(err):
+		status = http.StatusForbidden
+	case apierrors.IsNotFound(err):
+		status = http.StatusNotFound
+	}
+	writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+}
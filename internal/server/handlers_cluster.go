@@ -13,11 +13,14 @@ import (
 	"github.com/korex-labs/kview/v5/internal/kube/dto"
 	crbindings "github.com/korex-labs/kview/v5/internal/kube/resource/clusterrolebindings"
 	clusterroles "github.com/korex-labs/kview/v5/internal/kube/resource/clusterroles"
+	"github.com/korex-labs/kview/v5/internal/kube/resource/csidrivers"
 	crds "github.com/korex-labs/kview/v5/internal/kube/resource/customresourcedefinitions"
 	crs "github.com/korex-labs/kview/v5/internal/kube/resource/customresources"
 	kubeevents "github.com/korex-labs/kview/v5/internal/kube/resource/events"
 	nodes "github.com/korex-labs/kview/v5/internal/kube/resource/nodes"
 	pvs "github.com/korex-labs/kview/v5/internal/kube/resource/persistentvolumes"
+	"github.com/korex-labs/kview/v5/internal/kube/resource/storageclasses"
+	"github.com/korex-labs/kview/v5/internal/kube/resource/volumeattachments"
 )
 
 func (s *Server) registerClusterResourceRoutes(api chi.Router) {
@@ -628,4 +631,99 @@ func (s *Server) registerClusterResourceRoutes(api chi.Router) {
 
 		writeJSON(w, http.StatusOK, map[string]any{"active": active, "yaml": y})
 	})
+
+	api.Get("/storageclasses", dataplaneClusterListHandler(s, s.dp.StorageClassesSnapshot, nil))
+
+	api.Get("/storageclasses/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		if name == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing storageclass name"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		det, err := storageclasses.GetStorageClassDetails(ctx, clients, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": det})
+	})
+
+	api.Get("/csidrivers", dataplaneClusterListHandler(s, s.dp.CSIDriversSnapshot, nil))
+
+	api.Get("/csidrivers/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		if name == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing csidriver name"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		det, err := csidrivers.GetCSIDriverDetails(ctx, clients, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": det})
+	})
+
+	api.Get("/volumeattachments", dataplaneClusterListHandler(s, s.dp.VolumeAttachmentsSnapshot, func(items []dto.VolumeAttachmentDTO) any {
+		return dataplane.EnrichVolumeAttachmentListItemsForAPI(items)
+	}))
+
+	api.Get("/volumeattachments/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		if name == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing volumeattachment name"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		det, err := volumeattachments.GetVolumeAttachmentDetails(ctx, clients, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": det})
+	})
 }
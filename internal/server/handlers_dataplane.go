@@ -187,6 +187,30 @@ func (s *Server) registerActivityAndDataplaneRoutes(api chi.Router) {
 		})
 	})
 
+	// The fleet dashboard reads only cached snapshots per context; all-context
+	// enrichment keeps them warm in the background. Contexts are scoped by their
+	// session identity only; the impersonation header applies to the active context.
+	api.Get("/dashboard/fleet", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutDetail)
+		defer cancel()
+
+		contexts := s.mgr.ListContexts()
+		rows := make([]dataplane.FleetContextSummary, 0, len(contexts))
+		for _, c := range contexts {
+			if c.Name == "" {
+				continue
+			}
+			name := s.sessionScopedContextName(c.Name)
+			row := s.dp.FleetContextSummary(ctx, name)
+			row.Reachability = s.connectivityReachability(name)
+			rows = append(rows, row)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"active": s.readContextName(r),
+			"item":   dataplane.BuildFleetDashboard(rows),
+		})
+	})
+
 	api.Get("/dataplane/revision", func(w http.ResponseWriter, r *http.Request) {
 		kindStr := strings.TrimSpace(r.URL.Query().Get("kind"))
 		kind, ok := dataplane.ParseListRevisionResourceKind(kindStr)
@@ -220,6 +244,8 @@ func (s *Server) registerActivityAndDataplaneRoutes(api chi.Router) {
 		writeJSON(w, http.StatusOK, env)
 	})
 
+	api.Get("/dataplane/events", s.serveDataplaneEvents)
+
 	api.Get("/contexts", func(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusOK, map[string]any{
 			"active":         s.mgr.ActiveContext(),
@@ -21,12 +21,14 @@ func (s *Server) registerCapabilitiesAndActionsRoutes(api chi.Router) {
 			})
 			return
 		}
+		ctxName = s.scopeContextName(r, ctxName)
 
 		var body struct {
-			Group     string `json:"group"`
-			Resource  string `json:"resource"`
-			Namespace string `json:"namespace"`
-			Name      string `json:"name"`
+			Group       string `json:"group"`
+			Resource    string `json:"resource"`
+			Subresource string `json:"subresource"`
+			Namespace   string `json:"namespace"`
+			Name        string `json:"name"`
 		}
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Resource == "" {
 			writeJSON(w, http.StatusBadRequest, map[string]any{"error": validationError("invalid body")})
@@ -51,10 +53,11 @@ func (s *Server) registerCapabilitiesAndActionsRoutes(api chi.Router) {
 		}
 
 		caps, err := kube.CheckCapabilities(ctx, clients, kube.CapabilitiesRequest{
-			Group:     body.Group,
-			Resource:  body.Resource,
-			Namespace: body.Namespace,
-			Name:      body.Name,
+			Group:       body.Group,
+			Resource:    body.Resource,
+			Subresource: body.Subresource,
+			Namespace:   body.Namespace,
+			Name:        body.Name,
 		})
 		if err != nil {
 			status, apiErr := mapKubeError(err)
@@ -73,6 +76,7 @@ func (s *Server) registerCapabilitiesAndActionsRoutes(api chi.Router) {
 			})
 			return
 		}
+		ctxName = s.scopeContextName(r, ctxName)
 
 		var body kube.ActionRequest
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Resource == "" || body.Action == "" {
@@ -97,6 +101,11 @@ func (s *Server) registerCapabilitiesAndActionsRoutes(api chi.Router) {
 			return
 		}
 
+		if blocked := s.dependentsGuard(ctx, ctxName, body); blocked != nil {
+			writeJSON(w, http.StatusOK, map[string]any{"context": ctxName, "result": blocked})
+			return
+		}
+
 		result, err := s.actions.Execute(ctx, clients, body)
 		if err != nil {
 			if errors.Is(err, kube.ErrUnknownAction) {
@@ -115,7 +124,7 @@ func (s *Server) registerCapabilitiesAndActionsRoutes(api chi.Router) {
 		if body.Resource == "helmreleases" && body.Namespace != "" {
 			_ = s.dp.InvalidateHelmReleasesSnapshot(ctx, ctxName, body.Namespace)
 		}
-		if body.Action == "resource.yaml.apply" && body.Namespace != "" {
+		if (body.Action == "resource.yaml.apply" || body.Action == "resource.finalizers.remove") && body.Namespace != "" {
 			switch body.Resource {
 			case "deployments":
 				_ = s.dp.InvalidateDeploymentsSnapshot(ctx, ctxName, body.Namespace)
@@ -139,6 +148,9 @@ func (s *Server) registerCapabilitiesAndActionsRoutes(api chi.Router) {
 		if body.Action == "cronjob.run" && body.Namespace != "" {
 			_ = s.dp.InvalidateJobsSnapshot(ctx, ctxName, body.Namespace)
 		}
+		if (body.Action == "cronjob.suspend" || body.Action == "cronjob.resume") && body.Namespace != "" {
+			_ = s.dp.InvalidateCronJobsSnapshot(ctx, ctxName, body.Namespace)
+		}
 
 		writeJSON(w, http.StatusOK, map[string]any{"context": ctxName, "result": result})
 	})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -131,6 +132,36 @@ func (s *Server) registerNamespaceRoutes(api chi.Router) {
 		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": det})
 	})
 
+	api.Get("/namespaces/{name}/remaining-resources", func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		if name == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing namespace name"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		active := s.readContextName(r)
+		clients, active, err := s.mgr.GetClientsForContext(ctx, active)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		item, err := namespaces.ListRemainingResources(ctx, clients, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": item})
+	})
+
 	api.Get("/namespaces/{name}/events", func(w http.ResponseWriter, r *http.Request) {
 		name := chi.URLParam(r, "name")
 		if name == "" {
@@ -205,6 +236,30 @@ func (s *Server) registerNamespaceRoutes(api chi.Router) {
 		})
 	})
 
+	// Reverse references: which cached pods, workloads, Ingresses and ServiceAccounts
+	// use a ConfigMap, Secret, PVC or ServiceAccount, and how. Built from snapshots only.
+	api.Get("/namespaces/{ns}/{kind}/{name}/referrers", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		route := chi.URLParam(r, "kind")
+		name := chi.URLParam(r, "name")
+		kind, ok := dataplane.ReferenceKindFromRoute(route)
+		if !ok {
+			writeJSON(w, http.StatusNotFound, map[string]any{"error": "unknown resource kind for referrers", "kind": route})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutProjection)
+		defer cancel()
+		active := s.readContextName(r)
+
+		res, err := s.dp.ResourceReferrers(ctx, active, ns, kind, name)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": res})
+	})
+
 	// Per-resource signals — cluster-scoped.
 	// Same contract as the namespace-scoped variant for cluster-level
 	// resources (Node, PersistentVolume, ClusterRole, …). Lives under the
@@ -275,6 +330,36 @@ func (s *Server) registerNamespaceRoutes(api chi.Router) {
 		})
 	})
 
+	// Topology of the namespace from dataplane snapshots: owner, selector, routing,
+	// reference, and RBAC edges, with the signal severity of every node.
+	api.Get("/namespaces/{name}/graph", func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		if name == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing namespace name"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutProjection)
+		defer cancel()
+
+		active := s.readContextName(r)
+
+		graph, err := s.dp.NamespaceGraph(ctx, active, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"active": active,
+			"item":   graph,
+		})
+	})
+
 	api.Get("/namespaces/{name}/summary", func(w http.ResponseWriter, r *http.Request) {
 		name := chi.URLParam(r, "name")
 		if name == "" {
@@ -391,4 +476,40 @@ func (s *Server) registerNamespaceRoutes(api chi.Router) {
 
 		writeJSON(w, http.StatusOK, map[string]any{"allowed": res.Allowed, "reason": res.Reason})
 	})
+
+	// Reverse lookup over the cached RBAC snapshots:
+	// ?verb=<verb>&resource=<resource[/subresource]>[&group=&namespace=&name=].
+	// Without a namespace only cluster-wide grants are considered.
+	api.Get("/auth/who-can", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		verb := strings.TrimSpace(q.Get("verb"))
+		resource, subresource, _ := strings.Cut(strings.TrimSpace(q.Get("resource")), "/")
+		if verb == "" || resource == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "verb and resource are required"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		active := s.readContextName(r)
+		res, err := s.dp.WhoCan(ctx, active, dataplane.WhoCanRequest{
+			Verb:        verb,
+			Group:       strings.TrimSpace(q.Get("group")),
+			Resource:    resource,
+			Subresource: subresource,
+			Name:        strings.TrimSpace(q.Get("name")),
+			Namespace:   strings.TrimSpace(q.Get("namespace")),
+		})
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": res})
+	})
 }
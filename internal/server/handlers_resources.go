@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -12,6 +13,7 @@ import (
 	configmaps "github.com/korex-labs/kview/v5/internal/kube/resource/configmaps"
 	kubeevents "github.com/korex-labs/kview/v5/internal/kube/resource/events"
 	ingresses "github.com/korex-labs/kview/v5/internal/kube/resource/ingresses"
+	netpols "github.com/korex-labs/kview/v5/internal/kube/resource/networkpolicies"
 	pvcs "github.com/korex-labs/kview/v5/internal/kube/resource/persistentvolumeclaims"
 	rolebindings "github.com/korex-labs/kview/v5/internal/kube/resource/rolebindings"
 	roles "github.com/korex-labs/kview/v5/internal/kube/resource/roles"
@@ -267,6 +269,29 @@ func (s *Server) registerNamespacedResourceRoutes(api chi.Router) {
 		writeJSON(w, http.StatusOK, map[string]any{"active": active, "items": items})
 	})
 
+	// The matrix is built from the cached RBAC snapshots: ClusterRoleBindings plus the
+	// RoleBindings of the ServiceAccount's namespace and of every namespace already cached.
+	api.Get("/namespaces/{ns}/serviceaccounts/{name}/permissions", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		active := s.readContextName(r)
+		res, err := s.dp.ServiceAccountPermissions(ctx, active, ns, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": res})
+	})
+
 	api.Get("/namespaces/{ns}/roles", dataplaneNamespacedListHandler(s, s.dp.RolesSnapshot, func(items []dto.RoleListItemDTO) any {
 		return dataplane.EnrichRoleListItemsForAPI(items)
 	}))
@@ -624,4 +649,105 @@ func (s *Server) registerNamespacedResourceRoutes(api chi.Router) {
 
 		writeEventListResponse(w, active, result)
 	})
+
+	api.Get("/namespaces/{ns}/networkpolicies", dataplaneNamespacedListHandler(s, s.dp.NetworkPoliciesSnapshot, nil))
+
+	api.Get("/namespaces/{ns}/networkpolicies/{name}", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		det, err := netpols.GetNetworkPolicyDetails(ctx, clients, ns, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": det})
+	})
+
+	api.Get("/namespaces/{ns}/networkpolicies/{name}/events", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		result, err := kubeevents.ListEventsForObjectPage(ctx, clients, ns, "NetworkPolicy", name, readEventListOptions(r))
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeEventListResponse(w, active, result)
+	})
+
+	// Reachability is evaluated from the dataplane pod, namespace, and NetworkPolicy
+	// snapshots: ?from=<ns>/<pod>&to=<ns>/<pod>&port=<number|name>[&protocol=TCP|UDP|SCTP].
+	api.Get("/networkpolicies/reachability", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		srcNs, srcPod, okSrc := splitNamespacedName(q.Get("from"))
+		dstNs, dstPod, okDst := splitNamespacedName(q.Get("to"))
+		port := strings.TrimSpace(q.Get("port"))
+		if !okSrc || !okDst || port == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "from and to must be <namespace>/<pod> and port is required"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		active := s.readContextName(r)
+		res, err := s.dp.NetworkReachability(ctx, active, dataplane.NetworkReachabilityRequest{
+			SourceNamespace:      srcNs,
+			SourcePod:            srcPod,
+			DestinationNamespace: dstNs,
+			DestinationPod:       dstPod,
+			Port:                 port,
+			Protocol:             q.Get("protocol"),
+		})
+		if err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case apierrors.IsForbidden(err):
+				status = http.StatusForbidden
+			case apierrors.IsNotFound(err):
+				status = http.StatusNotFound
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": res})
+	})
+}
+
+func splitNamespacedName(v string) (string, string, bool) {
+	ns, name, ok := strings.Cut(strings.TrimSpace(v), "/")
+	if !ok || ns == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", false
+	}
+	return ns, name, true
 }
@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -145,6 +149,7 @@ func (s *Server) registerSessionRoutes(api chi.Router) {
 			Container string `json:"container"`
 			Title     string `json:"title"`
 			Shell     string `json:"shell"`
+			terminalSessionOptions
 		}
 		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid body"})
@@ -165,6 +170,7 @@ func (s *Server) registerSessionRoutes(api chi.Router) {
 		if shell := strings.TrimSpace(body.Shell); shell != "" {
 			metadata["shell"] = shell
 		}
+		body.applyTo(metadata)
 
 		sess := session.Session{
 			Type:            session.TypeTerminal,
@@ -191,6 +197,100 @@ func (s *Server) registerSessionRoutes(api chi.Router) {
 		writeJSON(w, http.StatusOK, map[string]any{"item": created})
 	})
 
+	api.Post("/sessions/debug", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutDetail)
+		defer cancel()
+
+		var body struct {
+			kube.DebugContainerRequest
+			Title string `json:"title"`
+			terminalSessionOptions
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid body"})
+			return
+		}
+		if strings.TrimSpace(body.Namespace) == "" || strings.TrimSpace(body.Pod) == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "namespace and pod are required"})
+			return
+		}
+
+		contextName := s.readContextName(r)
+		clients, clusterName, err := s.mgr.GetClientsForContext(ctx, contextName)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "failed to get Kubernetes client"})
+			return
+		}
+
+		// Same checks the UI runs through /api/capabilities before offering the debug action:
+		// update on pods/ephemeralcontainers to add the container, create on pods/attach to use it.
+		for _, check := range []struct {
+			subresource string
+			allowed     func(*kube.CapabilitiesResult) bool
+		}{
+			{"ephemeralcontainers", func(c *kube.CapabilitiesResult) bool { return c.Update || c.Patch }},
+			{"attach", func(c *kube.CapabilitiesResult) bool { return c.Create }},
+		} {
+			caps, err := kube.CheckCapabilities(ctx, clients, kube.CapabilitiesRequest{
+				Resource:    "pods",
+				Subresource: check.subresource,
+				Namespace:   body.Namespace,
+				Name:        body.Pod,
+			})
+			if err != nil {
+				status, apiErr := mapKubeError(err)
+				writeJSON(w, status, map[string]any{"error": apiErr.Message})
+				return
+			}
+			if !check.allowed(caps) {
+				writeJSON(w, http.StatusForbidden, map[string]any{"error": "not permitted by RBAC: pods/" + check.subresource})
+				return
+			}
+		}
+
+		name, err := kube.DebugContainerClient{Clientset: clients.Clientset}.Add(ctx, body.DebugContainerRequest)
+		if err != nil {
+			status, apiErr := mapKubeError(err)
+			writeJSON(w, status, map[string]any{"error": apiErr.Message})
+			return
+		}
+
+		image := strings.TrimSpace(body.Image)
+		if image == "" {
+			image = kube.DefaultDebugImage
+		}
+		title := strings.TrimSpace(body.Title)
+		if title == "" {
+			title = body.Pod + " (debug)"
+		}
+		metadata := map[string]string{"image": image}
+		if body.TargetContainer != "" {
+			metadata["debugTarget"] = body.TargetContainer
+		}
+		body.applyTo(metadata)
+
+		sess := session.Session{
+			Type:            session.TypeDebug,
+			Title:           title,
+			Status:          session.StatusPending,
+			TargetCluster:   clusterName,
+			TargetNamespace: body.Namespace,
+			TargetResource:  body.Pod,
+			TargetContainer: name,
+			ConnectionState: session.ConnectionDisconnected,
+			Metadata:        metadata,
+		}
+		created, err := s.sessions.Create(ctx, sess)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "failed to create debug session"})
+			return
+		}
+		logStructured(s.rt, runtime.LogLevelInfo, "sessions", "success",
+			fmt.Sprintf("added debug container %s (image=%s, target=%s) to pod %s/%s", name, image, body.TargetContainer, body.Namespace, body.Pod),
+			"session_id", created.ID, "kind", "debug", "context", clusterName, "namespace", body.Namespace, "name", body.Pod, "container", name)
+		writeJSON(w, http.StatusOK, map[string]any{"item": created})
+	})
+
 	api.Post("/container-commands/run", func(w http.ResponseWriter, r *http.Request) {
 		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutExec)
 		defer cancel()
@@ -427,7 +527,43 @@ func (s *Server) registerSessionRoutes(api chi.Router) {
 		})
 	})
 
-	api.Get("/sessions/{id}/terminal/ws", (&stream.TerminalWS{Mgr: s.mgr, Sessions: s.sessions}).ServeHTTP)
+	api.Get("/sessions/{id}/terminal/ws", (&stream.TerminalWS{Mgr: s.mgr, Sessions: s.sessions, RecordingsDir: s.recordingsDir}).ServeHTTP)
+
+	// Recordings outlive their session (postmortems), so these read the recordings
+	// directory by session ID without requiring the session to still exist.
+	api.Get("/sessions/{id}/recordings", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		items, err := stream.ListTerminalRecordings(s.recordingsDir, id)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "failed to list recordings"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"items": items})
+	})
+
+	api.Get("/sessions/{id}/recordings/{name}", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		name := chi.URLParam(r, "name")
+		path, ok := stream.TerminalRecordingPath(s.recordingsDir, id, name)
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid recording name"})
+			return
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				writeJSON(w, http.StatusNotFound, map[string]any{"error": "recording not found"})
+				return
+			}
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "failed to open recording"})
+			return
+		}
+		defer func() { _ = f.Close() }()
+		w.Header().Set("Content-Type", "application/x-asciicast")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = io.Copy(w, f)
+	})
 
 	api.Post("/namespaces/{ns}/job-runs/debug", func(w http.ResponseWriter, r *http.Request) {
 		ctxName := r.Header.Get("X-Kview-Context")
@@ -521,3 +657,25 @@ func (s *Server) registerSessionRoutes(api chi.Router) {
 		writeJSON(w, http.StatusOK, map[string]any{"status": "ok"})
 	})
 }
+
+// terminalSessionOptions are the optional reattach and recording settings shared by
+// terminal and debug session requests; see stream.DefaultTerminalReattachGrace and
+// stream.DefaultTerminalScrollback.
+type terminalSessionOptions struct {
+	ReattachGraceSec *int `json:"reattachGraceSec"`
+	ScrollbackBytes  *int `json:"scrollbackBytes"`
+	// Record captures the session as an asciinema v2 cast under the kview cache dir.
+	Record bool `json:"record"`
+}
+
+func (o terminalSessionOptions) applyTo(metadata map[string]string) {
+	if o.ReattachGraceSec != nil {
+		metadata["reattachGraceSec"] = strconv.Itoa(*o.ReattachGraceSec)
+	}
+	if o.ScrollbackBytes != nil {
+		metadata["scrollbackBytes"] = strconv.Itoa(*o.ScrollbackBytes)
+	}
+	if o.Record {
+		metadata["record"] = "true"
+	}
+}
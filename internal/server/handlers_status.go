@@ -10,6 +10,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/korex-labs/kview/v5/internal/buildinfo"
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/dataplane"
 	"github.com/korex-labs/kview/v5/internal/runtime"
 	"github.com/korex-labs/kview/v5/internal/session"
 )
@@ -64,7 +66,7 @@ func (s *Server) buildStatus(parent context.Context, contextName string) statusD
 	checkedAt := time.Now().UTC()
 	clusterStatus := statusClusterDTO{Context: contextName}
 	if s.mgr != nil {
-		if info, ok := s.mgr.ContextInfo(contextName); ok {
+		if info, ok := s.mgr.ContextInfo(cluster.BaseContextName(contextName)); ok {
 			clusterStatus.Cluster = info.Cluster
 			clusterStatus.AuthInfo = info.AuthInfo
 			clusterStatus.Namespace = info.Namespace
@@ -201,6 +203,28 @@ func (s *Server) updateConnectivityActivity(clusterStatus statusClusterDTO) {
 	})
 }
 
+// connectivityReachability reports the last connectivity probe of a context from its
+// connectivity activity. Contexts that were never active have no activity and report
+// unknown; the activity of a context left for another expires after connectivityActivityTTL.
+func (s *Server) connectivityReachability(contextName string) dataplane.FleetContextReachability {
+	out := dataplane.FleetContextReachability{State: "unknown"}
+	if s == nil || s.rt == nil || s.rt.Registry() == nil {
+		return out
+	}
+	act, ok, err := s.rt.Registry().Get(context.Background(), fmt.Sprintf("connectivity:%s", contextName))
+	if err != nil || !ok || act.Type != runtime.ActivityTypeConnectivity {
+		return out
+	}
+	if state := act.Metadata["state"]; state != "" {
+		out.State = state
+	}
+	out.Message = act.Metadata["message"]
+	if !act.UpdatedAt.IsZero() {
+		out.CheckedAt = act.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
 func (s *Server) stopInactiveConnectivityActivitiesExcept(activeContext string) {
 	if s == nil || s.rt == nil || s.rt.Registry() == nil {
 		return
@@ -265,7 +289,7 @@ func (s *Server) hasOpenSessionForContext(ctx context.Context, contextName strin
 		if item.TargetCluster != contextName {
 			continue
 		}
-		if item.Type != session.TypeTerminal && item.Type != session.TypePortForward {
+		if item.Type != session.TypeTerminal && item.Type != session.TypePortForward && item.Type != session.TypeDebug {
 			continue
 		}
 		if item.Status == session.StatusPending ||
@@ -1,8 +1,14 @@
 package server
 
 import (
+	"compress/gzip"
 	"context"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -16,6 +22,7 @@ import (
 	kubeevents "github.com/korex-labs/kview/v5/internal/kube/resource/events"
 	hpas "github.com/korex-labs/kview/v5/internal/kube/resource/horizontalpodautoscalers"
 	jobs "github.com/korex-labs/kview/v5/internal/kube/resource/jobs"
+	pdbs "github.com/korex-labs/kview/v5/internal/kube/resource/poddisruptionbudgets"
 	pods "github.com/korex-labs/kview/v5/internal/kube/resource/pods"
 	replicasets "github.com/korex-labs/kview/v5/internal/kube/resource/replicasets"
 	statefulsets "github.com/korex-labs/kview/v5/internal/kube/resource/statefulsets"
@@ -29,6 +36,27 @@ func (s *Server) registerWorkloadRoutes(api chi.Router) {
 			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing namespace"})
 			return
 		}
+		image := strings.TrimSpace(r.URL.Query().Get("image"))
+		if contexts, ok, err := s.requestedContexts(r); ok {
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": err.Error()})
+				return
+			}
+			// Cross-context rows merge only already cached pod metrics; no warmups
+			// are started for the other contexts.
+			serveCrossContextList(s, w, r, contexts, func(ctx context.Context, contextName string) (dataplane.PodsSnapshot, error) {
+				return s.dp.PodsSnapshot(ctx, contextName, ns)
+			}, func(contextName string, items []dto.PodListItemDTO) any {
+				items = filterPodsByImage(items, image)
+				var podMetricsItems []dto.PodMetricsDTO
+				if msnap, ok := s.dp.PodMetricsCachedSnapshot(contextName, ns); ok {
+					podMetricsItems = msnap.Items
+				}
+				items = dataplane.EnrichPodListItemsWithMetrics(items, dataplane.BuildPodMetricsIndex(podMetricsItems))
+				return dataplane.EnrichPodListItemsWithSignalSummary(items, ns, podMetricsItems, s.dp.EffectivePolicy(contextName), time.Now())
+			})
+			return
+		}
 		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
 		defer cancel()
 		active := s.readContextName(r)
@@ -54,7 +82,7 @@ func (s *Server) registerWorkloadRoutes(api chi.Router) {
 		if msnap, ok := s.dp.PodMetricsCachedSnapshot(active, ns); ok && len(msnap.Items) > 0 {
 			podMetricsItems = msnap.Items
 		}
-		items := dataplane.EnrichPodListItemsWithMetrics(snap.Items, dataplane.BuildPodMetricsIndex(podMetricsItems))
+		items := dataplane.EnrichPodListItemsWithMetrics(filterPodsByImage(snap.Items, image), dataplane.BuildPodMetricsIndex(podMetricsItems))
 		items = dataplane.EnrichPodListItemsWithSignalSummary(items, ns, podMetricsItems, s.dp.EffectivePolicy(active), time.Now())
 		writeDataplaneListResponse(w, active, items, snap.Meta, snap.Err)
 	})
@@ -178,7 +206,38 @@ func (s *Server) registerWorkloadRoutes(api chi.Router) {
 		writeJSON(w, http.StatusOK, map[string]any{"active": active, "items": items})
 	})
 
+	// The pod is read live; nodes, pods, PVCs and PVs come from the dataplane snapshots.
+	api.Get("/namespaces/{ns}/pods/{name}/scheduling", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutDetail)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		spec, err := pods.GetPodSchedulingSpec(ctx, clients, ns, name)
+		if err != nil {
+			writeGenericResourceError(w, active, err)
+			return
+		}
+		res, err := s.dp.PodScheduling(ctx, active, spec)
+		if err != nil {
+			writeGenericResourceError(w, active, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": res})
+	})
+
 	api.Get("/namespaces/{ns}/pods/{name}/logs/ws", (&stream.LogsWS{Mgr: s.mgr}).ServeHTTP)
+	// Multi-pod logs resolve targets from the pods snapshot (labels + container names on
+	// the list row); only the workload selector lookup and the log streams are live reads.
+	api.Get("/namespaces/{ns}/logs/ws", (&stream.AggregatedLogsWS{Mgr: s.mgr, Pods: s.podLogTargets}).ServeHTTP)
+	api.Get("/namespaces/{ns}/pods/{name}/logs/download", s.handlePodLogsDownload)
 
 	// Namespaced workload list routes below (daemonsets, statefulsets, replicasets, jobs, cronjobs, HPAs) are
 	// dataplane-backed: s.dp.*Snapshot + writeDataplaneListResponse. kube.List* for these kinds runs only
@@ -250,6 +309,34 @@ func (s *Server) registerWorkloadRoutes(api chi.Router) {
 		writeEventListResponse(w, active, result)
 	})
 
+	api.Get("/namespaces/{ns}/deployments/{name}/history", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		items, err := deployments.GetDeploymentRolloutHistory(ctx, clients, ns, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			} else if apierrors.IsNotFound(err) {
+				status = http.StatusNotFound
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "items": items})
+	})
+
 	api.Get("/namespaces/{ns}/daemonsets", dataplaneNamespacedListHandler(s, s.dp.DaemonSetsSnapshot, func(items []dto.DaemonSetDTO) any {
 		return dataplane.EnrichDaemonSetListItemsForAPI(items)
 	}))
@@ -567,7 +654,17 @@ func (s *Server) registerWorkloadRoutes(api chi.Router) {
 			return
 		}
 
-		det, err := cronjobs.GetCronJobDetails(ctx, clients, ns, name)
+		nextRuns := cronjobs.DefaultCronJobNextRuns
+		if v := r.URL.Query().Get("nextRuns"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 || n > cronjobs.MaxCronJobNextRuns {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": fmt.Sprintf("nextRuns must be an integer between 0 and %d", cronjobs.MaxCronJobNextRuns), "active": active})
+				return
+			}
+			nextRuns = n
+		}
+
+		det, err := cronjobs.GetCronJobDetails(ctx, clients, ns, name, nextRuns)
 		if err != nil {
 			status := http.StatusInternalServerError
 			if apierrors.IsForbidden(err) {
@@ -667,4 +764,154 @@ func (s *Server) registerWorkloadRoutes(api chi.Router) {
 
 		writeEventListResponse(w, active, result)
 	})
+
+	api.Get("/namespaces/{ns}/poddisruptionbudgets", dataplaneNamespacedListHandler(s, s.dp.PodDisruptionBudgetsSnapshot, func(items []dto.PodDisruptionBudgetDTO) any {
+		return dataplane.EnrichPodDisruptionBudgetListItemsForAPI(items)
+	}))
+
+	api.Get("/namespaces/{ns}/poddisruptionbudgets/{name}", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		det, err := pdbs.GetPodDisruptionBudgetDetails(ctx, clients, ns, name)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"active": active, "item": det})
+	})
+
+	api.Get("/namespaces/{ns}/poddisruptionbudgets/{name}/events", func(w http.ResponseWriter, r *http.Request) {
+		ns := chi.URLParam(r, "ns")
+		name := chi.URLParam(r, "name")
+
+		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
+		defer cancel()
+
+		clients, active, err := s.clientsForRequest(ctx, r)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		result, err := kubeevents.ListEventsForObjectPage(ctx, clients, ns, "PodDisruptionBudget", name, readEventListOptions(r))
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsForbidden(err) {
+				status = http.StatusForbidden
+			}
+			writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+			return
+		}
+
+		writeEventListResponse(w, active, result)
+	})
+}
+
+// podLogTargets returns pod rows for multi-pod log fan-out from the dataplane pods snapshot.
+func (s *Server) podLogTargets(ctx context.Context, contextName, namespace string) ([]dto.PodListItemDTO, error) {
+	snap, err := s.dp.PodsSnapshot(ctx, contextName, namespace)
+	if err != nil && len(snap.Items) == 0 {
+		return nil, err
+	}
+	return snap.Items, nil
+}
+
+// handlePodLogsDownload streams a container log as an attachment. It accepts the same
+// options as the logs websocket (never following) and gzip-compresses the body when
+// compress=true, mirroring the compress flag on container command requests.
+func (s *Server) handlePodLogsDownload(w http.ResponseWriter, r *http.Request) {
+	ns := chi.URLParam(r, "ns")
+	name := chi.URLParam(r, "name")
+
+	opts, err := stream.PodLogOptionsFromQuery(r.URL.Query(), 0)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": err.Error()})
+		return
+	}
+	opts.Follow = false
+
+	ctx := r.Context()
+	clients, active, err := s.clientsForRequest(ctx, r)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error(), "active": active})
+		return
+	}
+
+	logs, err := clients.Clientset.CoreV1().Pods(ns).GetLogs(name, opts).Stream(ctx)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case apierrors.IsForbidden(err):
+			status = http.StatusForbidden
+		case apierrors.IsNotFound(err):
+			status = http.StatusNotFound
+		case apierrors.IsBadRequest(err):
+			status = http.StatusBadRequest
+		}
+		writeJSON(w, status, map[string]any{"error": err.Error(), "active": active})
+		return
+	}
+	defer func() { _ = logs.Close() }()
+
+	filename := name
+	if opts.Container != "" {
+		filename += "-" + opts.Container
+	}
+	if opts.Previous {
+		filename += "-previous"
+	}
+	filename += ".log"
+
+	compress := r.URL.Query().Get("compress") == "1" || r.URL.Query().Get("compress") == "true"
+	var body io.Writer = w
+	if compress {
+		filename += ".gz"
+		w.Header().Set("Content-Type", "application/gzip")
+	} else {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	}
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	if compress {
+		gz := gzip.NewWriter(w)
+		defer func() { _ = gz.Close() }()
+		body = gz
+	}
+	// Headers are already sent; a mid-stream failure can only truncate the download.
+	_, _ = io.Copy(body, logs)
+}
+
+// filterPodsByImage keeps the pods with a container image containing image. An empty
+// image keeps every pod; the snapshot slice itself is never modified.
+func filterPodsByImage(items []dto.PodListItemDTO, image string) []dto.PodListItemDTO {
+	if image == "" {
+		return items
+	}
+	out := make([]dto.PodListItemDTO, 0, len(items))
+	for _, it := range items {
+		for _, img := range it.Images {
+			if strings.Contains(img, image) {
+				out = append(out, it)
+				break
+			}
+		}
+	}
+	return out
 }
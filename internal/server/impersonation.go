@@ -0,0 +1,115 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+)
+
+// impersonateHeader selects a "view as" identity for a single request, e.g.
+// "serviceaccount:team-a/deployer" or "user:alice,group:devs". It overrides the
+// identity set for the context through /api/impersonation.
+const impersonateHeader = "X-Kview-Impersonate"
+
+// impersonationMiddleware rejects malformed impersonation headers up front, so
+// readContextName can apply them without an error path.
+func (s *Server) impersonationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := strings.TrimSpace(r.Header.Get(impersonateHeader)); v != "" {
+			if _, err := cluster.ParseImpersonation(v); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": validationError(impersonateHeader + ": " + err.Error())})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// scopeContextName returns the context name the request is served as: the plain
+// context, or a scoped name carrying the impersonated identity from the request
+// header or the session. An already scoped name (a client echoing back "active") is
+// reduced to its context first, so identities never stack and only the header or
+// the session selects one.
+func (s *Server) scopeContextName(r *http.Request, contextName string) string {
+	contextName = cluster.BaseContextName(contextName)
+	if v := strings.TrimSpace(r.Header.Get(impersonateHeader)); v != "" {
+		if imp, err := cluster.ParseImpersonation(v); err == nil {
+			return cluster.ScopedContextName(contextName, imp)
+		}
+		return contextName
+	}
+	return s.sessionScopedContextName(contextName)
+}
+
+// sessionScopedContextName scopes contextName with the session identity set for it,
+// ignoring any request header. Views over every context (the fleet dashboard) use it:
+// applying one header identity to all contexts would open a plane per context.
+func (s *Server) sessionScopedContextName(contextName string) string {
+	contextName = cluster.BaseContextName(contextName)
+	s.impersonationMu.RLock()
+	imp := s.impersonation[contextName]
+	s.impersonationMu.RUnlock()
+	return cluster.ScopedContextName(contextName, imp)
+}
+
+// registerImpersonationRoutes manages the session-wide "view as" identity per context.
+// It lasts until cleared or the server restarts.
+func (s *Server) registerImpersonationRoutes(api chi.Router) {
+	api.Get("/impersonation", func(w http.ResponseWriter, r *http.Request) {
+		s.impersonationMu.RLock()
+		items := make(map[string]string, len(s.impersonation))
+		for ctxName, imp := range s.impersonation {
+			items[ctxName] = imp.String()
+		}
+		s.impersonationMu.RUnlock()
+		writeJSON(w, http.StatusOK, map[string]any{"active": s.mgr.ActiveContext(), "items": items})
+	})
+
+	api.Post("/impersonation", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Context string `json:"context"`
+			As      string `json:"as"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || strings.TrimSpace(body.As) == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": validationError("invalid body: as is required")})
+			return
+		}
+		ctxName := strings.TrimSpace(body.Context)
+		if ctxName == "" {
+			ctxName = s.mgr.ActiveContext()
+		}
+		if _, ok := s.mgr.ContextInfo(ctxName); !ok {
+			writeJSON(w, http.StatusNotFound, map[string]any{"error": &APIError{Code: ErrCodeNotFound, Message: "unknown context: " + ctxName}})
+			return
+		}
+		imp, err := cluster.ParseImpersonation(body.As)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": validationError(err.Error())})
+			return
+		}
+
+		s.impersonationMu.Lock()
+		s.impersonation[ctxName] = imp
+		s.impersonationMu.Unlock()
+		writeJSON(w, http.StatusOK, map[string]any{
+			"context": ctxName,
+			"as":      imp.String(),
+			"active":  cluster.ScopedContextName(ctxName, imp),
+		})
+	})
+
+	api.Delete("/impersonation", func(w http.ResponseWriter, r *http.Request) {
+		ctxName := strings.TrimSpace(r.URL.Query().Get("context"))
+		if ctxName == "" {
+			ctxName = s.mgr.ActiveContext()
+		}
+		s.impersonationMu.Lock()
+		delete(s.impersonation, ctxName)
+		s.impersonationMu.Unlock()
+		writeJSON(w, http.StatusOK, map[string]any{"context": ctxName, "active": ctxName})
+	})
+}
@@ -12,7 +12,7 @@ import (
 func (s *Server) authMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Prefer Authorization: Bearer. Query "token" is fallback only for WebSocket
-		// endpoints, where the browser WebSocket API cannot set custom headers.
+		// and EventSource (SSE) endpoints, where browser APIs cannot set custom headers.
 		token := r.Header.Get("Authorization")
 		if strings.HasPrefix(token, "Bearer ") {
 			token = strings.TrimPrefix(token, "Bearer ")
@@ -38,6 +38,11 @@ func (w *statusCapturingWriter) WriteHeader(statusCode int) {
 	w.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Unwrap lets http.ResponseController reach Flush on the underlying writer (SSE streams).
+func (w *statusCapturingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (s *Server) activityAccessDeniedLogMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// WebSocket upgrade requires optional interfaces (Hijacker, etc.).
@@ -97,9 +102,10 @@ func isBackgroundPollingPath(p string) bool {
 		"/api/dataplane/signals/catalog",
 		"/api/dataplane/metrics/status",
 		"/api/dashboard/cluster",
+		"/api/dashboard/fleet",
 		"/api/sessions":
 		return true
 	default:
-		return p == "/api/dataplane/revision"
+		return p == "/api/dataplane/revision" || p == "/api/dataplane/events"
 	}
 }
@@ -85,6 +85,15 @@ func dataplaneClusterListHandler[I any](
 	transform func([]I) any,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if contexts, ok, err := s.requestedContexts(r); ok {
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": err.Error()})
+				return
+			}
+			serveCrossContextList(s, w, r, contexts, fetch, crossContextTransform(transform))
+			return
+		}
+
 		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
 		defer cancel()
 
@@ -116,6 +125,16 @@ func dataplaneNamespacedListHandler[I any](
 			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "missing namespace"})
 			return
 		}
+		if contexts, ok, err := s.requestedContexts(r); ok {
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": err.Error()})
+				return
+			}
+			serveCrossContextList(s, w, r, contexts, func(ctx context.Context, contextName string) (dataplane.Snapshot[I], error) {
+				return fetch(ctx, contextName, ns)
+			}, crossContextTransform(transform))
+			return
+		}
 
 		ctx, cancel := context.WithTimeout(r.Context(), ctxTimeoutList)
 		defer cancel()
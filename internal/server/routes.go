@@ -13,7 +13,7 @@ func (s *Server) Router() http.Handler {
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:*", "http://127.0.0.1:*"},
 		AllowedMethods:   []string{"GET", "POST", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Kview-Context"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Kview-Context", "X-Kview-Impersonate"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
@@ -23,6 +23,7 @@ func (s *Server) Router() http.Handler {
 		api.Use(s.authMiddleware)
 		api.Use(s.activityAccessDeniedLogMiddleware)
 		api.Use(s.dataplaneUserActivityMiddleware)
+		api.Use(s.impersonationMiddleware)
 
 		// Read-path ownership (dataplane snapshot vs projection vs direct kube in handler):
 		// Keep docs/API_READ_OWNERSHIP.md in sync when adding GET routes.
@@ -35,7 +36,9 @@ func (s *Server) Router() http.Handler {
 		s.registerWorkloadRoutes(api)
 		s.registerNamespacedResourceRoutes(api)
 		s.registerHelmRoutes(api)
+		s.registerAPIResourceRoutes(api)
 		s.registerCapabilitiesAndActionsRoutes(api)
+		s.registerImpersonationRoutes(api)
 	})
 
 	// Public UI (SPA)
@@ -13,6 +13,7 @@ import (
 	"github.com/korex-labs/kview/v5/internal/kube/jobdebug"
 	"github.com/korex-labs/kview/v5/internal/runtime"
 	"github.com/korex-labs/kview/v5/internal/session"
+	"github.com/korex-labs/kview/v5/internal/stream"
 )
 
 const (
@@ -37,10 +38,15 @@ type Server struct {
 	dp             dataplane.DataPlaneManager
 	sessions       session.Manager
 	jobRuns        *jobdebug.Manager
+	recordingsDir  string
 	deniedLogMu    sync.Mutex
 	deniedLogUntil map[string]time.Time
 	statusLogMu    sync.Mutex
 	clusterOnline  map[string]bool
+
+	// impersonation holds the session "view as" identity per kubeconfig context.
+	impersonationMu sync.RWMutex
+	impersonation   map[string]cluster.Impersonation
 }
 
 func New(mgr *cluster.Manager, rt runtime.RuntimeManager, token string) *Server {
@@ -57,8 +63,10 @@ func New(mgr *cluster.Manager, rt runtime.RuntimeManager, token string) *Server
 		dp:             dpMgr,
 		sessions:       session.NewInMemoryManager(rt.Registry()),
 		jobRuns:        jobdebug.NewManager(),
+		recordingsDir:  stream.DefaultTerminalRecordingsDir(),
 		deniedLogUntil: map[string]time.Time{},
 		clusterOnline:  map[string]bool{},
+		impersonation:  map[string]cluster.Impersonation{},
 	}
 	// Best-effort runtime manager startup; failures are logged via regular logs.
 	_ = s.rt.Start(context.Background())
@@ -80,11 +88,14 @@ func (s *Server) Sessions() session.Manager {
 	return s.sessions
 }
 
+// readContextName returns the context the request targets. While a "view as" identity
+// applies, it is a scoped name (see cluster.ScopedContextName), so clients and
+// dataplane snapshots are those of the impersonated identity.
 func (s *Server) readContextName(r *http.Request) string {
 	if ctxName := strings.TrimSpace(r.Header.Get("X-Kview-Context")); ctxName != "" {
-		return ctxName
+		return s.scopeContextName(r, ctxName)
 	}
-	return s.mgr.ActiveContext()
+	return s.scopeContextName(r, s.mgr.ActiveContext())
 }
 
 func (s *Server) clientsForRequest(ctx context.Context, r *http.Request) (*cluster.Clients, string, error) {
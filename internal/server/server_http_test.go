@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -12,6 +13,8 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/korex-labs/kview/v5/internal/cluster"
 	"github.com/korex-labs/kview/v5/internal/dataplane"
 	"github.com/korex-labs/kview/v5/internal/kube"
@@ -80,8 +83,10 @@ func newTestServer(t *testing.T) (*Server, http.Handler) {
 		dp:             dp,
 		sessions:       sess,
 		jobRuns:        jobdebug.NewManager(),
+		recordingsDir:  filepath.Join(dir, "recordings"),
 		deniedLogUntil: map[string]time.Time{},
 		clusterOnline:  map[string]bool{},
+		impersonation:  map[string]cluster.Impersonation{},
 	}
 	return s, s.Router()
 }
@@ -145,6 +150,9 @@ type stubDataplane struct {
 	policy    dataplane.DataplanePolicy
 	bundle    dataplane.DataplanePolicyBundle
 	effective map[string]dataplane.DataplanePolicy
+	events    chan dataplane.DataplaneEvent
+	// pods are returned by PodsSnapshot per context; nil keeps it unimplemented.
+	pods map[string]dataplane.PodsSnapshot
 }
 
 func newStubDataplane() *stubDataplane {
@@ -241,8 +249,20 @@ func (s *stubDataplane) ClusterRoleBindingsSnapshot(_ context.Context, _ string)
 func (s *stubDataplane) CRDsSnapshot(_ context.Context, _ string) (dataplane.CRDsSnapshot, error) {
 	panic("stubDataplane: CRDsSnapshot")
 }
-func (s *stubDataplane) PodsSnapshot(_ context.Context, _, _ string) (dataplane.PodsSnapshot, error) {
-	panic("stubDataplane: PodsSnapshot")
+func (s *stubDataplane) StorageClassesSnapshot(_ context.Context, _ string) (dataplane.StorageClassesSnapshot, error) {
+	panic("stubDataplane: StorageClassesSnapshot")
+}
+func (s *stubDataplane) CSIDriversSnapshot(_ context.Context, _ string) (dataplane.CSIDriversSnapshot, error) {
+	panic("stubDataplane: CSIDriversSnapshot")
+}
+func (s *stubDataplane) VolumeAttachmentsSnapshot(_ context.Context, _ string) (dataplane.VolumeAttachmentsSnapshot, error) {
+	panic("stubDataplane: VolumeAttachmentsSnapshot")
+}
+func (s *stubDataplane) PodsSnapshot(_ context.Context, contextName, _ string) (dataplane.PodsSnapshot, error) {
+	if s.pods == nil {
+		panic("stubDataplane: PodsSnapshot")
+	}
+	return s.pods[contextName], nil
 }
 func (s *stubDataplane) DeploymentsSnapshot(_ context.Context, _, _ string) (dataplane.DeploymentsSnapshot, error) {
 	panic("stubDataplane: DeploymentsSnapshot")
@@ -301,6 +321,39 @@ func (s *stubDataplane) ResourceQuotasSnapshot(_ context.Context, _, _ string) (
 func (s *stubDataplane) LimitRangesSnapshot(_ context.Context, _, _ string) (dataplane.LimitRangesSnapshot, error) {
 	panic("stubDataplane: LimitRangesSnapshot")
 }
+func (s *stubDataplane) NetworkPoliciesSnapshot(_ context.Context, _, _ string) (dataplane.NetworkPoliciesSnapshot, error) {
+	panic("stubDataplane: NetworkPoliciesSnapshot")
+}
+func (s *stubDataplane) PodDisruptionBudgetsSnapshot(_ context.Context, _, _ string) (dataplane.PodDisruptionBudgetsSnapshot, error) {
+	panic("stubDataplane: PodDisruptionBudgetsSnapshot")
+}
+
+func (s *stubDataplane) GenericResourcesSnapshot(_ context.Context, _ string, _ schema.GroupVersionResource, _ string) (dataplane.GenericResourcesSnapshot, error) {
+	panic("stubDataplane: GenericResourcesSnapshot")
+}
+func (s *stubDataplane) NetworkReachability(_ context.Context, _ string, _ dataplane.NetworkReachabilityRequest) (dto.NetworkReachabilityDTO, error) {
+	panic("stubDataplane: NetworkReachability")
+}
+
+func (s *stubDataplane) ResourceReferrers(_ context.Context, _, _, _, _ string) (dto.ResourceReferrersDTO, error) {
+	panic("stubDataplane: ResourceReferrers")
+}
+
+func (s *stubDataplane) NamespaceGraph(_ context.Context, _, _ string) (dto.NamespaceGraphDTO, error) {
+	panic("stubDataplane: NamespaceGraph")
+}
+
+func (s *stubDataplane) PodScheduling(_ context.Context, _ string, _ dto.PodSchedulingSpecDTO) (dto.PodSchedulingDTO, error) {
+	panic("stubDataplane: PodScheduling")
+}
+
+func (s *stubDataplane) WhoCan(_ context.Context, _ string, _ dataplane.WhoCanRequest) (dto.WhoCanDTO, error) {
+	panic("stubDataplane: WhoCan")
+}
+
+func (s *stubDataplane) ServiceAccountPermissions(_ context.Context, _, _, _ string) (dto.ServiceAccountPermissionsDTO, error) {
+	panic("stubDataplane: ServiceAccountPermissions")
+}
 func (s *stubDataplane) NodeMetricsSnapshot(_ context.Context, _ string) (dataplane.NodeMetricsSnapshot, error) {
 	panic("stubDataplane: NodeMetricsSnapshot")
 }
@@ -326,11 +379,22 @@ func (s *stubDataplane) InvalidateStatefulSetsSnapshot(_ context.Context, _, _ s
 func (s *stubDataplane) InvalidateDaemonSetsSnapshot(_ context.Context, _, _ string) error {
 	return nil
 }
-func (s *stubDataplane) InvalidateJobsSnapshot(_ context.Context, _, _ string) error { return nil }
+func (s *stubDataplane) InvalidateJobsSnapshot(_ context.Context, _, _ string) error     { return nil }
+func (s *stubDataplane) InvalidateCronJobsSnapshot(_ context.Context, _, _ string) error { return nil }
 
 func (s *stubDataplane) DashboardSummary(_ context.Context, _ string, _ dataplane.ClusterDashboardListOptions) dataplane.ClusterDashboardSummary {
 	panic("stubDataplane: DashboardSummary")
 }
+
+func (s *stubDataplane) FleetContextSummary(_ context.Context, clusterName string) dataplane.FleetContextSummary {
+	return dataplane.FleetContextSummary{Context: clusterName}
+}
+func (s *stubDataplane) SubscribeEvents(_ int) (<-chan dataplane.DataplaneEvent, func()) {
+	if s.events == nil {
+		return make(chan dataplane.DataplaneEvent), func() {}
+	}
+	return s.events, func() {}
+}
 func (s *stubDataplane) ListSnapshotRevision(_ context.Context, _ string, _ dataplane.ResourceKind, _ string) (dataplane.ListSnapshotRevisionEnvelope, error) {
 	panic("stubDataplane: ListSnapshotRevision")
 }
@@ -416,6 +480,57 @@ func TestHealthz(t *testing.T) {
 	}
 }
 
+// ── GET /api/dataplane/events ─────────────────────────────────────────────────
+
+func TestDataplaneEvents_StreamsFilteredEventsThenResync(t *testing.T) {
+	s, h := newTestServer(t)
+	dp := s.dp.(*stubDataplane)
+	dp.events = make(chan dataplane.DataplaneEvent, 4)
+	dp.events <- dataplane.DataplaneEvent{ID: 1, Type: dataplane.DataplaneEventRevision, Context: "test-context", Kind: dataplane.ResourceKindPods, Namespace: "app", Revision: 3}
+	dp.events <- dataplane.DataplaneEvent{ID: 2, Type: dataplane.DataplaneEventRevision, Context: "other", Kind: dataplane.ResourceKindPods, Namespace: "app", Revision: 4}
+	dp.events <- dataplane.DataplaneEvent{ID: 3, Type: dataplane.DataplaneEventRevision, Context: "test-context", Kind: dataplane.ResourceKindServices, Namespace: "app", Revision: 5}
+	dp.events <- dataplane.DataplaneEvent{ID: 4, Type: dataplane.DataplaneEventSignal, Context: "test-context", Namespace: "app", Signal: &dataplane.DataplaneSignalEvent{Change: dataplane.DataplaneSignalAppeared, Kind: "Pod", Name: "web"}}
+	close(dp.events)
+
+	rec := doReq(t, h, http.MethodGet, "/api/dataplane/events?kind=pods&namespace=app", testToken, nil)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("content type: got %q", ct)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{"event: ready\n", "event: revision\nid: 1\n", "event: signal\nid: 4\n", "event: resync\n"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %q in stream:\n%s", want, body)
+		}
+	}
+	for _, unwanted := range []string{"id: 2\n", "id: 3\n"} {
+		if strings.Contains(body, unwanted) {
+			t.Errorf("filtered event %q leaked into stream:\n%s", unwanted, body)
+		}
+	}
+}
+
+func TestDataplaneEvents_UnknownKind(t *testing.T) {
+	_, h := newTestServer(t)
+	rec := doReq(t, h, http.MethodGet, "/api/dataplane/events?kind=pods,bogus", testToken, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d, want 400", rec.Code)
+	}
+}
+
+// ── GET /api/namespaces/{ns}/pods/{name}/logs/download ───────────────────────
+
+func TestPodLogsDownload_InvalidOptions(t *testing.T) {
+	_, h := newTestServer(t)
+	rec := doReq(t, h, http.MethodGet, "/api/namespaces/app/pods/web/logs/download?sinceSeconds=10&sinceTime=2026-01-02T03:04:05Z", testToken, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d, want 400", rec.Code)
+	}
+}
+
 // ── GET /api/contexts ─────────────────────────────────────────────────────────
 
 func TestContexts(t *testing.T) {
@@ -692,6 +807,28 @@ func TestPostSessionsTerminal_Validation(t *testing.T) {
 	}
 }
 
+// ── GET /api/sessions/{id}/recordings ───────────────────────────────────────
+
+func TestGetSessionRecordings_UsesServerDir(t *testing.T) {
+	s, h := newTestServer(t)
+	if err := os.MkdirAll(s.recordingsDir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	name := "sess-1-20260301T120000Z.cast"
+	if err := os.WriteFile(filepath.Join(s.recordingsDir, name), []byte("{}\n"), 0o600); err != nil {
+		t.Fatalf("write recording: %v", err)
+	}
+
+	rec := doReq(t, h, http.MethodGet, "/api/sessions/sess-1/recordings", testToken, nil)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), name) {
+		t.Fatalf("list: got %d %s", rec.Code, rec.Body.String())
+	}
+	rec = doReq(t, h, http.MethodGet, "/api/sessions/sess-1/recordings/"+name, testToken, nil)
+	if rec.Code != http.StatusOK || rec.Body.String() != "{}\n" {
+		t.Fatalf("download: got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
 // ── POST /api/sessions/portforward ──────────────────────────────────────────
 
 func TestPostSessionsPortforward_Validation(t *testing.T) {
@@ -1227,6 +1364,140 @@ func TestGetClusterResourceSignals_UnknownKind(t *testing.T) {
 	}
 }
 
+func TestGetWhoCan_MissingParams(t *testing.T) {
+	_, h := newTestServer(t)
+	for _, path := range []string{"/api/auth/who-can?resource=pods", "/api/auth/who-can?verb=get", "/api/auth/who-can?verb=get&resource=/log"} {
+		rec := doReq(t, h, http.MethodGet, path, testToken, nil)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: got %d, want 400 (body=%s)", path, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestGetFleetDashboard(t *testing.T) {
+	s, h := newTestServer(t)
+	s.updateConnectivityActivity(statusClusterDTO{OK: true, Context: "test-context"})
+
+	rec := doReq(t, h, http.MethodGet, "/api/dashboard/fleet", testToken, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200 (body=%s)", rec.Code, rec.Body.String())
+	}
+	body := mustDecodeJSON(t, rec.Body.Bytes())
+	item, _ := body["item"].(map[string]any)
+	contexts, _ := item["contexts"].([]any)
+	if len(contexts) != 1 {
+		t.Fatalf("contexts: got %v", item["contexts"])
+	}
+	row := contexts[0].(map[string]any)
+	if row["context"] != "test-context" || row["reachability"].(map[string]any)["state"] != "connected" {
+		t.Fatalf("unexpected row: %v", row)
+	}
+}
+
+func TestGetFleetDashboard_ImpersonateHeaderDoesNotScopeEveryContext(t *testing.T) {
+	s, h := newTestServer(t)
+
+	var kc strings.Builder
+	kc.WriteString("apiVersion: v1\nkind: Config\nclusters:\n- cluster:\n    server: https://127.0.0.1:6443\n  name: test-cluster\ncontexts:\n")
+	for i := range 10 {
+		fmt.Fprintf(&kc, "- context:\n    cluster: test-cluster\n    user: test-user\n  name: ctx-%02d\n", i)
+	}
+	kc.WriteString("current-context: ctx-00\nusers:\n- name: test-user\n  user:\n    token: fake-token\n")
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(path, []byte(kc.String()), 0o600); err != nil {
+		t.Fatalf("write kubeconfig: %v", err)
+	}
+	mgr, err := cluster.NewManagerWithLoggerAndConfig(discardLogger{}, path)
+	if err != nil {
+		t.Fatalf("new cluster manager: %v", err)
+	}
+	s.mgr = mgr
+	s.impersonation["ctx-03"] = cluster.Impersonation{UserName: "alice"}
+
+	rec := doReqWithHeader(t, h, http.MethodGet, "/api/dashboard/fleet", map[string]string{
+		"Authorization":       "Bearer " + testToken,
+		"X-Kview-Impersonate": "serviceaccount:team-a/deployer",
+	}, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200 (body=%s)", rec.Code, rec.Body.String())
+	}
+	body := mustDecodeJSON(t, rec.Body.Bytes())
+	contexts, _ := body["item"].(map[string]any)["contexts"].([]any)
+	if len(contexts) != 10 {
+		t.Fatalf("contexts: got %d, want 10", len(contexts))
+	}
+	scoped := 0
+	for _, c := range contexts {
+		name, _ := c.(map[string]any)["context"].(string)
+		if name != cluster.BaseContextName(name) {
+			scoped++
+			if name != cluster.ScopedContextName("ctx-03", cluster.Impersonation{UserName: "alice"}) {
+				t.Fatalf("unexpected scoped context %q", name)
+			}
+		}
+	}
+	if scoped != 1 {
+		t.Fatalf("scoped contexts: got %d, want only the session-impersonated one", scoped)
+	}
+}
+
+// ── impersonation ────────────────────────────────────────────────────────────
+
+func TestImpersonateHeader_Invalid(t *testing.T) {
+	_, h := newTestServer(t)
+	rec := doReqWithHeader(t, h, http.MethodGet, "/api/impersonation", map[string]string{
+		"Authorization":       "Bearer " + testToken,
+		"X-Kview-Impersonate": "serviceaccount:missing-namespace",
+	}, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d, want 400 (body=%s)", rec.Code, rec.Body.String())
+	}
+}
+
+func TestImpersonationSessionLifecycle(t *testing.T) {
+	s, h := newTestServer(t)
+
+	rec := doReq(t, h, http.MethodPost, "/api/impersonation", testToken, toJSON(t, map[string]any{"as": "serviceaccount:team-a/deployer"}))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("set: got %d, want 200 (body=%s)", rec.Code, rec.Body.String())
+	}
+	body := mustDecodeJSON(t, rec.Body.Bytes())
+	if body["active"] != "test-context|as=serviceaccount:team-a/deployer" {
+		t.Fatalf("active: got %v", body["active"])
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/namespaces", nil)
+	if got := s.readContextName(req); got != "test-context|as=serviceaccount:team-a/deployer" {
+		t.Fatalf("session identity not applied: %q", got)
+	}
+	req.Header.Set("X-Kview-Impersonate", "group:auditors")
+	if got := s.readContextName(req); got != "test-context|as=group:auditors" {
+		t.Fatalf("header should override the session identity: %q", got)
+	}
+	req.Header.Set("X-Kview-Context", "test-context|as=user:alice")
+	if got := s.readContextName(req); got != "test-context|as=group:auditors" {
+		t.Fatalf("scoped context header should not stack identities: %q", got)
+	}
+
+	rec = doReq(t, h, http.MethodPost, "/api/impersonation", testToken, toJSON(t, map[string]any{"context": "nope", "as": "user:alice"}))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("unknown context: got %d, want 404", rec.Code)
+	}
+
+	rec = doReq(t, h, http.MethodDelete, "/api/impersonation", testToken, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("clear: got %d, want 200", rec.Code)
+	}
+	if got := s.readContextName(httptest.NewRequest(http.MethodGet, "/api/namespaces", nil)); got != "test-context" {
+		t.Fatalf("identity not cleared: %q", got)
+	}
+	req = httptest.NewRequest(http.MethodGet, "/api/namespaces", nil)
+	req.Header.Set("X-Kview-Context", "test-context|as=user:alice")
+	if got := s.readContextName(req); got != "test-context" {
+		t.Fatalf("scoped context header should not select an identity: %q", got)
+	}
+}
+
 // ── JSON Content-Type ─────────────────────────────────────────────────────────
 
 func TestResponseContentType(t *testing.T) {
@@ -186,13 +186,29 @@ func TestStopInactiveConnectivityActivitiesExceptKeepsContextWithOpenSession(t *
 	}
 }
 
+func TestConnectivityReachability(t *testing.T) {
+	rt := runtime.NewManager()
+	s := &Server{rt: rt}
+
+	if got := s.connectivityReachability("dev"); got.State != "unknown" {
+		t.Fatalf("never probed: got %+v", got)
+	}
+	s.updateConnectivityActivity(statusClusterDTO{OK: false, Context: "dev", Message: "dial tcp: connection refused"})
+	got := s.connectivityReachability("dev")
+	if got.State != "disconnected" || got.Message != "dial tcp: connection refused" || got.CheckedAt == "" {
+		t.Fatalf("failed probe: got %+v", got)
+	}
+}
+
 func TestIsBackgroundPollingPath(t *testing.T) {
 	polling := []string{
 		"/api/status",
 		"/api/activity",
 		"/api/dashboard/cluster",
+		"/api/dashboard/fleet",
 		"/api/dataplane/work/live",
 		"/api/dataplane/revision",
+		"/api/dataplane/events",
 		"/api/namespaces/enrichment",
 		"/api/sessions",
 	}
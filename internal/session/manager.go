@@ -24,6 +24,7 @@ type InMemoryManager struct {
 	sessions     map[string]Session
 	reg          runtime.ActivityRegistry
 	portForwards map[string]func()
+	terminals    map[string]func()
 }
 
 const stoppedActivityTTL = 3 * time.Minute
@@ -33,6 +34,7 @@ func NewInMemoryManager(reg runtime.ActivityRegistry) *InMemoryManager {
 		sessions:     make(map[string]Session),
 		reg:          reg,
 		portForwards: make(map[string]func()),
+		terminals:    make(map[string]func()),
 	}
 }
 
@@ -77,8 +79,11 @@ func (m *InMemoryManager) Create(_ context.Context, s Session) (Session, error)
 		actType = runtime.ActivityTypePortForward
 	}
 	resType := "session:terminal"
-	if actType == runtime.ActivityTypePortForward {
+	switch s.Type {
+	case TypePortForward:
 		resType = "session:portforward"
+	case TypeDebug:
+		resType = "session:debug"
 	}
 	activity := runtime.Activity{
 		ID:           s.ID,
@@ -124,6 +129,11 @@ func (m *InMemoryManager) Stop(_ context.Context, id string) error {
 		go cancel()
 		delete(m.portForwards, id)
 	}
+	if stop, ok := m.terminals[id]; ok && stop != nil {
+		// Kill a live (possibly detached) terminal process.
+		go stop()
+		delete(m.terminals, id)
+	}
 	delete(m.sessions, id)
 	m.mu.Unlock()
 
@@ -153,6 +163,24 @@ func (m *InMemoryManager) RegisterPortForward(id string, stop func()) {
 	m.portForwards[id] = stop
 }
 
+// RegisterTerminal associates a stop function with a terminal session whose exec stream
+// outlives individual websocket connections, so Stop() ends the remote shell too.
+func (m *InMemoryManager) RegisterTerminal(id string, stop func()) {
+	if id == "" || stop == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.terminals[id] = stop
+}
+
+// UnregisterTerminal drops the stop function once the terminal process has exited.
+func (m *InMemoryManager) UnregisterTerminal(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.terminals, id)
+}
+
 func (m *InMemoryManager) Update(_ context.Context, s Session) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
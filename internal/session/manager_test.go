@@ -96,6 +96,21 @@ func TestInMemoryManager_CreateRegistersPortForwardActivity(t *testing.T) {
 	}
 }
 
+func TestInMemoryManager_CreateRegistersDebugAsTerminalActivity(t *testing.T) {
+	m, reg := newTestManager()
+	s, _ := m.Create(context.Background(), Session{Type: TypeDebug, Title: "dbg"})
+	act, found, _ := reg.Get(context.Background(), s.ID)
+	if !found {
+		t.Fatal("activity not registered")
+	}
+	if act.Type != runtime.ActivityTypeTerminal {
+		t.Errorf("ActivityType: got %q, want %q", act.Type, runtime.ActivityTypeTerminal)
+	}
+	if act.ResourceType != "session:debug" {
+		t.Errorf("ResourceType: got %q, want session:debug", act.ResourceType)
+	}
+}
+
 func TestInMemoryManager_CreateMergesCustomMetadata(t *testing.T) {
 	m, reg := newTestManager()
 	s, _ := m.Create(context.Background(), Session{
@@ -379,3 +394,25 @@ func TestInMemoryManager_ConcurrentCreateListStop(t *testing.T) {
 		t.Errorf("expected all sessions stopped, %d remain", len(list))
 	}
 }
+
+func TestInMemoryManager_StopKillsRegisteredTerminal(t *testing.T) {
+	m, _ := newTestManager()
+	created, _ := m.Create(context.Background(), Session{Type: TypeTerminal, Title: "t"})
+	stopped := make(chan struct{}, 1)
+	m.RegisterTerminal(created.ID, func() { stopped <- struct{}{} })
+
+	if err := m.Stop(context.Background(), created.ID); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("terminal stop func was not called")
+	}
+	m.mu.RLock()
+	n := len(m.terminals)
+	m.mu.RUnlock()
+	if n != 0 {
+		t.Errorf("expected terminal registration to be dropped, got %d", n)
+	}
+}
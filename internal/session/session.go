@@ -7,6 +7,8 @@ type Type string
 const (
 	TypeTerminal    Type = "terminal"
 	TypePortForward Type = "portforward"
+	// TypeDebug is a terminal attached to an ephemeral debug container added to the pod.
+	TypeDebug Type = "debug"
 )
 
 type Status string
@@ -0,0 +1,343 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+const (
+	aggregateLogsRescanInterval = 5 * time.Second
+	aggregateLogsMaxStreams     = 64
+	aggregateLogsDefaultTail    = 100
+)
+
+// PodTargetsFunc returns the current pod rows for a namespace. The server wires it to the
+// dataplane pods snapshot so fan-out follows the same cache as the pods list.
+type PodTargetsFunc func(ctx context.Context, contextName, namespace string) ([]dto.PodListItemDTO, error)
+
+// AggregatedLogsWS tails every container of every pod matched by a label selector or a
+// workload (Deployment, StatefulSet, DaemonSet, Job) and interleaves the lines, each
+// prefixed with "[pod/container] ". With follow enabled it rescans targets periodically
+// and attaches to pods that appear later.
+type AggregatedLogsWS struct {
+	Mgr  *cluster.Manager
+	Pods PodTargetsFunc
+}
+
+type logTarget struct {
+	Pod       string
+	Container string
+}
+
+func (t logTarget) key() string { return t.Pod + "/" + t.Container }
+
+type logTargetState struct {
+	running bool
+	endedAt time.Time
+}
+
+func (h *AggregatedLogsWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ns := chi.URLParam(r, "ns")
+	q := r.URL.Query()
+	base, optsErr := PodLogOptionsFromQuery(q, aggregateLogsDefaultTail)
+	var format *logStreamFormat
+	if optsErr == nil {
+		format, optsErr = logStreamFormatFromQuery(q, base.Timestamps)
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+	if optsErr != nil {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("ERROR: "+optsErr.Error()))
+		return
+	}
+	follow := base.Follow
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var clients *cluster.Clients
+	var contextName string
+	if name := q.Get("context"); name != "" {
+		clients, contextName, err = h.Mgr.GetClientsForContext(ctx, name)
+	} else {
+		clients, contextName, err = h.Mgr.GetClients(ctx)
+	}
+	if err != nil {
+		_ = conn.WriteMessage(websocket.TextMessage, format.encodeNotice("error", err.Error()))
+		return
+	}
+
+	selector, err := resolveLogSelector(ctx, clients, ns, q.Get("selector"), q.Get("kind"), q.Get("name"))
+	if err != nil {
+		_ = conn.WriteMessage(websocket.TextMessage, format.encodeNotice("error", err.Error()))
+		return
+	}
+
+	// Detect client close so follow streams do not outlive the socket.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	lines := make(chan []byte, 1024)
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		for line := range lines {
+			if err := conn.WriteMessage(websocket.TextMessage, line); err != nil {
+				cancel()
+				// Keep draining so producers never block on a dead socket.
+				for range lines {
+				}
+				return
+			}
+		}
+	}()
+
+	go func() {
+		t := time.NewTicker(20 * time.Second)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				_ = conn.WriteControl(websocket.PingMessage, []byte("ping"), time.Now().Add(2*time.Second))
+			}
+		}
+	}()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		states  = map[string]*logTargetState{}
+		started = time.Now()
+		capped  bool
+	)
+	emit := func(line []byte) {
+		select {
+		case lines <- line:
+		case <-ctx.Done():
+		}
+	}
+
+	scan := func(initial bool) error {
+		pods, err := h.Pods(ctx, contextName, ns)
+		if err != nil {
+			return err
+		}
+		targets, running := matchLogTargets(pods, selector, base.Container)
+		if initial && len(targets) == 0 {
+			emit(format.encodeNotice("info", "no pods match the requested selector"))
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		active := 0
+		for _, st := range states {
+			if st.running {
+				active++
+			}
+		}
+		for _, t := range targets {
+			st, seen := states[t.key()]
+			opts := *base
+			opts.Container = t.Container
+			switch {
+			case !seen && initial:
+				// Initial targets honor tail / since / previous as requested.
+			case !seen:
+				// Pods discovered after the stream opened: everything they logged is new,
+				// but never reach back before the stream started.
+				since := metav1.NewTime(started)
+				opts.TailLines, opts.SinceSeconds, opts.SinceTime = nil, nil, &since
+			case st.running || !follow || !running[t.Pod]:
+				continue
+			default:
+				// Container restarted or the stream dropped; resume where it ended.
+				since := metav1.NewTime(st.endedAt)
+				opts.TailLines, opts.SinceSeconds, opts.SinceTime = nil, nil, &since
+			}
+			if active >= aggregateLogsMaxStreams {
+				if !capped {
+					capped = true
+					emit(format.encodeNotice("info", fmt.Sprintf("stream limit of %d containers reached; narrow the selector to see the rest", aggregateLogsMaxStreams)))
+				}
+				continue
+			}
+			if st == nil {
+				st = &logTargetState{}
+				states[t.key()] = st
+			}
+			st.running = true
+			active++
+			wg.Add(1)
+			go func(t logTarget, st *logTargetState, opts *v1.PodLogOptions) {
+				defer wg.Done()
+				err := streamLogTarget(ctx, clients, ns, t, opts, format, emit)
+				if err != nil && ctx.Err() == nil {
+					emit(format.encodeNotice("error", t.key()+": "+err.Error()))
+				}
+				mu.Lock()
+				st.running = false
+				st.endedAt = time.Now()
+				mu.Unlock()
+			}(t, st, &opts)
+		}
+		return nil
+	}
+
+	if err := scan(true); err != nil {
+		emit(format.encodeNotice("error", err.Error()))
+		close(lines)
+		<-writerDone
+		return
+	}
+	if follow {
+		ticker := time.NewTicker(aggregateLogsRescanInterval)
+	loop:
+		for {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-ticker.C:
+				if err := scan(false); err != nil && ctx.Err() == nil {
+					emit(format.encodeNotice("error", err.Error()))
+				}
+			}
+		}
+		ticker.Stop()
+	}
+	wg.Wait()
+	close(lines)
+	<-writerDone
+}
+
+// resolveLogSelector turns either an explicit label selector or a workload reference into
+// a label selector. Workload selectors are read live because they are not part of the
+// workload list snapshots.
+func resolveLogSelector(ctx context.Context, clients *cluster.Clients, ns, selector, kind, name string) (labels.Selector, error) {
+	if strings.TrimSpace(selector) != "" {
+		sel, err := labels.Parse(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+		return sel, nil
+	}
+	if kind == "" || name == "" {
+		return nil, fmt.Errorf("selector or kind and name are required")
+	}
+
+	var ls *metav1.LabelSelector
+	switch strings.ToLower(kind) {
+	case "deployment", "deployments":
+		obj, err := clients.Clientset.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ls = obj.Spec.Selector
+	case "statefulset", "statefulsets":
+		obj, err := clients.Clientset.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ls = obj.Spec.Selector
+	case "daemonset", "daemonsets":
+		obj, err := clients.Clientset.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ls = obj.Spec.Selector
+	case "job", "jobs":
+		obj, err := clients.Clientset.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ls = obj.Spec.Selector
+	default:
+		return nil, fmt.Errorf("unsupported workload kind %q", kind)
+	}
+	if ls == nil {
+		return nil, fmt.Errorf("%s %s has no selector", kind, name)
+	}
+	sel, err := metav1.LabelSelectorAsSelector(ls)
+	if err != nil {
+		return nil, err
+	}
+	if sel.Empty() {
+		// An empty selector would match every pod in the namespace.
+		return nil, fmt.Errorf("%s %s has an empty selector", kind, name)
+	}
+	return sel, nil
+}
+
+// matchLogTargets expands pod rows matching the selector into (pod, container) targets in
+// stable order and reports which matched pods are still running.
+func matchLogTargets(pods []dto.PodListItemDTO, selector labels.Selector, container string) ([]logTarget, map[string]bool) {
+	var out []logTarget
+	running := map[string]bool{}
+	for _, p := range pods {
+		if !selector.Matches(labels.Set(p.Labels)) {
+			continue
+		}
+		if p.Phase == string(v1.PodPending) || p.Phase == string(v1.PodRunning) {
+			running[p.Name] = true
+		}
+		for _, c := range p.Containers {
+			if container != "" && c != container {
+				continue
+			}
+			out = append(out, logTarget{Pod: p.Name, Container: c})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].key() < out[j].key() })
+	return out, running
+}
+
+func streamLogTarget(ctx context.Context, clients *cluster.Clients, ns string, t logTarget, opts *v1.PodLogOptions, format *logStreamFormat, emit func([]byte)) error {
+	stream, err := clients.Clientset.CoreV1().Pods(ns).GetLogs(t.Pod, opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+
+	reader := bufio.NewReader(stream)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			if out, ok := format.encodeLine(t, true, line); ok {
+				emit(out)
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package stream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/korex-labs/kview/v5/internal/kube/dto"
+)
+
+func TestMatchLogTargets_SelectorAndContainerFilter(t *testing.T) {
+	sel, err := resolveLogSelector(context.Background(), nil, "app", "app=web,tier!=db", "", "")
+	if err != nil {
+		t.Fatalf("resolve selector: %v", err)
+	}
+	pods := []dto.PodListItemDTO{
+		{Name: "web-b", Phase: "Running", Labels: map[string]string{"app": "web"}, Containers: []string{"app", "sidecar"}},
+		{Name: "web-a", Phase: "Succeeded", Labels: map[string]string{"app": "web"}, Containers: []string{"app"}},
+		{Name: "db-0", Phase: "Running", Labels: map[string]string{"app": "web", "tier": "db"}, Containers: []string{"app"}},
+		{Name: "other", Phase: "Running", Labels: map[string]string{"app": "api"}, Containers: []string{"app"}},
+	}
+
+	targets, running := matchLogTargets(pods, sel, "")
+	want := []string{"web-a/app", "web-b/app", "web-b/sidecar"}
+	if len(targets) != len(want) {
+		t.Fatalf("targets: got %v want %v", targets, want)
+	}
+	for i, k := range want {
+		if targets[i].key() != k {
+			t.Fatalf("targets: got %v want %v", targets, want)
+		}
+	}
+	if !running["web-b"] || running["web-a"] {
+		t.Fatalf("running: got %v", running)
+	}
+
+	targets, _ = matchLogTargets(pods, sel, "sidecar")
+	if len(targets) != 1 || targets[0].key() != "web-b/sidecar" {
+		t.Fatalf("container filter: got %v", targets)
+	}
+}
+
+func TestResolveLogSelector_Validation(t *testing.T) {
+	if _, err := resolveLogSelector(context.Background(), nil, "app", "", "", ""); err == nil {
+		t.Fatal("expected error without selector or workload")
+	}
+	if _, err := resolveLogSelector(context.Background(), nil, "app", "app in (", "", ""); err == nil {
+		t.Fatal("expected error for malformed selector")
+	}
+	if _, err := resolveLogSelector(context.Background(), nil, "app", "", "cronjob", "nightly"); err == nil {
+		t.Fatal("expected error for unsupported workload kind")
+	}
+}
@@ -0,0 +1,322 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Log stream parse modes. "raw" keeps the historical plain-text messages; the other modes
+// send one JSON frame per line.
+const (
+	logParseRaw    = "raw"
+	logParseJSON   = "json"
+	logParseLogfmt = "logfmt"
+	logParseAuto   = "auto"
+)
+
+// Normalized log level ranks; unknown is below every real level.
+const (
+	logLevelUnknown = iota
+	logLevelTrace
+	logLevelDebug
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+	logLevelFatal
+)
+
+var logLevelNames = map[int]string{
+	logLevelTrace: "trace",
+	logLevelDebug: "debug",
+	logLevelInfo:  "info",
+	logLevelWarn:  "warn",
+	logLevelError: "error",
+	logLevelFatal: "fatal",
+}
+
+// Fallback for unstructured lines: a bare level word near the start of the line.
+var plainLevelPattern = regexp.MustCompile(`(?i)\b(trace|debug|info|warn|warning|error|err|fatal|panic|critical)\b`)
+
+// LogFrame is the structured websocket message sent when a parse mode is selected.
+// Type is "line" for log lines and "info" / "error" for stream notices.
+type LogFrame struct {
+	Type      string         `json:"type"`
+	Pod       string         `json:"pod,omitempty"`
+	Container string         `json:"container,omitempty"`
+	TS        string         `json:"ts,omitempty"`
+	Level     string         `json:"level,omitempty"`
+	Msg       string         `json:"msg"`
+	Fields    map[string]any `json:"fields,omitempty"`
+}
+
+// logStreamFormat filters log lines server-side and encodes them for the websocket.
+type logStreamFormat struct {
+	parse      string
+	include    *regexp.Regexp
+	exclude    *regexp.Regexp
+	minLevel   int
+	timestamps bool
+}
+
+// logStreamFormatFromQuery reads include / exclude (RE2 regexes matched against the raw
+// line), minLevel, and parse (raw|json|logfmt|auto). timestamps tells the encoder that
+// kube prefixed each line with an RFC3339 timestamp.
+func logStreamFormatFromQuery(q url.Values, timestamps bool) (*logStreamFormat, error) {
+	f := &logStreamFormat{parse: logParseRaw, timestamps: timestamps}
+	switch p := strings.ToLower(strings.TrimSpace(q.Get("parse"))); p {
+	case "", logParseRaw:
+	case logParseJSON, logParseLogfmt, logParseAuto:
+		f.parse = p
+	default:
+		return nil, fmt.Errorf("parse must be one of raw, json, logfmt, auto")
+	}
+	var err error
+	if v := q.Get("include"); v != "" {
+		if f.include, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("invalid include pattern: %w", err)
+		}
+	}
+	if v := q.Get("exclude"); v != "" {
+		if f.exclude, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern: %w", err)
+		}
+	}
+	if v := strings.TrimSpace(q.Get("minLevel")); v != "" {
+		if f.minLevel = parseLogLevel(v); f.minLevel == logLevelUnknown {
+			return nil, fmt.Errorf("minLevel must be one of trace, debug, info, warn, error, fatal")
+		}
+	}
+	return f, nil
+}
+
+func (f *logStreamFormat) structured() bool {
+	return f.parse != logParseRaw
+}
+
+// encodeLine applies filters and returns the websocket payload for one log line, or false
+// when the line is filtered out. Raw lines from multi-pod streams get a "[pod/container] "
+// prefix. Lines whose level cannot be determined pass the minLevel filter.
+func (f *logStreamFormat) encodeLine(t logTarget, prefixed bool, line []byte) ([]byte, bool) {
+	if f.include != nil && !f.include.Match(line) {
+		return nil, false
+	}
+	if f.exclude != nil && f.exclude.Match(line) {
+		return nil, false
+	}
+
+	if !f.structured() && f.minLevel == logLevelUnknown {
+		return f.rawLine(t, prefixed, line), true
+	}
+
+	frame, level := f.parseLine(line)
+	if f.minLevel != logLevelUnknown && level != logLevelUnknown && level < f.minLevel {
+		return nil, false
+	}
+	if !f.structured() {
+		return f.rawLine(t, prefixed, line), true
+	}
+	frame.Type = "line"
+	frame.Pod = t.Pod
+	frame.Container = t.Container
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// encodeNotice renders a stream notice: "ERROR: msg" / plain text in raw mode, a frame otherwise.
+func (f *logStreamFormat) encodeNotice(kind, msg string) []byte {
+	if !f.structured() {
+		if kind == "error" {
+			return []byte("ERROR: " + msg)
+		}
+		return []byte(msg)
+	}
+	data, _ := json.Marshal(LogFrame{Type: kind, Msg: msg})
+	return data
+}
+
+func (f *logStreamFormat) rawLine(t logTarget, prefixed bool, line []byte) []byte {
+	if !prefixed {
+		return line
+	}
+	prefix := "[" + t.key() + "] "
+	out := make([]byte, 0, len(prefix)+len(line))
+	out = append(out, prefix...)
+	return append(out, line...)
+}
+
+// parseLine splits off a kube timestamp prefix, then parses the rest according to the
+// parse mode. "raw" still detects levels (for minLevel) using auto parsing.
+func (f *logStreamFormat) parseLine(line []byte) (LogFrame, int) {
+	text := strings.TrimRight(string(line), "\r\n")
+	var frame LogFrame
+	if f.timestamps {
+		if i := strings.IndexByte(text, ' '); i > 0 {
+			if _, err := time.Parse(time.RFC3339Nano, text[:i]); err == nil {
+				frame.TS = text[:i]
+				text = text[i+1:]
+			}
+		}
+	}
+
+	var fields map[string]any
+	switch f.parse {
+	case logParseJSON:
+		fields = parseJSONLogLine(text)
+	case logParseLogfmt:
+		fields = parseLogfmtLine(text)
+	default:
+		if fields = parseJSONLogLine(text); fields == nil {
+			fields = parseLogfmtLine(text)
+		}
+	}
+
+	level := logLevelUnknown
+	if fields == nil {
+		frame.Msg = text
+		if m := plainLevelPattern.FindString(firstN(text, 64)); m != "" {
+			level = parseLogLevel(m)
+		}
+	} else {
+		if v, ok := takeField(fields, "msg", "message", "log"); ok {
+			frame.Msg = fmt.Sprint(v)
+		}
+		if v, ok := takeField(fields, "level", "lvl", "severity", "log.level"); ok {
+			level = parseLogLevel(fmt.Sprint(v))
+		}
+		if v, ok := takeField(fields, "ts", "time", "timestamp", "@timestamp"); ok && frame.TS == "" {
+			frame.TS = fmt.Sprint(v)
+		}
+		if len(fields) > 0 {
+			frame.Fields = fields
+		}
+	}
+	frame.Level = logLevelNames[level]
+	return frame, level
+}
+
+func parseJSONLogLine(text string) map[string]any {
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil
+	}
+	dec := json.NewDecoder(strings.NewReader(trimmed))
+	dec.UseNumber()
+	var fields map[string]any
+	if err := dec.Decode(&fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// parseLogfmtLine parses key=value pairs (values optionally double-quoted). It returns nil
+// unless the line is entirely logfmt, so prose containing a stray "=" stays plain text.
+func parseLogfmtLine(text string) map[string]any {
+	fields := map[string]any{}
+	s := strings.TrimSpace(text)
+	for s != "" {
+		eq := strings.IndexByte(s, '=')
+		sp := strings.IndexByte(s, ' ')
+		if eq <= 0 || (sp >= 0 && sp < eq) {
+			return nil
+		}
+		key := s[:eq]
+		s = s[eq+1:]
+		var val string
+		if strings.HasPrefix(s, `"`) {
+			end, escaped := 1, false
+			for ; end < len(s); end++ {
+				if escaped {
+					escaped = false
+					continue
+				}
+				if s[end] == '"' {
+					break
+				}
+				escaped = s[end] == '\\'
+			}
+			if end >= len(s) {
+				return nil
+			}
+			unquoted, err := strconv.Unquote(s[:end+1])
+			if err != nil {
+				return nil
+			}
+			val = unquoted
+			s = s[end+1:]
+		} else {
+			if sp = strings.IndexByte(s, ' '); sp < 0 {
+				val, s = s, ""
+			} else {
+				val, s = s[:sp], s[sp:]
+			}
+		}
+		fields[key] = val
+		s = strings.TrimLeft(s, " ")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+func takeField(fields map[string]any, keys ...string) (any, bool) {
+	for _, k := range keys {
+		if v, ok := fields[k]; ok {
+			delete(fields, k)
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// parseLogLevel maps level names and numeric (pino/bunyan style) levels to a rank.
+func parseLogLevel(v string) int {
+	v = strings.ToLower(strings.TrimSpace(v))
+	if n, err := strconv.Atoi(v); err == nil {
+		switch {
+		case n >= 60:
+			return logLevelFatal
+		case n >= 50:
+			return logLevelError
+		case n >= 40:
+			return logLevelWarn
+		case n >= 30:
+			return logLevelInfo
+		case n >= 20:
+			return logLevelDebug
+		case n >= 10:
+			return logLevelTrace
+		}
+		return logLevelUnknown
+	}
+	switch v {
+	case "trace":
+		return logLevelTrace
+	case "debug", "dbg":
+		return logLevelDebug
+	case "info", "information", "notice":
+		return logLevelInfo
+	case "warn", "warning":
+		return logLevelWarn
+	case "error", "err":
+		return logLevelError
+	case "fatal", "panic", "critical", "crit", "alert", "emergency":
+		return logLevelFatal
+	}
+	return logLevelUnknown
+}
+
+func firstN(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
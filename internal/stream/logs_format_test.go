@@ -0,0 +1,101 @@
+package stream
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestLogStreamFormat_JSONFrames(t *testing.T) {
+	f, err := logStreamFormatFromQuery(url.Values{"parse": {"auto"}, "minLevel": {"warn"}}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	target := logTarget{Pod: "web-1", Container: "app"}
+
+	if _, ok := f.encodeLine(target, true, []byte(`2026-01-02T03:04:05.123Z {"level":"info","msg":"ok"}`+"\n")); ok {
+		t.Fatal("info line should be filtered by minLevel=warn")
+	}
+
+	out, ok := f.encodeLine(target, true, []byte(`2026-01-02T03:04:05.123Z {"level":"error","msg":"boom","code":42}`+"\n"))
+	if !ok {
+		t.Fatal("error line should pass")
+	}
+	var frame LogFrame
+	if err := json.Unmarshal(out, &frame); err != nil {
+		t.Fatalf("decode frame: %v", err)
+	}
+	if frame.Type != "line" || frame.Pod != "web-1" || frame.Container != "app" || frame.Level != "error" || frame.Msg != "boom" {
+		t.Fatalf("unexpected frame: %#v", frame)
+	}
+	if frame.TS != "2026-01-02T03:04:05.123Z" || frame.Fields["code"] != float64(42) {
+		t.Fatalf("unexpected ts/fields: %#v", frame)
+	}
+
+	out, ok = f.encodeLine(target, true, []byte(`level=warn msg="disk almost full" pct=91`+"\n"))
+	if !ok {
+		t.Fatal("logfmt warn line should pass")
+	}
+	frame = LogFrame{}
+	_ = json.Unmarshal(out, &frame)
+	if frame.Level != "warn" || frame.Msg != "disk almost full" || frame.Fields["pct"] != "91" {
+		t.Fatalf("unexpected logfmt frame: %#v", frame)
+	}
+
+	if _, ok := f.encodeLine(target, true, []byte("plain line without level\n")); !ok {
+		t.Fatal("lines without a detectable level should pass minLevel")
+	}
+}
+
+func TestLogStreamFormat_RawFilters(t *testing.T) {
+	f, err := logStreamFormatFromQuery(url.Values{"include": {"GET|POST"}, "exclude": {"/healthz"}, "minLevel": {"info"}}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	target := logTarget{Pod: "web-1", Container: "app"}
+
+	if _, ok := f.encodeLine(target, false, []byte("INFO GET /healthz 200\n")); ok {
+		t.Fatal("excluded line should be dropped")
+	}
+	if _, ok := f.encodeLine(target, false, []byte("INFO dial tcp timeout\n")); ok {
+		t.Fatal("line not matching include should be dropped")
+	}
+	if _, ok := f.encodeLine(target, false, []byte("DEBUG GET /api 200\n")); ok {
+		t.Fatal("debug line should be dropped by minLevel=info")
+	}
+	out, ok := f.encodeLine(target, true, []byte("INFO GET /api 200\n"))
+	if !ok || string(out) != "[web-1/app] INFO GET /api 200\n" {
+		t.Fatalf("raw prefixed line: got %q ok=%v", out, ok)
+	}
+	if got := string(f.encodeNotice("error", "gone")); got != "ERROR: gone" {
+		t.Fatalf("raw notice: got %q", got)
+	}
+}
+
+func TestLogStreamFormat_Invalid(t *testing.T) {
+	for _, q := range []url.Values{
+		{"parse": {"xml"}},
+		{"include": {"("}},
+		{"minLevel": {"loud"}},
+	} {
+		if _, err := logStreamFormatFromQuery(q, false); err == nil {
+			t.Errorf("expected error for %v", q)
+		}
+	}
+}
+
+func TestParseLogfmtLine_EscapedQuotes(t *testing.T) {
+	fields := parseLogfmtLine(`msg="C:\\" path="a \"b\"" n=1`)
+	if fields == nil {
+		t.Fatal("line with escaped backslash and quotes should parse as logfmt")
+	}
+	if fields["msg"] != `C:\` || fields["path"] != `a "b"` || fields["n"] != "1" {
+		t.Fatalf("unexpected fields: %#v", fields)
+	}
+}
+
+func TestParseLogLevel_Numeric(t *testing.T) {
+	if parseLogLevel("50") != logLevelError || parseLogLevel("30") != logLevelInfo || parseLogLevel("WARNING") != logLevelWarn {
+		t.Fatal("unexpected level mapping")
+	}
+}
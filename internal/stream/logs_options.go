@@ -0,0 +1,70 @@
+package stream
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const maxLogTailLines = 5000
+
+// PodLogOptionsFromQuery parses the pod log options shared by the logs websockets and the
+// download endpoint: container, tail, follow, previous, sinceSeconds / sinceTime,
+// timestamps, and limitBytes. defaultTail applies when tail is absent (0 = whole log).
+// Out-of-range tail values keep the default, as the logs websocket always has.
+func PodLogOptionsFromQuery(q url.Values, defaultTail int64) (*v1.PodLogOptions, error) {
+	opts := &v1.PodLogOptions{
+		Container:  q.Get("container"),
+		Follow:     queryBool(q, "follow"),
+		Previous:   queryBool(q, "previous"),
+		Timestamps: queryBool(q, "timestamps"),
+	}
+
+	tail := defaultTail
+	if v := q.Get("tail"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n >= 0 && n <= maxLogTailLines {
+			tail = n
+		}
+	}
+	if tail > 0 {
+		opts.TailLines = &tail
+	}
+
+	sinceSeconds, sinceTime := q.Get("sinceSeconds"), q.Get("sinceTime")
+	if sinceSeconds != "" && sinceTime != "" {
+		return nil, fmt.Errorf("sinceSeconds and sinceTime are mutually exclusive")
+	}
+	if sinceSeconds != "" {
+		n, err := strconv.ParseInt(sinceSeconds, 10, 64)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("sinceSeconds must be a positive integer")
+		}
+		opts.SinceSeconds = &n
+	}
+	if sinceTime != "" {
+		t, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			return nil, fmt.Errorf("sinceTime must be an RFC3339 timestamp")
+		}
+		st := metav1.NewTime(t)
+		opts.SinceTime = &st
+	}
+
+	if v := q.Get("limitBytes"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("limitBytes must be a positive integer")
+		}
+		opts.LimitBytes = &n
+	}
+	return opts, nil
+}
+
+func queryBool(q url.Values, key string) bool {
+	v := q.Get(key)
+	return v == "1" || v == "true"
+}
@@ -0,0 +1,66 @@
+package stream
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestPodLogOptionsFromQuery(t *testing.T) {
+	q := url.Values{
+		"container":    {"app"},
+		"tail":         {"50"},
+		"follow":       {"1"},
+		"previous":     {"true"},
+		"timestamps":   {"true"},
+		"sinceSeconds": {"600"},
+		"limitBytes":   {"1048576"},
+	}
+	opts, err := PodLogOptionsFromQuery(q, 200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Container != "app" || !opts.Follow || !opts.Previous || !opts.Timestamps {
+		t.Fatalf("flags not parsed: %#v", opts)
+	}
+	if opts.TailLines == nil || *opts.TailLines != 50 {
+		t.Fatalf("tail: got %v", opts.TailLines)
+	}
+	if opts.SinceSeconds == nil || *opts.SinceSeconds != 600 || opts.SinceTime != nil {
+		t.Fatalf("since: got %v / %v", opts.SinceSeconds, opts.SinceTime)
+	}
+	if opts.LimitBytes == nil || *opts.LimitBytes != 1048576 {
+		t.Fatalf("limitBytes: got %v", opts.LimitBytes)
+	}
+}
+
+func TestPodLogOptionsFromQuery_Defaults(t *testing.T) {
+	opts, err := PodLogOptionsFromQuery(url.Values{"tail": {"999999"}}, 200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.TailLines == nil || *opts.TailLines != 200 {
+		t.Fatalf("out-of-range tail should keep default, got %v", opts.TailLines)
+	}
+
+	opts, err = PodLogOptionsFromQuery(url.Values{"sinceTime": {"2026-01-02T03:04:05Z"}}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.TailLines != nil || opts.SinceTime == nil || opts.SinceTime.UTC().Hour() != 3 {
+		t.Fatalf("whole-log defaults: %#v", opts)
+	}
+}
+
+func TestPodLogOptionsFromQuery_Invalid(t *testing.T) {
+	cases := []url.Values{
+		{"sinceSeconds": {"10"}, "sinceTime": {"2026-01-02T03:04:05Z"}},
+		{"sinceSeconds": {"-5"}},
+		{"sinceTime": {"yesterday"}},
+		{"limitBytes": {"0"}},
+	}
+	for _, q := range cases {
+		if _, err := PodLogOptionsFromQuery(q, 200); err == nil {
+			t.Errorf("expected error for %v", q)
+		}
+	}
+}
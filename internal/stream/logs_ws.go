@@ -5,12 +5,10 @@ import (
 	"context"
 	"io"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/websocket"
-	v1 "k8s.io/api/core/v1"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
 )
@@ -26,22 +24,22 @@ type LogsWS struct {
 func (h *LogsWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ns := chi.URLParam(r, "ns")
 	pod := chi.URLParam(r, "name")
-	container := r.URL.Query().Get("container")
-
-	tail := int64(200)
-	if v := r.URL.Query().Get("tail"); v != "" {
-		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n >= 0 && n <= 5000 {
-			tail = n
-		}
+	opts, optsErr := PodLogOptionsFromQuery(r.URL.Query(), 200)
+	var format *logStreamFormat
+	if optsErr == nil {
+		format, optsErr = logStreamFormatFromQuery(r.URL.Query(), opts.Timestamps)
 	}
 
-	follow := r.URL.Query().Get("follow") == "1" || r.URL.Query().Get("follow") == "true"
-
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		return
 	}
 	defer func() { _ = conn.Close() }()
+	if optsErr != nil {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("ERROR: "+optsErr.Error()))
+		return
+	}
+	target := logTarget{Pod: pod, Container: opts.Container}
 
 	ctx, cancel := context.WithCancel(r.Context())
 	defer cancel()
@@ -54,22 +52,14 @@ func (h *LogsWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		clients, _, err = h.Mgr.GetClients(ctx)
 	}
 	if err != nil {
-		_ = conn.WriteMessage(websocket.TextMessage, []byte("ERROR: "+err.Error()))
+		_ = conn.WriteMessage(websocket.TextMessage, format.encodeNotice("error", err.Error()))
 		return
 	}
 
-	opts := &v1.PodLogOptions{
-		Container: container,
-		Follow:    follow,
-	}
-	if tail > 0 {
-		opts.TailLines = &tail
-	}
-
 	req := clients.Clientset.CoreV1().Pods(ns).GetLogs(pod, opts)
 	stream, err := req.Stream(ctx)
 	if err != nil {
-		_ = conn.WriteMessage(websocket.TextMessage, []byte("ERROR: "+err.Error()))
+		_ = conn.WriteMessage(websocket.TextMessage, format.encodeNotice("error", err.Error()))
 		return
 	}
 	defer func() { _ = stream.Close() }()
@@ -92,13 +82,15 @@ func (h *LogsWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	for {
 		line, err := reader.ReadBytes('\n')
 		if len(line) > 0 {
-			_ = conn.WriteMessage(websocket.TextMessage, line)
+			if out, ok := format.encodeLine(target, false, line); ok {
+				_ = conn.WriteMessage(websocket.TextMessage, out)
+			}
 		}
 		if err != nil {
 			if err == io.EOF {
 				return
 			}
-			_ = conn.WriteMessage(websocket.TextMessage, []byte("ERROR: "+err.Error()))
+			_ = conn.WriteMessage(websocket.TextMessage, format.encodeNotice("error", err.Error()))
 			return
 		}
 	}
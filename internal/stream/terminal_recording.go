@@ -0,0 +1,175 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const terminalRecordingExt = ".cast"
+
+// TerminalRecording describes one asciinema v2 recording of a terminal session.
+type TerminalRecording struct {
+	Name      string    `json:"name"`
+	SessionID string    `json:"sessionId"`
+	SizeBytes int64     `json:"sizeBytes"`
+	StartedAt time.Time `json:"startedAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DefaultTerminalRecordingsDir is where opt-in terminal recordings are written, next to
+// the dataplane cache under the user cache directory.
+func DefaultTerminalRecordingsDir() string {
+	base, err := os.UserCacheDir()
+	if err != nil || base == "" {
+		base = os.TempDir()
+	}
+	return filepath.Join(base, "kview", "recordings")
+}
+
+// terminalRecorder writes stdin, stdout and resize frames as an asciinema v2 cast file.
+// Once a write fails every later frame reports the same error, and the terminal process
+// closes the shell rather than let it continue unrecorded.
+type terminalRecorder struct {
+	mu    sync.Mutex
+	f     *os.File
+	w     *bufio.Writer
+	start time.Time
+	err   error
+}
+
+func terminalRecordingName(sessionID string, started time.Time) string {
+	return sessionID + "-" + started.UTC().Format("20060102T150405Z") + terminalRecordingExt
+}
+
+func newTerminalRecorder(dir, sessionID, title string, cols, rows uint16, started time.Time) (*terminalRecorder, string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, "", err
+	}
+	name := terminalRecordingName(sessionID, started)
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, "", err
+	}
+	rec := &terminalRecorder{f: f, w: bufio.NewWriter(f), start: started}
+	header, _ := json.Marshal(map[string]any{
+		"version":   2,
+		"width":     cols,
+		"height":    rows,
+		"timestamp": started.Unix(),
+		"title":     title,
+		"env":       map[string]string{"TERM": "xterm-256color", "SHELL": "/bin/sh"},
+	})
+	if err := rec.writeLine(header); err != nil {
+		_ = rec.Close()
+		return nil, "", err
+	}
+	return rec, name, nil
+}
+
+func (r *terminalRecorder) output(b []byte) error { return r.event("o", string(b)) }
+func (r *terminalRecorder) input(b []byte) error  { return r.event("i", string(b)) }
+func (r *terminalRecorder) resize(cols, rows uint16) error {
+	if cols == 0 || rows == 0 {
+		return nil
+	}
+	return r.event("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+func (r *terminalRecorder) event(kind, data string) error {
+	if r == nil {
+		return nil
+	}
+	line, err := json.Marshal([]any{time.Since(r.start).Seconds(), kind, data})
+	if err != nil {
+		return err
+	}
+	return r.writeLine(line)
+}
+
+func (r *terminalRecorder) writeLine(line []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	if r.w == nil {
+		return nil
+	}
+	if _, err := r.w.Write(append(line, '\n')); err != nil {
+		r.err = fmt.Errorf("session recording failed: %w", err)
+		return r.err
+	}
+	// Flush per frame so a crash or kill still leaves a usable recording.
+	if err := r.w.Flush(); err != nil {
+		r.err = fmt.Errorf("session recording failed: %w", err)
+	}
+	return r.err
+}
+
+func (r *terminalRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.w == nil {
+		return nil
+	}
+	_ = r.w.Flush()
+	r.w = nil
+	return r.f.Close()
+}
+
+// ListTerminalRecordings returns recordings for a session, oldest first. Recordings are
+// kept after the session itself is deleted.
+func ListTerminalRecordings(dir, sessionID string) ([]TerminalRecording, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []TerminalRecording{}, nil
+		}
+		return nil, err
+	}
+	out := []TerminalRecording{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, sessionID+"-") || !strings.HasSuffix(name, terminalRecordingExt) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, sessionID+"-"), terminalRecordingExt)
+		started, err := time.Parse("20060102T150405Z", stamp)
+		if err != nil {
+			// Another session whose ID shares this prefix.
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		out = append(out, TerminalRecording{
+			Name:      name,
+			SessionID: sessionID,
+			SizeBytes: info.Size(),
+			StartedAt: started,
+			UpdatedAt: info.ModTime().UTC(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.Before(out[j].StartedAt) })
+	return out, nil
+}
+
+// TerminalRecordingPath resolves a recording name for a session to a file path, rejecting
+// names that do not belong to the session or would escape the recordings directory.
+func TerminalRecordingPath(dir, sessionID, name string) (string, bool) {
+	if name != filepath.Base(name) || !strings.HasPrefix(name, sessionID+"-") || !strings.HasSuffix(name, terminalRecordingExt) {
+		return "", false
+	}
+	return filepath.Join(dir, name), true
+}
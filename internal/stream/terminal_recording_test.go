@@ -0,0 +1,142 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTerminalRecorder_WritesAsciicastV2(t *testing.T) {
+	dir := t.TempDir()
+	started := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rec, name, err := newTerminalRecorder(dir, "sess-1", "ns/pod", 80, 24, started)
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	if name != "sess-1-20260301T120000Z.cast" {
+		t.Fatalf("name: got %q", name)
+	}
+	rec.output([]byte("$ "))
+	rec.input([]byte("ls\r"))
+	rec.resize(120, 40)
+	rec.resize(0, 40)
+	if err := rec.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	rec.output([]byte("after close"))
+
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	sc := bufio.NewScanner(f)
+	var lines []string
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	if len(lines) != 4 {
+		t.Fatalf("expected header + 3 events, got %d: %v", len(lines), lines)
+	}
+
+	var header map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &header); err != nil {
+		t.Fatalf("header: %v", err)
+	}
+	if header["version"] != float64(2) || header["width"] != float64(80) || header["height"] != float64(24) {
+		t.Fatalf("header: got %v", header)
+	}
+
+	wantKinds := []string{"o", "i", "r"}
+	wantData := []string{"$ ", "ls\r", "120x40"}
+	for i, line := range lines[1:] {
+		var ev []any
+		if err := json.Unmarshal([]byte(line), &ev); err != nil || len(ev) != 3 {
+			t.Fatalf("event %d: %q (%v)", i, line, err)
+		}
+		if ev[1] != wantKinds[i] || ev[2] != wantData[i] {
+			t.Fatalf("event %d: got %v", i, ev)
+		}
+	}
+}
+
+func TestListTerminalRecordings_FiltersBySession(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"sess-1-20260301T130000Z.cast",
+		"sess-1-20260301T120000Z.cast",
+		"sess-10-20260301T120000Z.cast",
+		"sess-1-notes.txt",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	items, err := ListTerminalRecordings(dir, "sess-1")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(items) != 2 || items[0].Name != "sess-1-20260301T120000Z.cast" || items[1].Name != "sess-1-20260301T130000Z.cast" {
+		t.Fatalf("items: got %+v", items)
+	}
+
+	items, err = ListTerminalRecordings(filepath.Join(dir, "missing"), "sess-1")
+	if err != nil || len(items) != 0 {
+		t.Fatalf("missing dir: got %v, %v", items, err)
+	}
+}
+
+func TestTerminalRecordingPath_RejectsForeignNames(t *testing.T) {
+	dir := t.TempDir()
+	if p, ok := TerminalRecordingPath(dir, "sess-1", "sess-1-20260301T120000Z.cast"); !ok || p != filepath.Join(dir, "sess-1-20260301T120000Z.cast") {
+		t.Fatalf("valid name: got %q %v", p, ok)
+	}
+	for _, name := range []string{
+		"../sess-1-x.cast",
+		"sess-1-../../etc/passwd.cast",
+		"sess-2-20260301T120000Z.cast",
+		"sess-1-20260301T120000Z.txt",
+	} {
+		if _, ok := TerminalRecordingPath(dir, "sess-1", name); ok {
+			t.Fatalf("expected %q to be rejected", name)
+		}
+	}
+}
+
+func TestTerminalRecorder_FailureIsReportedAndSticky(t *testing.T) {
+	rec, _, err := newTerminalRecorder(t.TempDir(), "sess-1", "ns/pod", 80, 24, time.Now())
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	_ = rec.f.Close()
+	if err := rec.output([]byte("$ ")); err == nil {
+		t.Fatal("expected a write error once the file is gone")
+	}
+	if err := rec.input([]byte("ls\r")); err == nil {
+		t.Fatal("a failed recorder must keep failing")
+	}
+}
+
+func TestTerminalProcess_UnrecordableOutputEndsShell(t *testing.T) {
+	rec, _, err := newTerminalRecorder(t.TempDir(), "sess-1", "ns/pod", 80, 24, time.Now())
+	if err != nil {
+		t.Fatalf("new recorder: %v", err)
+	}
+	_ = rec.f.Close()
+	cancelled := false
+	proc := newTerminalProcess("sess-1", nil, nil, func() { cancelled = true })
+	proc.rec = rec
+	if _, err := proc.Write([]byte("secret")); err == nil {
+		t.Fatal("expected Write to fail when the output cannot be recorded")
+	}
+	if !cancelled {
+		t.Fatal("expected the process to be cancelled")
+	}
+	if got := proc.scroll.Bytes(); len(got) != 0 {
+		t.Fatalf("unrecorded output must not reach the scrollback, got %q", got)
+	}
+}
@@ -0,0 +1,420 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/korex-labs/kview/v5/internal/session"
+)
+
+// Terminal reattach defaults. Sessions override them through the "reattachGraceSec" and
+// "scrollbackBytes" metadata keys set when the session is created.
+const (
+	DefaultTerminalReattachGrace = 5 * time.Minute
+	DefaultTerminalScrollback    = 256 << 10
+
+	maxTerminalReattachGrace = time.Hour
+	minTerminalScrollback    = 16 << 10
+	maxTerminalScrollback    = 4 << 20
+)
+
+// Websocket liveness for attached terminals. A client that stops answering pings or
+// cannot take a write within terminalWriteWait is dropped; the process keeps running
+// for the reattach grace period either way.
+const (
+	terminalWriteWait  = 10 * time.Second
+	terminalPongWait   = 60 * time.Second
+	terminalPingPeriod = 20 * time.Second
+	terminalSendQueue  = 256
+)
+
+// terminalCleanupRegistrar is implemented by session managers that can stop a live
+// terminal process when the session is deleted.
+type terminalCleanupRegistrar interface {
+	RegisterTerminal(id string, stop func())
+	UnregisterTerminal(id string)
+}
+
+// scrollbackRing keeps the most recent terminal output, up to a fixed byte capacity.
+// Replayed output may start mid escape sequence; terminals recover on the next redraw.
+type scrollbackRing struct {
+	buf   []byte
+	start int
+	size  int
+}
+
+func newScrollbackRing(capacity int) *scrollbackRing {
+	return &scrollbackRing{buf: make([]byte, capacity)}
+}
+
+func (r *scrollbackRing) Write(p []byte) {
+	c := len(r.buf)
+	if c == 0 {
+		return
+	}
+	if len(p) >= c {
+		copy(r.buf, p[len(p)-c:])
+		r.start, r.size = 0, c
+		return
+	}
+	end := (r.start + r.size) % c
+	n := copy(r.buf[end:], p)
+	copy(r.buf, p[n:])
+	r.size += len(p)
+	if r.size > c {
+		r.start = (r.start + r.size - c) % c
+		r.size = c
+	}
+}
+
+// Bytes returns a copy of the buffered output in write order.
+func (r *scrollbackRing) Bytes() []byte {
+	out := make([]byte, r.size)
+	n := copy(out, r.buf[r.start:min(r.start+r.size, len(r.buf))])
+	copy(out[n:], r.buf[:r.size-n])
+	return out
+}
+
+type terminalFrame struct {
+	kind  int
+	data  []byte
+	close bool
+}
+
+// terminalConn owns all writes to one attached websocket. Frames are queued without
+// blocking and sent by a single writer goroutine with a write deadline, so a stalled
+// client never holds up exec output or a reattach. A full queue drops the connection;
+// the output is still in the scrollback and is replayed on reattach.
+type terminalConn struct {
+	ws   *websocket.Conn
+	out  chan terminalFrame
+	done chan struct{}
+	once sync.Once
+}
+
+func newTerminalConn(ws *websocket.Conn) *terminalConn {
+	return &terminalConn{
+		ws:   ws,
+		out:  make(chan terminalFrame, terminalSendQueue),
+		done: make(chan struct{}),
+	}
+}
+
+// send queues a frame. It never blocks.
+func (c *terminalConn) send(kind int, data []byte) {
+	c.enqueue(terminalFrame{kind: kind, data: data})
+}
+
+// closeWith queues an optional final text message and closes the socket once the
+// frames queued before it are written.
+func (c *terminalConn) closeWith(msg string) {
+	f := terminalFrame{close: true}
+	if msg != "" {
+		f.kind, f.data = websocket.TextMessage, []byte(msg)
+	}
+	c.enqueue(f)
+}
+
+func (c *terminalConn) enqueue(f terminalFrame) {
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+	select {
+	case c.out <- f:
+	default:
+		c.stop()
+	}
+}
+
+// stop closes the socket and ends the writer. The read loop then fails and detaches.
+func (c *terminalConn) stop() {
+	c.once.Do(func() {
+		close(c.done)
+		_ = c.ws.Close()
+	})
+}
+
+// writeLoop sends queued frames and keepalive pings until the connection stops.
+func (c *terminalConn) writeLoop() {
+	ping := time.NewTicker(terminalPingPeriod)
+	defer ping.Stop()
+	defer c.stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case f := <-c.out:
+			if f.data != nil {
+				_ = c.ws.SetWriteDeadline(time.Now().Add(terminalWriteWait))
+				if err := c.ws.WriteMessage(f.kind, f.data); err != nil {
+					return
+				}
+			}
+			if f.close {
+				return
+			}
+		case <-ping.C:
+			if err := c.ws.WriteControl(websocket.PingMessage, []byte("ping"), time.Now().Add(terminalWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// terminalProcess is one exec stream that outlives individual websocket connections.
+// Output goes to the scrollback ring and, when attached, to the current websocket.
+// After a disconnect the process keeps running for the grace period, waiting for a
+// new websocket to reattach and replay the scrollback.
+type terminalProcess struct {
+	id       string
+	sessions session.Manager
+	grace    time.Duration
+	cancel   context.CancelFunc
+	sizes    *terminalSizeQueue
+	stdinR   *io.PipeReader
+	stdinW   *io.PipeWriter
+	onExit   func()
+	rec      *terminalRecorder
+
+	mu        sync.Mutex
+	conn      *terminalConn
+	scroll    *scrollbackRing
+	graceStop *time.Timer
+	closed    bool
+
+	// sessMu serializes session store updates; once finish has written the final
+	// state, later updates from a racing attach or detach are dropped.
+	sessMu    sync.Mutex
+	sessFinal bool
+}
+
+func newTerminalProcess(id string, sessions session.Manager, meta map[string]string, cancel context.CancelFunc) *terminalProcess {
+	stdinR, stdinW := io.Pipe()
+	return &terminalProcess{
+		id:       id,
+		sessions: sessions,
+		grace:    terminalReattachGrace(meta),
+		cancel:   cancel,
+		sizes:    newTerminalSizeQueue(),
+		stdinR:   stdinR,
+		stdinW:   stdinW,
+		scroll:   newScrollbackRing(terminalScrollbackBytes(meta)),
+	}
+}
+
+func terminalReattachGrace(meta map[string]string) time.Duration {
+	v, ok := meta["reattachGraceSec"]
+	if !ok {
+		return DefaultTerminalReattachGrace
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return DefaultTerminalReattachGrace
+	}
+	return min(time.Duration(n)*time.Second, maxTerminalReattachGrace)
+}
+
+func terminalScrollbackBytes(meta map[string]string) int {
+	n, err := strconv.Atoi(meta["scrollbackBytes"])
+	if err != nil || n <= 0 {
+		return DefaultTerminalScrollback
+	}
+	return max(minTerminalScrollback, min(n, maxTerminalScrollback))
+}
+
+// Write receives exec stdout/stderr. Output that cannot be recorded is not shown; the
+// returned error ends the exec stream and finish reports it to the client.
+func (p *terminalProcess) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.rec.output(b); err != nil {
+		p.cancel()
+		return 0, err
+	}
+	p.scroll.Write(b)
+	if p.conn != nil {
+		// The exec stream reuses b, so the queued frame needs its own copy.
+		p.conn.send(websocket.BinaryMessage, bytes.Clone(b))
+	}
+	return len(b), nil
+}
+
+// run streams the exec session until it exits or the process is cancelled.
+func (p *terminalProcess) run(ctx context.Context, exec remotecommand.Executor) {
+	err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             p.stdinR,
+		Stdout:            p,
+		Stderr:            p,
+		Tty:               true,
+		TerminalSizeQueue: p.sizes,
+	})
+	p.finish(err)
+}
+
+// attach makes conn the live websocket, replacing any previous one, and replays the
+// scrollback to it.
+func (p *terminalProcess) attach(conn *terminalConn) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return fmt.Errorf("terminal session has ended")
+	}
+	if prev := p.conn; prev != nil {
+		prev.closeWith("error: session attached from another connection")
+	}
+	if p.graceStop != nil {
+		p.graceStop.Stop()
+		p.graceStop = nil
+	}
+	if replay := p.scroll.Bytes(); len(replay) > 0 {
+		conn.send(websocket.BinaryMessage, replay)
+	}
+	p.conn = conn
+	p.mu.Unlock()
+
+	p.updateSession(false, func(s *session.Session) {
+		s.Status = session.StatusRunning
+		s.ConnectionState = session.ConnectionConnected
+	})
+	return nil
+}
+
+// detach forgets conn if it is still the live websocket and starts the grace timer.
+func (p *terminalProcess) detach(conn *terminalConn) {
+	p.mu.Lock()
+	if p.conn != conn || p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.conn = nil
+	if p.grace <= 0 {
+		p.mu.Unlock()
+		p.cancel()
+		return
+	}
+	p.graceStop = time.AfterFunc(p.grace, p.cancel)
+	p.mu.Unlock()
+
+	p.updateSession(false, func(s *session.Session) {
+		s.ConnectionState = session.ConnectionDisconnected
+	})
+}
+
+// pump forwards websocket input to the exec stdin until the socket closes or the
+// client stops answering pings.
+func (p *terminalProcess) pump(conn *terminalConn) {
+	ws := conn.ws
+	_ = ws.SetReadDeadline(time.Now().Add(terminalPongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(terminalPongWait))
+	})
+	for {
+		mt, msg, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		if mt != websocket.TextMessage && mt != websocket.BinaryMessage {
+			continue
+		}
+		_ = ws.SetReadDeadline(time.Now().Add(terminalPongWait))
+		if len(msg) == 0 {
+			continue
+		}
+		if mt == websocket.TextMessage {
+			var control terminalControlMessage
+			if err := json.Unmarshal(msg, &control); err == nil && control.Type == "resize" {
+				p.sizes.Push(control.Cols, control.Rows)
+				if err := p.rec.resize(control.Cols, control.Rows); err != nil {
+					p.recordingFailed(err)
+					return
+				}
+				continue
+			}
+		}
+		if err := p.rec.input(msg); err != nil {
+			p.recordingFailed(err)
+			return
+		}
+		if _, err := p.stdinW.Write(msg); err != nil {
+			return
+		}
+	}
+}
+
+// recordingFailed closes a recorded shell whose input could no longer be recorded.
+// The session ends as failed; the client gets the reason before the socket closes.
+func (p *terminalProcess) recordingFailed(err error) {
+	p.mu.Lock()
+	if p.conn != nil && !p.closed {
+		p.conn.send(websocket.TextMessage, []byte("error: "+err.Error()))
+	}
+	p.mu.Unlock()
+	p.cancel()
+}
+
+func (p *terminalProcess) finish(err error) {
+	p.mu.Lock()
+	p.closed = true
+	if p.graceStop != nil {
+		p.graceStop.Stop()
+		p.graceStop = nil
+	}
+	if conn := p.conn; conn != nil {
+		msg := ""
+		if err != nil {
+			msg = fmt.Sprintf("error: stream ended: %v", err)
+		}
+		conn.closeWith(msg)
+		p.conn = nil
+	}
+	p.mu.Unlock()
+
+	p.cancel()
+	p.sizes.Close()
+	_ = p.stdinW.Close()
+	_ = p.rec.Close()
+	if p.onExit != nil {
+		p.onExit()
+	}
+	p.updateSession(true, func(s *session.Session) {
+		if err != nil {
+			s.Status = session.StatusFailed
+		} else {
+			s.Status = session.StatusStopped
+		}
+		s.ConnectionState = session.ConnectionClosed
+	})
+}
+
+// updateSession applies fn to the stored session. The final update (from finish) wins
+// over any attach or detach update that runs after it.
+func (p *terminalProcess) updateSession(final bool, fn func(*session.Session)) {
+	if p.sessions == nil {
+		return
+	}
+	p.sessMu.Lock()
+	defer p.sessMu.Unlock()
+	if p.sessFinal {
+		return
+	}
+	p.sessFinal = final
+	ctx := context.Background()
+	sess, ok, err := p.sessions.Get(ctx, p.id)
+	if err != nil || !ok {
+		return
+	}
+	fn(&sess)
+	sess.UpdatedAt = time.Now().UTC()
+	_ = p.sessions.Update(ctx, sess)
+}
@@ -0,0 +1,148 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/korex-labs/kview/v5/internal/runtime"
+	"github.com/korex-labs/kview/v5/internal/session"
+)
+
+func TestScrollbackRing_KeepsMostRecentBytes(t *testing.T) {
+	r := newScrollbackRing(8)
+	r.Write([]byte("abc"))
+	if got := string(r.Bytes()); got != "abc" {
+		t.Fatalf("got %q", got)
+	}
+	r.Write([]byte("defgh"))
+	if got := string(r.Bytes()); got != "abcdefgh" {
+		t.Fatalf("got %q", got)
+	}
+	r.Write([]byte("ij"))
+	if got := string(r.Bytes()); got != "cdefghij" {
+		t.Fatalf("wrapped: got %q", got)
+	}
+	r.Write([]byte("0123456789"))
+	if got := string(r.Bytes()); got != "23456789" {
+		t.Fatalf("oversized write: got %q", got)
+	}
+}
+
+func TestTerminalReattachSettingsFromMetadata(t *testing.T) {
+	if got := terminalReattachGrace(nil); got != DefaultTerminalReattachGrace {
+		t.Errorf("default grace: got %v", got)
+	}
+	if got := terminalReattachGrace(map[string]string{"reattachGraceSec": "0"}); got != 0 {
+		t.Errorf("zero grace should end on disconnect, got %v", got)
+	}
+	if got := terminalReattachGrace(map[string]string{"reattachGraceSec": "999999"}); got != maxTerminalReattachGrace {
+		t.Errorf("grace should clamp, got %v", got)
+	}
+	if got := terminalScrollbackBytes(map[string]string{"scrollbackBytes": "10"}); got != minTerminalScrollback {
+		t.Errorf("scrollback should clamp up, got %d", got)
+	}
+	if got := terminalScrollbackBytes(nil); got != DefaultTerminalScrollback {
+		t.Errorf("default scrollback: got %d", got)
+	}
+}
+
+func TestTerminalProcess_DetachStartsGraceAndMarksDisconnected(t *testing.T) {
+	sessions := session.NewInMemoryManager(runtime.NewInMemoryActivityRegistry())
+	created, _ := sessions.Create(context.Background(), session.Session{
+		Type:            session.TypeTerminal,
+		Status:          session.StatusRunning,
+		ConnectionState: session.ConnectionConnected,
+	})
+
+	cancelled := make(chan struct{})
+	proc := newTerminalProcess(created.ID, sessions, map[string]string{"reattachGraceSec": "1"}, func() {
+		select {
+		case <-cancelled:
+		default:
+			close(cancelled)
+		}
+	})
+	conn := newTerminalConn(&websocket.Conn{})
+	proc.conn = conn
+
+	proc.detach(newTerminalConn(&websocket.Conn{}))
+	if proc.conn != conn {
+		t.Fatal("detaching a stale connection must not drop the live one")
+	}
+
+	proc.detach(conn)
+	got, _, _ := sessions.Get(context.Background(), created.ID)
+	if got.ConnectionState != session.ConnectionDisconnected || got.Status != session.StatusRunning {
+		t.Fatalf("session after detach: status=%s conn=%s", got.Status, got.ConnectionState)
+	}
+	select {
+	case <-cancelled:
+		t.Fatal("process cancelled before grace period")
+	default:
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(3 * time.Second):
+		t.Fatal("process not cancelled after grace period")
+	}
+}
+
+func TestTerminalProcess_FinishIsFinalSessionUpdate(t *testing.T) {
+	sessions := session.NewInMemoryManager(runtime.NewInMemoryActivityRegistry())
+	created, _ := sessions.Create(context.Background(), session.Session{
+		Type:            session.TypeTerminal,
+		Status:          session.StatusRunning,
+		ConnectionState: session.ConnectionConnected,
+	})
+	proc := newTerminalProcess(created.ID, sessions, nil, func() {})
+
+	proc.finish(nil)
+	// A detach racing with finish must not reopen the session.
+	proc.updateSession(false, func(s *session.Session) {
+		s.ConnectionState = session.ConnectionDisconnected
+	})
+	got, _, _ := sessions.Get(context.Background(), created.ID)
+	if got.Status != session.StatusStopped || got.ConnectionState != session.ConnectionClosed {
+		t.Fatalf("session after finish: status=%s conn=%s", got.Status, got.ConnectionState)
+	}
+}
+
+func TestTerminalConn_FullQueueDropsConnection(t *testing.T) {
+	accepted := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		accepted <- ws
+	}))
+	defer srv.Close()
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	// No writer goroutine drains the queue, as with a stalled client.
+	conn := newTerminalConn(<-accepted)
+	for range terminalSendQueue {
+		conn.send(websocket.BinaryMessage, []byte("x"))
+	}
+	select {
+	case <-conn.done:
+		t.Fatal("connection dropped before the queue filled")
+	default:
+	}
+	conn.send(websocket.BinaryMessage, []byte("overflow"))
+	select {
+	case <-conn.done:
+	default:
+		t.Fatal("a full queue must drop the connection instead of blocking")
+	}
+}
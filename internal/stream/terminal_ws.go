@@ -1,30 +1,41 @@
 package stream
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
+	"maps"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/websocket"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 
 	"github.com/korex-labs/kview/v5/internal/cluster"
+	"github.com/korex-labs/kview/v5/internal/kube"
 	"github.com/korex-labs/kview/v5/internal/session"
 )
 
+// TerminalWS attaches websockets to terminal sessions. Exec streams are kept per session
+// ID so a reconnecting websocket reattaches to the running shell and replays scrollback.
 type TerminalWS struct {
 	Mgr      *cluster.Manager
 	Sessions session.Manager
-}
+	// RecordingsDir is where sessions created with metadata record=true are recorded.
+	// The server passes the same directory to the recording download routes; empty
+	// means DefaultTerminalRecordingsDir.
+	RecordingsDir string
 
-type wsWriter struct {
-	conn *websocket.Conn
+	mu   sync.Mutex
+	live map[string]*terminalProcess
 }
 
+// debugContainerStartTimeout bounds how long attaching a debug session waits for the
+// ephemeral container image to be pulled and started.
+const debugContainerStartTimeout = 2 * time.Minute
+
 type terminalControlMessage struct {
 	Type string `json:"type"`
 	Cols uint16 `json:"cols"`
@@ -86,13 +97,6 @@ func (q *terminalSizeQueue) Close() {
 	close(q.ch)
 }
 
-func (w *wsWriter) Write(p []byte) (int, error) {
-	if err := w.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
-		return 0, err
-	}
-	return len(p), nil
-}
-
 func (t *TerminalWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -117,71 +121,148 @@ func (t *TerminalWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		_ = conn.WriteMessage(websocket.TextMessage, []byte("error: session not found"))
 		return
 	}
-	if sess.Type != session.TypeTerminal {
+	if sess.Type != session.TypeTerminal && sess.Type != session.TypeDebug {
 		_ = conn.WriteMessage(websocket.TextMessage, []byte("error: session is not terminal type"))
 		return
 	}
 
+	proc := t.liveProcess(id)
+	if proc == nil {
+		if sess.Status == session.StatusStopped || sess.Status == session.StatusFailed {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte("error: terminal session has ended"))
+			return
+		}
+		proc, err = t.startProcess(ctx, sess)
+		if err != nil {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte("error: "+err.Error()))
+			sess.Status = session.StatusFailed
+			sess.ConnectionState = session.ConnectionClosed
+			sess.UpdatedAt = time.Now().UTC()
+			_ = t.Sessions.Update(ctx, sess)
+			return
+		}
+	}
+
+	tc := newTerminalConn(conn)
+	if err := proc.attach(tc); err != nil {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("error: "+err.Error()))
+		return
+	}
+	// From here on only the writer goroutine writes to conn.
+	go tc.writeLoop()
+	defer tc.stop()
+	defer proc.detach(tc)
+	proc.pump(tc)
+}
+
+func (t *TerminalWS) liveProcess(id string) *terminalProcess {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.live[id]
+}
+
+// startProcess opens the exec stream for a terminal session. The stream runs on its own
+// context so it survives websocket disconnects until the reattach grace period expires.
+func (t *TerminalWS) startProcess(ctx context.Context, sess session.Session) (*terminalProcess, error) {
 	var clients *cluster.Clients
+	var err error
 	if sess.TargetCluster != "" {
 		clients, _, err = t.Mgr.GetClientsForContext(ctx, sess.TargetCluster)
 	} else {
 		clients, _, err = t.Mgr.GetClients(ctx)
 	}
 	if err != nil {
-		_ = conn.WriteMessage(websocket.TextMessage, []byte("error: failed to get Kubernetes client"))
-		sess.Status = session.StatusFailed
-		sess.ConnectionState = session.ConnectionClosed
-		sess.UpdatedAt = time.Now().UTC()
-		_ = t.Sessions.Update(ctx, sess)
-		return
+		return nil, fmt.Errorf("failed to get Kubernetes client")
 	}
 
 	ns := sess.TargetNamespace
 	pod := sess.TargetResource
 	container := sess.TargetContainer
 	if ns == "" || pod == "" {
-		_ = conn.WriteMessage(websocket.TextMessage, []byte("error: session is missing namespace or pod"))
-		sess.Status = session.StatusFailed
-		sess.ConnectionState = session.ConnectionClosed
-		sess.UpdatedAt = time.Now().UTC()
-		_ = t.Sessions.Update(ctx, sess)
-		return
+		return nil, fmt.Errorf("session is missing namespace or pod")
 	}
 
-	var cmd []string
-	if shell, ok := sess.Metadata["shell"]; ok && shell != "" {
-		// Explicit shell requested for this session.
-		cmd = []string{"/bin/sh", "-c", "export TERM=xterm-256color COLORTERM=truecolor; exec \"$0\"", shell}
+	restClient := clients.Clientset.CoreV1().RESTClient()
+	var req *rest.Request
+	if sess.Type == session.TypeDebug {
+		// The ephemeral container runs the debug image's default command (its shell), so the
+		// terminal attaches to it instead of exec'ing a new one.
+		if container == "" {
+			return nil, fmt.Errorf("debug session is missing its container")
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, debugContainerStartTimeout)
+		err := kube.DebugContainerClient{Clientset: clients.Clientset}.WaitRunning(waitCtx, ns, pod, container)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		req = restClient.Post().
+			Resource("pods").
+			Namespace(ns).
+			Name(pod).
+			SubResource("attach").
+			Param("container", container).
+			Param("stdin", "true").
+			Param("stdout", "true").
+			Param("stderr", "false").
+			Param("tty", "true")
 	} else {
-		// Prefer bash when available, otherwise fall back to POSIX sh.
-		cmd = []string{"/bin/sh", "-c", "export TERM=xterm-256color COLORTERM=truecolor; [ -x /bin/bash ] && exec /bin/bash || exec /bin/sh"}
-	}
+		var cmd []string
+		if shell, ok := sess.Metadata["shell"]; ok && shell != "" {
+			// Explicit shell requested for this session.
+			cmd = []string{"/bin/sh", "-c", "export TERM=xterm-256color COLORTERM=truecolor; exec \"$0\"", shell}
+		} else {
+			// Prefer bash when available, otherwise fall back to POSIX sh.
+			cmd = []string{"/bin/sh", "-c", "export TERM=xterm-256color COLORTERM=truecolor; [ -x /bin/bash ] && exec /bin/bash || exec /bin/sh"}
+		}
 
-	restClient := clients.Clientset.CoreV1().RESTClient()
-	req := restClient.Post().
-		Resource("pods").
-		Namespace(ns).
-		Name(pod).
-		SubResource("exec").
-		Param("container", container).
-		Param("stdin", "true").
-		Param("stdout", "true").
-		Param("stderr", "true").
-		Param("tty", "true")
+		req = restClient.Post().
+			Resource("pods").
+			Namespace(ns).
+			Name(pod).
+			SubResource("exec").
+			Param("container", container).
+			Param("stdin", "true").
+			Param("stdout", "true").
+			Param("stderr", "true").
+			Param("tty", "true")
 
-	for _, c := range cmd {
-		req = req.Param("command", c)
+		for _, c := range cmd {
+			req = req.Param("command", c)
+		}
 	}
 
 	exec, err := remotecommand.NewSPDYExecutor(clients.RestConfig, http.MethodPost, req.URL())
 	if err != nil {
-		_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf("error: failed to create executor: %v", err)))
-		sess.Status = session.StatusFailed
-		sess.ConnectionState = session.ConnectionClosed
-		sess.UpdatedAt = time.Now().UTC()
-		_ = t.Sessions.Update(ctx, sess)
-		return
+		return nil, fmt.Errorf("failed to create executor: %v", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if existing := t.live[sess.ID]; existing != nil {
+		// Lost a race with a concurrent attach for the same session.
+		return existing, nil
+	}
+	if t.live == nil {
+		t.live = map[string]*terminalProcess{}
+	}
+
+	// Opt-in recording fails closed: an audited shell must not start unrecorded. The
+	// recorder is only created by the attach that won the race above, so a losing attach
+	// never leaves a stray recording behind.
+	var rec *terminalRecorder
+	if sess.Metadata["record"] == "true" {
+		dir := t.RecordingsDir
+		if dir == "" {
+			dir = DefaultTerminalRecordingsDir()
+		}
+		var name string
+		rec, name, err = newTerminalRecorder(dir, sess.ID, sess.Title, 80, 24, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to start session recording: %v", err)
+		}
+		sess.Metadata = maps.Clone(sess.Metadata)
+		sess.Metadata["recording"] = name
 	}
 
 	// Update session to starting/connecting.
@@ -190,59 +271,24 @@ func (t *TerminalWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	sess.UpdatedAt = time.Now().UTC()
 	_ = t.Sessions.Update(ctx, sess)
 
-	stdinReader, stdinWriter := io.Pipe()
-	sizeQueue := newTerminalSizeQueue()
-	defer sizeQueue.Close()
-
-	go func() {
-		defer func() { _ = stdinWriter.Close() }()
-		for {
-			mt, msg, err := conn.ReadMessage()
-			if err != nil {
-				return
-			}
-			if mt != websocket.TextMessage && mt != websocket.BinaryMessage {
-				continue
-			}
-			if len(msg) == 0 {
-				continue
-			}
-			if mt == websocket.TextMessage {
-				var control terminalControlMessage
-				if err := json.Unmarshal(msg, &control); err == nil && control.Type == "resize" {
-					sizeQueue.Push(control.Cols, control.Rows)
-					continue
-				}
-			}
-			if _, err := stdinWriter.Write(msg); err != nil {
-				return
-			}
+	procCtx, cancel := context.WithCancel(context.Background())
+	proc := newTerminalProcess(sess.ID, t.Sessions, sess.Metadata, cancel)
+	proc.rec = rec
+	registrar, _ := t.Sessions.(terminalCleanupRegistrar)
+	proc.onExit = func() {
+		t.mu.Lock()
+		if t.live[sess.ID] == proc {
+			delete(t.live, sess.ID)
+		}
+		t.mu.Unlock()
+		if registrar != nil {
+			registrar.UnregisterTerminal(sess.ID)
 		}
-	}()
-
-	stdoutWriter := &wsWriter{conn: conn}
-
-	// Update session to running/connected once streaming starts.
-	sess.Status = session.StatusRunning
-	sess.ConnectionState = session.ConnectionConnected
-	sess.UpdatedAt = time.Now().UTC()
-	_ = t.Sessions.Update(ctx, sess)
-
-	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
-		Stdin:             stdinReader,
-		Stdout:            stdoutWriter,
-		Stderr:            stdoutWriter,
-		Tty:               true,
-		TerminalSizeQueue: sizeQueue,
-	})
-
-	if err != nil {
-		_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf("error: stream ended: %v", err)))
-		sess.Status = session.StatusFailed
-	} else {
-		sess.Status = session.StatusStopped
 	}
-	sess.ConnectionState = session.ConnectionClosed
-	sess.UpdatedAt = time.Now().UTC()
-	_ = t.Sessions.Update(ctx, sess)
+	t.live[sess.ID] = proc
+	if registrar != nil {
+		registrar.RegisterTerminal(sess.ID, cancel)
+	}
+	go proc.run(procCtx, exec)
+	return proc, nil
 }